@@ -3,7 +3,9 @@ package api
 import (
 	"github.com/onmetal-dev/metal/lib/background"
 	"github.com/onmetal-dev/metal/lib/background/deployment"
+	"github.com/onmetal-dev/metal/lib/background/previewenv"
 	"github.com/onmetal-dev/metal/lib/cellprovider"
+	"github.com/onmetal-dev/metal/lib/domainverify"
 	"github.com/onmetal-dev/metal/lib/oapi"
 	"github.com/onmetal-dev/metal/lib/store"
 )
@@ -14,9 +16,13 @@ func New(
 	deploymentStore store.DeploymentStore,
 	teamStore store.TeamStore,
 	buildStore store.BuildStore,
+	cronJobStore store.CronJobStore,
+	domainStore store.DomainStore,
+	domainResolver domainverify.Resolver,
 	cellStore store.CellStore,
 	cellProviderForType func(cellType store.CellType) cellprovider.CellProvider,
 	producerDeployment *background.QueueProducer[deployment.Message],
+	producerPreviewEnv *background.QueueProducer[previewenv.Message],
 ) oapi.StrictServerInterface {
 	return api{
 		apiTokenStore:       apiTokenStore,
@@ -24,9 +30,13 @@ func New(
 		deploymentStore:     deploymentStore,
 		teamStore:           teamStore,
 		buildStore:          buildStore,
+		cronJobStore:        cronJobStore,
+		domainStore:         domainStore,
+		domainResolver:      domainResolver,
 		cellStore:           cellStore,
 		cellProviderForType: cellProviderForType,
 		producerDeployment:  producerDeployment,
+		producerPreviewEnv:  producerPreviewEnv,
 	}
 }
 
@@ -36,9 +46,13 @@ type api struct {
 	deploymentStore     store.DeploymentStore
 	teamStore           store.TeamStore
 	buildStore          store.BuildStore
+	cronJobStore        store.CronJobStore
+	domainStore         store.DomainStore
+	domainResolver      domainverify.Resolver
 	cellStore           store.CellStore
 	cellProviderForType func(cellType store.CellType) cellprovider.CellProvider
 	producerDeployment  *background.QueueProducer[deployment.Message]
+	producerPreviewEnv  *background.QueueProducer[previewenv.Message]
 }
 
 var _ oapi.StrictServerInterface = api{}
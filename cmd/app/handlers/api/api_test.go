@@ -9,6 +9,10 @@ func newTestAPI() api {
 		&mock.DeploymentStoreMock{},
 		&mock.TeamStoreMock{},
 		&mock.BuildStoreMock{},
+		&mock.CronJobStoreMock{},
+		&mock.DomainStoreMock{},
+		nil,
+		&mock.CellStoreMock{},
 		nil,
 		nil,
 		nil,
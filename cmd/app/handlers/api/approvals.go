@@ -0,0 +1,137 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+)
+
+var errNoPendingApproval = errors.New("there is no deployment pending approval")
+
+// pendingApprovalForAppEnv returns the deployment a review is for: the one with deploymentId, or the latest one pending approval
+func (a api) pendingApprovalForAppEnv(ctx context.Context, appId, envId string, deploymentId *int) (store.Deployment, error) {
+	deployments, err := a.deploymentStore.GetForAppEnv(ctx, appId, envId)
+	if err != nil {
+		return store.Deployment{}, err
+	}
+	if deploymentId == nil {
+		d, ok := lo.Find(deployments, func(d store.Deployment) bool { return d.Status == store.DeploymentStatusPendingApproval })
+		if !ok {
+			return store.Deployment{}, errNoPendingApproval
+		}
+		return d, nil
+	}
+	d, ok := lo.Find(deployments, func(d store.Deployment) bool { return d.Id == uint(*deploymentId) })
+	if !ok {
+		return store.Deployment{}, store.ErrDeploymentNotFound
+	}
+	return d, nil
+}
+
+// reviewOptions reviews the deployment as the team member who created the token
+func reviewOptions(ctx context.Context, team *store.Team, token store.ApiToken, d store.Deployment, approved bool, comment string) store.ReviewDeploymentOptions {
+	reviewer, _ := lo.Find(team.Members, func(m store.TeamMember) bool { return m.UserId == token.CreatorId })
+	return store.ReviewDeploymentOptions{
+		AppId:        d.AppId,
+		EnvId:        d.EnvId,
+		DeploymentId: d.Id,
+		Approved:     approved,
+		ReviewerId:   token.CreatorId,
+		Reviewer:     lo.CoalesceOrEmpty(reviewer.User.Email, middleware.Actor(ctx)),
+		Comment:      comment,
+	}
+}
+
+func (a api) ApproveDeployment(ctx context.Context, request oapi.ApproveDeploymentRequestObject) (oapi.ApproveDeploymentResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.ApproveDeployment404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.ApproveDeployment500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+
+	var deploymentId *int
+	var comment string
+	if request.Body != nil {
+		deploymentId, comment = request.Body.DeploymentId, lo.FromPtr(request.Body.Comment)
+	}
+	d, err := a.pendingApprovalForAppEnv(ctx, app.Id, env.Id, deploymentId)
+	if err != nil {
+		if errors.Is(err, errNoPendingApproval) {
+			return oapi.ApproveDeployment400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+		} else if errors.Is(err, store.ErrDeploymentNotFound) {
+			return oapi.ApproveDeployment404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: fmt.Sprintf("deployment %d not found", *deploymentId)}}, nil
+		}
+		return oapi.ApproveDeployment500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	team, err := a.teamStore.GetTeam(ctx, token.TeamId)
+	if err != nil {
+		return oapi.ApproveDeployment500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	if err := d.CheckReviewer(*team, token.CreatorId); err != nil {
+		return oapi.ApproveDeployment400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	if err := a.deploymentStore.ReviewDeployment(reviewOptions(ctx, team, token, d, true, comment)); err != nil {
+		return oapi.ApproveDeployment500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to approve deployment: %s", err)}}, nil
+	}
+	if err := a.enqueueDeployment(ctx, d); err != nil {
+		return oapi.ApproveDeployment500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to send deployment message to queue: %s", err)}}, nil
+	}
+
+	if d, err = a.deploymentStore.Get(app.Id, env.Id, d.Id); err != nil {
+		return oapi.ApproveDeployment500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	return oapi.ApproveDeployment200JSONResponse(deploymentFromStore(d)), nil
+}
+
+func (a api) RejectDeployment(ctx context.Context, request oapi.RejectDeploymentRequestObject) (oapi.RejectDeploymentResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.RejectDeployment404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.RejectDeployment500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+
+	var deploymentId *int
+	var comment string
+	if request.Body != nil {
+		deploymentId, comment = request.Body.DeploymentId, lo.FromPtr(request.Body.Comment)
+	}
+	d, err := a.pendingApprovalForAppEnv(ctx, app.Id, env.Id, deploymentId)
+	if err != nil {
+		if errors.Is(err, errNoPendingApproval) {
+			return oapi.RejectDeployment400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+		} else if errors.Is(err, store.ErrDeploymentNotFound) {
+			return oapi.RejectDeployment404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: fmt.Sprintf("deployment %d not found", *deploymentId)}}, nil
+		}
+		return oapi.RejectDeployment500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	team, err := a.teamStore.GetTeam(ctx, token.TeamId)
+	if err != nil {
+		return oapi.RejectDeployment500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	if err := d.CheckReviewer(*team, token.CreatorId); err != nil {
+		return oapi.RejectDeployment400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	if err := a.deploymentStore.ReviewDeployment(reviewOptions(ctx, team, token, d, false, comment)); err != nil {
+		return oapi.RejectDeployment500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to reject deployment: %s", err)}}, nil
+	}
+
+	if d, err = a.deploymentStore.Get(app.Id, env.Id, d.Id); err != nil {
+		return oapi.RejectDeployment500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	return oapi.RejectDeployment200JSONResponse(deploymentFromStore(d)), nil
+}
@@ -0,0 +1,149 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/onmetal-dev/metal/lib/store/mock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.jetify.com/typeid"
+	"gorm.io/datatypes"
+)
+
+func TestReviewDeployment(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	appId := typeid.Must(typeid.WithPrefix("app")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	team := &store.Team{Common: store.Common{Id: teamId}, Members: []store.TeamMember{
+		{UserId: "user_admin", User: store.User{Email: "admin@example.com"}, Role: store.TeamRoleAdmin},
+		{UserId: "user_requester", User: store.User{Email: "requester@example.com"}, Role: store.TeamRoleAdmin},
+		{UserId: "user_member", User: store.User{Email: "member@example.com"}, Role: store.TeamRoleMember},
+	}}
+	tokenCtx := func(userId string) context.Context {
+		return middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId, CreatorId: userId})
+	}
+
+	newReviewTestAPI := func(deployments []store.Deployment) api {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId, Protected: true}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetForAppEnv", testifymock.Anything, appId, envId).Return(deployments, nil)
+		api.teamStore.(*mock.TeamStoreMock).On("GetTeam", testifymock.Anything, teamId).Return(team, nil)
+		return api
+	}
+	running := store.Deployment{Id: 1, AppId: appId, EnvId: envId, Status: store.DeploymentStatusRunning}
+	pending := store.Deployment{Id: 2, AppId: appId, EnvId: envId, Status: store.DeploymentStatusPendingApproval, RequestedBy: "user_requester"}
+
+	t.Run("nothing pending approval", func(t *testing.T) {
+		api := newReviewTestAPI([]store.Deployment{running})
+
+		resp, err := api.ApproveDeployment(tokenCtx("user_admin"), oapi.ApproveDeploymentRequestObject{AppId: appId, EnvId: envId})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.ApproveDeployment400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Equal(t, "there is no deployment pending approval", badReq.Error)
+	})
+
+	t.Run("deployment not pending approval", func(t *testing.T) {
+		api := newReviewTestAPI([]store.Deployment{pending, running})
+
+		resp, err := api.ApproveDeployment(tokenCtx("user_admin"), oapi.ApproveDeploymentRequestObject{AppId: appId, EnvId: envId, Body: &oapi.ApproveDeploymentJSONRequestBody{DeploymentId: lo.ToPtr(1)}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.ApproveDeployment400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Equal(t, "deployment 1 is running, only deployments pending approval can be approved or rejected", badReq.Error)
+	})
+
+	t.Run("unknown deployment", func(t *testing.T) {
+		api := newReviewTestAPI([]store.Deployment{pending, running})
+
+		resp, err := api.ApproveDeployment(tokenCtx("user_admin"), oapi.ApproveDeploymentRequestObject{AppId: appId, EnvId: envId, Body: &oapi.ApproveDeploymentJSONRequestBody{DeploymentId: lo.ToPtr(3)}})
+		require.NoError(t, err)
+		notFound, ok := resp.(oapi.ApproveDeployment404JSONResponse)
+		require.True(t, ok, "Expected 404 response")
+		assert.Equal(t, "deployment 3 not found", notFound.Error)
+	})
+
+	t.Run("failing to get the deployments", func(t *testing.T) {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId, Protected: true}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetForAppEnv", testifymock.Anything, appId, envId).Return([]store.Deployment(nil), errors.New("connection refused"))
+
+		resp, err := api.ApproveDeployment(tokenCtx("user_admin"), oapi.ApproveDeploymentRequestObject{AppId: appId, EnvId: envId, Body: &oapi.ApproveDeploymentJSONRequestBody{DeploymentId: lo.ToPtr(2)}})
+		require.NoError(t, err)
+		internalErr, ok := resp.(oapi.ApproveDeployment500JSONResponse)
+		require.True(t, ok, "Expected 500 response")
+		assert.Equal(t, "connection refused", internalErr.Error)
+
+		rejectResp, err := api.RejectDeployment(tokenCtx("user_admin"), oapi.RejectDeploymentRequestObject{AppId: appId, EnvId: envId})
+		require.NoError(t, err)
+		_, ok = rejectResp.(oapi.RejectDeployment500JSONResponse)
+		require.True(t, ok, "Expected 500 response")
+	})
+
+	t.Run("not an admin", func(t *testing.T) {
+		api := newReviewTestAPI([]store.Deployment{pending, running})
+
+		resp, err := api.ApproveDeployment(tokenCtx("user_member"), oapi.ApproveDeploymentRequestObject{AppId: appId, EnvId: envId})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.ApproveDeployment400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Equal(t, "only team admins can approve or reject deployments", badReq.Error)
+	})
+
+	t.Run("approving your own deployment", func(t *testing.T) {
+		api := newReviewTestAPI([]store.Deployment{pending, running})
+
+		resp, err := api.ApproveDeployment(tokenCtx("user_requester"), oapi.ApproveDeploymentRequestObject{AppId: appId, EnvId: envId})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.ApproveDeployment400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Equal(t, "deployment 2 must be approved or rejected by an admin other than the one who asked for it", badReq.Error)
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		api := newReviewTestAPI([]store.Deployment{pending, running})
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("ReviewDeployment", store.ReviewDeploymentOptions{
+			AppId:        appId,
+			EnvId:        envId,
+			DeploymentId: 2,
+			Approved:     false,
+			ReviewerId:   "user_admin",
+			Reviewer:     "admin@example.com",
+			Comment:      "not during the launch",
+		}).Return(nil)
+		rejected := pending
+		rejected.Status = store.DeploymentStatusRejected
+		rejected.Review = datatypes.NewJSONType(&store.DeploymentReview{ReviewerId: "user_admin", Reviewer: "admin@example.com", Comment: "not during the launch"})
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("Get", appId, envId, uint(2)).Return(rejected, nil)
+
+		resp, err := api.RejectDeployment(tokenCtx("user_admin"), oapi.RejectDeploymentRequestObject{AppId: appId, EnvId: envId, Body: &oapi.RejectDeploymentJSONRequestBody{Comment: lo.ToPtr("not during the launch")}})
+		require.NoError(t, err)
+		ok200, ok := resp.(oapi.RejectDeployment200JSONResponse)
+		require.True(t, ok, "Expected 200 response")
+		assert.Equal(t, oapi.DeploymentStatusRejected, ok200.Status)
+		require.NotNil(t, ok200.Review)
+		assert.False(t, ok200.Review.Approved)
+		assert.Equal(t, "admin@example.com", ok200.Review.Reviewer)
+		assert.Equal(t, "not during the launch", ok200.Review.Comment)
+	})
+
+	t.Run("other team's env", func(t *testing.T) {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: "team_other"}, nil)
+
+		resp, err := api.RejectDeployment(tokenCtx("user_admin"), oapi.RejectDeploymentRequestObject{AppId: appId, EnvId: envId})
+		require.NoError(t, err)
+		_, ok := resp.(oapi.RejectDeployment404JSONResponse)
+		require.True(t, ok, "Expected 404 response")
+	})
+}
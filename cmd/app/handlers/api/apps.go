@@ -2,8 +2,11 @@ package api
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/background/deployment"
 	"github.com/onmetal-dev/metal/lib/oapi"
 	"github.com/onmetal-dev/metal/lib/store"
 	"github.com/samber/lo"
@@ -49,6 +52,12 @@ func (a api) DeleteApp(ctx context.Context, request oapi.DeleteAppRequestObject)
 		return oapi.DeleteApp404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: "not found"}}, nil
 	}
 
+	if err := deployment.DestroyApp(ctx, a.deploymentStore, a.domainStore, a.cellStore, a.cellProviderForType, app, lo.FromPtr(request.Params.DeleteVolumes)); err != nil {
+		if errors.Is(err, deployment.ErrAppHasVolumes) {
+			return oapi.DeleteApp400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: fmt.Sprintf("%s. pass delete_volumes=true to confirm", err)}}, nil
+		}
+		return oapi.DeleteApp500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
 	if err := a.appStore.Delete(ctx, request.AppId); err != nil {
 		return oapi.DeleteApp500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
 	}
@@ -0,0 +1,159 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+)
+
+var errNoCanary = errors.New("there is no canary waiting to be promoted or aborted")
+
+// parseCanarySteps parses comma-separated traffic percentages, e.g. "10,50". They must be strictly increasing and between 1 and 99
+func parseCanarySteps(s string) ([]int, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	var steps []int
+	for _, field := range strings.Split(s, ",") {
+		step, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", field)
+		} else if step < 1 || step > 99 {
+			return nil, fmt.Errorf("%d is not between 1 and 99", step)
+		} else if len(steps) > 0 && step <= steps[len(steps)-1] {
+			return nil, errors.New("steps must be increasing")
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+// checkCanCanary returns an error if a canary can't be released into the env, i.e. there is nothing running to compare it to or another canary is under way
+func (a api) checkCanCanary(ctx context.Context, appId, envId string) error {
+	deployments, err := a.deploymentStore.GetForAppEnv(ctx, appId, envId)
+	if err != nil {
+		return err
+	}
+	running, ok := lo.Find(deployments, func(d store.Deployment) bool { return d.Status == store.DeploymentStatusRunning })
+	if !ok {
+		return errors.New("a canary needs a running deployment to split traffic with")
+	} else if running.AppSettings.HasVolumes() {
+		return errors.New("apps with volumes can't be released as a canary since a volume can only be mounted by one pod")
+	}
+	if d, ok := lo.Find(deployments, func(d store.Deployment) bool {
+		return d.IsCanary() && !lo.Contains([]store.DeploymentStatus{store.DeploymentStatusRunning, store.DeploymentStatusFailed, store.DeploymentStatusStopped, store.DeploymentStatusCanceled}, d.Status)
+	}); ok {
+		return fmt.Errorf("deployment %d is already a canary in progress", d.Id)
+	}
+	return nil
+}
+
+// canaryForAppEnv returns the canary that is waiting to be promoted or aborted, or errNoCanary
+func (a api) canaryForAppEnv(ctx context.Context, appId, envId string) (store.Deployment, error) {
+	deployments, err := a.deploymentStore.GetForAppEnv(ctx, appId, envId)
+	if err != nil {
+		return store.Deployment{}, err
+	}
+	d, ok := lo.Find(deployments, func(d store.Deployment) bool { return d.Status == store.DeploymentStatusCanary })
+	if !ok {
+		return store.Deployment{}, errNoCanary
+	}
+	return d, nil
+}
+
+func (a api) PromoteCanary(ctx context.Context, request oapi.PromoteCanaryRequestObject) (oapi.PromoteCanaryResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.PromoteCanary404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.PromoteCanary500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+
+	d, err := a.canaryForAppEnv(ctx, app.Id, env.Id)
+	if err != nil {
+		if errors.Is(err, errNoCanary) {
+			return oapi.PromoteCanary400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.PromoteCanary500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+
+	weight := d.NextCanaryWeight()
+	if request.Body != nil && lo.FromPtr(request.Body.Full) {
+		weight = 100
+	}
+	statusReason := fmt.Sprintf("shifting %d%% of traffic to canary", weight)
+	if weight == 100 {
+		statusReason = "rolling out canary fully"
+	}
+	if err := a.deploymentStore.UpdateCanaryWeight(app.Id, env.Id, d.Id, weight); err != nil {
+		return oapi.PromoteCanary500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to update canary weight: %s", err)}}, nil
+	}
+	if err := a.deploymentStore.RecordDeploymentStatus(store.RecordDeploymentStatusOptions{
+		AppId:        app.Id,
+		EnvId:        env.Id,
+		DeploymentId: d.Id,
+		Status:       store.DeploymentStatusPromoting,
+		Reason:       statusReason,
+		Actor:        middleware.Actor(ctx),
+	}); err != nil {
+		return oapi.PromoteCanary500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to update deployment status: %s", err)}}, nil
+	}
+	if err := a.enqueueDeployment(ctx, d); err != nil {
+		return oapi.PromoteCanary500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to send deployment message to queue: %s", err)}}, nil
+	}
+
+	d.CanaryWeight = weight
+	d.Status = store.DeploymentStatusPromoting
+	d.StatusReason = statusReason
+	return oapi.PromoteCanary200JSONResponse(deploymentFromStore(d)), nil
+}
+
+func (a api) AbortCanary(ctx context.Context, request oapi.AbortCanaryRequestObject) (oapi.AbortCanaryResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.AbortCanary404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.AbortCanary500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+
+	d, err := a.canaryForAppEnv(ctx, app.Id, env.Id)
+	if err != nil {
+		if errors.Is(err, errNoCanary) {
+			return oapi.AbortCanary400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.AbortCanary500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+
+	statusReason := "sending all traffic back to the previous deployment"
+	if err := a.deploymentStore.RecordDeploymentStatus(store.RecordDeploymentStatusOptions{
+		AppId:        app.Id,
+		EnvId:        env.Id,
+		DeploymentId: d.Id,
+		Status:       store.DeploymentStatusAborting,
+		Reason:       statusReason,
+		Actor:        middleware.Actor(ctx),
+	}); err != nil {
+		return oapi.AbortCanary500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to update deployment status: %s", err)}}, nil
+	}
+	if err := a.enqueueDeployment(ctx, d); err != nil {
+		return oapi.AbortCanary500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to send deployment message to queue: %s", err)}}, nil
+	}
+
+	d.Status = store.DeploymentStatusAborting
+	d.StatusReason = statusReason
+	return oapi.AbortCanary200JSONResponse(deploymentFromStore(d)), nil
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/onmetal-dev/metal/lib/store/mock"
+	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.jetify.com/typeid"
+	"gorm.io/datatypes"
+)
+
+func TestParseCanarySteps(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    []int
+		wantErr string
+	}{
+		{input: "", want: nil},
+		{input: "10", want: []int{10}},
+		{input: " 10, 50 ", want: []int{10, 50}},
+		{input: "ten", wantErr: "not a number"},
+		{input: "0,50", wantErr: "not between 1 and 99"},
+		{input: "10,100", wantErr: "not between 1 and 99"},
+		{input: "50,10", wantErr: "increasing"},
+		{input: "10,10", wantErr: "increasing"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			steps, err := parseCanarySteps(tt.input)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, steps)
+		})
+	}
+}
+
+func TestCanary(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	appId := typeid.Must(typeid.WithPrefix("app")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId})
+
+	newCanaryTestAPI := func(deployments []store.Deployment) api {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetForAppEnv", testifymock.Anything, appId, envId).Return(deployments, nil)
+		return api
+	}
+	running := store.Deployment{Id: 1, Status: store.DeploymentStatusRunning}
+
+	t.Run("promote without a canary", func(t *testing.T) {
+		api := newCanaryTestAPI([]store.Deployment{running})
+
+		resp, err := api.PromoteCanary(ctx, oapi.PromoteCanaryRequestObject{AppId: appId, EnvId: envId, Body: &oapi.PromoteCanaryJSONRequestBody{}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.PromoteCanary400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Contains(t, badReq.Error, "no canary")
+	})
+
+	t.Run("abort without a canary", func(t *testing.T) {
+		api := newCanaryTestAPI([]store.Deployment{running})
+
+		resp, err := api.AbortCanary(ctx, oapi.AbortCanaryRequestObject{AppId: appId, EnvId: envId})
+		require.NoError(t, err)
+		_, ok := resp.(oapi.AbortCanary400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+	})
+
+	t.Run("canary needs a running deployment", func(t *testing.T) {
+		api := newCanaryTestAPI([]store.Deployment{{Id: 1, Status: store.DeploymentStatusFailed}})
+
+		err := api.checkCanCanary(ctx, appId, envId)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "running deployment")
+	})
+
+	t.Run("one canary at a time", func(t *testing.T) {
+		canary := store.Deployment{Id: 2, Status: store.DeploymentStatusCanary}
+		canary.CanarySteps = datatypes.NewJSONType([]int{10, 50})
+		api := newCanaryTestAPI([]store.Deployment{running, canary})
+
+		err := api.checkCanCanary(ctx, appId, envId)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "deployment 2 is already a canary")
+	})
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+)
+
+func (a api) CancelDeployment(ctx context.Context, request oapi.CancelDeploymentRequestObject) (oapi.CancelDeploymentResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.CancelDeployment404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.CancelDeployment500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+
+	deployments, err := a.deploymentStore.GetForAppEnv(ctx, app.Id, env.Id)
+	if err != nil {
+		return oapi.CancelDeployment500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	// without a deployment id, cancel the latest deployment that is still in progress
+	var d store.Deployment
+	if request.Body == nil || request.Body.DeploymentId == nil {
+		var ok bool
+		if d, ok = lo.Find(deployments, func(d store.Deployment) bool { return d.CanCancel() }); !ok {
+			return oapi.CancelDeployment400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: "there is no deployment in progress to cancel"}}, nil
+		}
+	} else {
+		id := *request.Body.DeploymentId
+		var ok bool
+		if d, ok = lo.Find(deployments, func(d store.Deployment) bool { return d.Id == uint(id) }); !ok {
+			return oapi.CancelDeployment404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: fmt.Sprintf("deployment %d not found", id)}}, nil
+		} else if !d.CanCancel() {
+			return oapi.CancelDeployment400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: fmt.Sprintf("deployment %d is %s, only pending, releasing and deploying deployments can be canceled", d.Id, d.Status)}}, nil
+		}
+	}
+
+	// the deployment's messages keep coming while it is in progress, and the next one rolls it back, so there is nothing to queue
+	statusReason := "rolling back to the previous deployment"
+	if err := a.deploymentStore.RecordDeploymentStatus(store.RecordDeploymentStatusOptions{
+		AppId:        app.Id,
+		EnvId:        env.Id,
+		DeploymentId: d.Id,
+		Status:       store.DeploymentStatusCanceling,
+		Reason:       statusReason,
+		Actor:        middleware.Actor(ctx),
+	}); err != nil {
+		return oapi.CancelDeployment500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to update deployment status: %s", err)}}, nil
+	}
+
+	d.Status = store.DeploymentStatusCanceling
+	d.StatusReason = statusReason
+	return oapi.CancelDeployment200JSONResponse(deploymentFromStore(d)), nil
+}
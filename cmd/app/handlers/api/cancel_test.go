@@ -0,0 +1,82 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/onmetal-dev/metal/lib/store/mock"
+	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.jetify.com/typeid"
+)
+
+func TestCancelDeployment(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	appId := typeid.Must(typeid.WithPrefix("app")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId})
+
+	newCancelTestAPI := func(deployments []store.Deployment) api {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetForAppEnv", testifymock.Anything, appId, envId).Return(deployments, nil)
+		return api
+	}
+	running := store.Deployment{Id: 1, Status: store.DeploymentStatusRunning}
+
+	t.Run("nothing in progress", func(t *testing.T) {
+		api := newCancelTestAPI([]store.Deployment{running})
+
+		resp, err := api.CancelDeployment(ctx, oapi.CancelDeploymentRequestObject{AppId: appId, EnvId: envId})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.CancelDeployment400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Contains(t, badReq.Error, "no deployment in progress")
+	})
+
+	t.Run("finished deployment", func(t *testing.T) {
+		api := newCancelTestAPI([]store.Deployment{{Id: 2, Status: store.DeploymentStatusDeploying}, running})
+
+		id := 1
+		resp, err := api.CancelDeployment(ctx, oapi.CancelDeploymentRequestObject{AppId: appId, EnvId: envId, Body: &oapi.CancelDeploymentJSONRequestBody{DeploymentId: &id}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.CancelDeployment400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Equal(t, "deployment 1 is running, only pending, releasing and deploying deployments can be canceled", badReq.Error)
+	})
+
+	t.Run("deployment in progress", func(t *testing.T) {
+		api := newCancelTestAPI([]store.Deployment{{Id: 2, Status: store.DeploymentStatusDeploying}, running})
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("RecordDeploymentStatus", store.RecordDeploymentStatusOptions{
+			AppId:        appId,
+			EnvId:        envId,
+			DeploymentId: 2,
+			Status:       store.DeploymentStatusCanceling,
+			Reason:       "rolling back to the previous deployment",
+			Actor:        middleware.Actor(ctx),
+		}).Return(nil)
+
+		// the deployment's own messages roll it back, so none is queued, which would fail without a producer
+		resp, err := api.CancelDeployment(ctx, oapi.CancelDeploymentRequestObject{AppId: appId, EnvId: envId})
+		require.NoError(t, err)
+		canceled, ok := resp.(oapi.CancelDeployment200JSONResponse)
+		require.True(t, ok, "Expected 200 response")
+		assert.Equal(t, 2, canceled.Id)
+		assert.Equal(t, oapi.DeploymentStatus(store.DeploymentStatusCanceling), canceled.Status)
+	})
+
+	t.Run("unknown deployment", func(t *testing.T) {
+		api := newCancelTestAPI([]store.Deployment{running})
+
+		id := 7
+		resp, err := api.CancelDeployment(ctx, oapi.CancelDeploymentRequestObject{AppId: appId, EnvId: envId, Body: &oapi.CancelDeploymentJSONRequestBody{DeploymentId: &id}})
+		require.NoError(t, err)
+		_, ok := resp.(oapi.CancelDeployment404JSONResponse)
+		require.True(t, ok, "Expected 404 response")
+	})
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+)
+
+func cellsFromStore(cells []store.Cell) []oapi.Cell {
+	return lo.Map(cells, func(c store.Cell, _ int) oapi.Cell {
+		return oapi.Cell{Id: c.Id, Name: c.Name, Type: string(c.Type)}
+	})
+}
+
+func (a api) GetCells(ctx context.Context, request oapi.GetCellsRequestObject) (oapi.GetCellsResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+	cells, err := a.cellStore.GetForTeam(ctx, token.TeamId)
+	if err != nil {
+		return oapi.GetCells500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	return oapi.GetCells200JSONResponse(cellsFromStore(cells)), nil
+}
+
+func (a api) UpdateCells(ctx context.Context, request oapi.UpdateCellsRequestObject) (oapi.UpdateCellsResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.UpdateCells404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.UpdateCells500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	if err := a.checkEnvUnlocked(ctx, env); err != nil {
+		return oapi.UpdateCells400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	latest, err := a.deploymentStore.GetLatestForAppEnv(ctx, app.Id, env.Id)
+	if err != nil {
+		return oapi.UpdateCells500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	} else if latest == nil {
+		return oapi.UpdateCells400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: "app has not been deployed to this env"}}, nil
+	}
+
+	cellIds := lo.Uniq(request.Body.CellIds)
+	if len(cellIds) == 0 {
+		return oapi.UpdateCells400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: "at least one cell is required"}}, nil
+	}
+	cells, err := a.cellStore.GetForTeam(ctx, token.TeamId)
+	if err != nil {
+		return oapi.UpdateCells500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	for _, cellId := range cellIds {
+		if !lo.ContainsBy(cells, func(c store.Cell) bool { return c.Id == cellId }) {
+			return oapi.UpdateCells400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: fmt.Sprintf("cell %s does not exist", cellId)}}, nil
+		}
+	}
+
+	d, err := a.deploymentStore.Create(store.CreateDeploymentOptions{
+		TeamId:        token.TeamId,
+		EnvId:         env.Id,
+		AppId:         app.Id,
+		Type:          store.DeploymentTypeDeploy,
+		Actor:         middleware.Actor(ctx),
+		OverrideLock:  a.overridesLock(ctx),
+		RequestedBy:   middleware.ActorUserId(ctx),
+		AppSettingsId: latest.AppSettingsId,
+		AppEnvVarsId:  latest.AppEnvVarsId,
+		CellIds:       cellIds,
+		Replicas:      latest.Replicas,
+	})
+	if err != nil {
+		return oapi.UpdateCells500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to create deployment: %v", err)}}, nil
+	}
+	if err := a.enqueueDeployment(ctx, d); err != nil {
+		return oapi.UpdateCells500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to send deployment message to queue: %v", err)}}, nil
+	}
+	return oapi.UpdateCells201JSONResponse(deploymentFromStore(d)), nil
+}
@@ -0,0 +1,67 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/onmetal-dev/metal/lib/store/mock"
+	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.jetify.com/typeid"
+	"gorm.io/datatypes"
+)
+
+func TestUpdateCells(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	appId := typeid.Must(typeid.WithPrefix("app")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	cellId := typeid.Must(typeid.WithPrefix("cell")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId})
+
+	newCellsTestAPI := func() api {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetLatestForAppEnv", testifymock.Anything, appId, envId).Return(&store.Deployment{Id: 1, Status: store.DeploymentStatusRunning}, nil)
+		api.cellStore.(*mock.CellStoreMock).On("GetForTeam", testifymock.Anything, teamId).Return([]store.Cell{{Common: store.Common{Id: cellId}, Name: "fsn1"}}, nil)
+		return api
+	}
+
+	t.Run("no cells", func(t *testing.T) {
+		api := newCellsTestAPI()
+
+		resp, err := api.UpdateCells(ctx, oapi.UpdateCellsRequestObject{AppId: appId, EnvId: envId, Body: &oapi.UpdateCellsJSONRequestBody{CellIds: []string{}}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.UpdateCells400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Equal(t, "at least one cell is required", badReq.Error)
+	})
+
+	t.Run("other team's cell", func(t *testing.T) {
+		api := newCellsTestAPI()
+
+		resp, err := api.UpdateCells(ctx, oapi.UpdateCellsRequestObject{AppId: appId, EnvId: envId, Body: &oapi.UpdateCellsJSONRequestBody{CellIds: []string{cellId, "cell_other"}}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.UpdateCells400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Equal(t, "cell cell_other does not exist", badReq.Error)
+	})
+}
+
+func TestDeploymentFromStoreCells(t *testing.T) {
+	d := store.Deployment{
+		Id:     1,
+		Status: store.DeploymentStatusDeploying,
+		Cells:  []store.Cell{{Common: store.Common{Id: "cell_fsn1"}}, {Common: store.Common{Id: "cell_nbg1"}}},
+	}
+	d.CellStatuses = datatypes.NewJSONType([]store.CellStatus{{CellId: "cell_fsn1", Status: store.DeploymentStatusRunning}})
+
+	cells := *deploymentFromStore(d).Cells
+	require.Len(t, cells, 2)
+	assert.Equal(t, oapi.CellStatus{CellId: "cell_fsn1", Status: oapi.DeploymentStatusRunning}, cells[0])
+	assert.Equal(t, oapi.CellStatus{CellId: "cell_nbg1", Status: oapi.DeploymentStatusDeploying}, cells[1])
+}
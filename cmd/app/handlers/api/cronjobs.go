@@ -0,0 +1,207 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/background/deployment"
+	"github.com/onmetal-dev/metal/lib/cellprovider"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/onmetal-dev/metal/lib/validate"
+	"github.com/samber/lo"
+)
+
+func cronJobFromStore(c store.CronJob) oapi.CronJob {
+	return oapi.CronJob{
+		Id:                c.Id,
+		AppId:             c.AppId,
+		EnvId:             c.EnvId,
+		Name:              c.Name,
+		Schedule:          c.Schedule,
+		Command:           c.Command,
+		Timezone:          c.Timezone,
+		ConcurrencyPolicy: oapi.CronJobConcurrencyPolicy(c.ConcurrencyPolicy),
+		CreatedAt:         c.CreatedAt,
+		UpdatedAt:         c.UpdatedAt,
+	}
+}
+
+func (a api) syncCronJobs(ctx context.Context, appId, envId string) error {
+	_, err := deployment.SyncCronJobs(ctx, a.deploymentStore, a.cronJobStore, a.cellStore, a.cellProviderForType, appId, envId)
+	return err
+}
+
+// cronJobCellProvider returns the cell an app's cron jobs run on along with its provider, or nil if the app isn't running in the env
+func (a api) cronJobCellProvider(ctx context.Context, appId, envId string) (*store.Cell, cellprovider.CellProvider, error) {
+	cell, err := deployment.CronJobCell(ctx, a.deploymentStore, a.cellStore, appId, envId)
+	if err != nil || cell == nil {
+		return nil, nil, err
+	}
+	cellProvider := a.cellProviderForType(cell.Type)
+	if cellProvider == nil {
+		return nil, nil, fmt.Errorf("no cell provider found for cell type: %s", cell.Type)
+	}
+	return cell, cellProvider, nil
+}
+
+func (a api) GetCronJobs(ctx context.Context, request oapi.GetCronJobsRequestObject) (oapi.GetCronJobsResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.GetCronJobs404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.GetCronJobs500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+
+	cronJobs, err := a.cronJobStore.GetForAppEnv(ctx, app.Id, env.Id)
+	if err != nil {
+		return oapi.GetCronJobs500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	return oapi.GetCronJobs200JSONResponse(lo.Map(cronJobs, func(c store.CronJob, _ int) oapi.CronJob { return cronJobFromStore(c) })), nil
+}
+
+func (a api) CreateCronJob(ctx context.Context, request oapi.CreateCronJobRequestObject) (oapi.CreateCronJobResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.CreateCronJob404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.CreateCronJob500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+
+	opts := store.CreateCronJobOptions{
+		TeamId:            token.TeamId,
+		AppId:             app.Id,
+		EnvId:             env.Id,
+		Name:              request.Body.Name,
+		Schedule:          request.Body.Schedule,
+		Command:           request.Body.Command,
+		Timezone:          lo.CoalesceOrEmpty(lo.FromPtr(request.Body.Timezone), "UTC"),
+		ConcurrencyPolicy: store.CronJobConcurrencyPolicy(lo.CoalesceOrEmpty(lo.FromPtr(request.Body.ConcurrencyPolicy), oapi.CronJobConcurrencyPolicyAllow)),
+	}
+	if err := validate.Struct(opts); err != nil {
+		return oapi.CreateCronJob400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+	if _, err := a.cronJobStore.GetByName(ctx, app.Id, env.Id, opts.Name); err == nil {
+		return oapi.CreateCronJob400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: fmt.Sprintf("cron job %s already exists", opts.Name)}}, nil
+	} else if !errors.Is(err, store.ErrCronJobNotFound) {
+		return oapi.CreateCronJob500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+
+	cronJob, err := a.cronJobStore.Create(ctx, opts)
+	if err != nil {
+		return oapi.CreateCronJob500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	if err := a.syncCronJobs(ctx, app.Id, env.Id); err != nil {
+		return oapi.CreateCronJob500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("cron job created but failed to sync it to the cell: %s", err)}}, nil
+	}
+	return oapi.CreateCronJob201JSONResponse(cronJobFromStore(cronJob)), nil
+}
+
+func (a api) DeleteCronJob(ctx context.Context, request oapi.DeleteCronJobRequestObject) (oapi.DeleteCronJobResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.DeleteCronJob404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.DeleteCronJob500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+
+	cronJob, err := a.cronJobStore.GetByName(ctx, app.Id, env.Id, request.Name)
+	if err != nil {
+		if errors.Is(err, store.ErrCronJobNotFound) {
+			return oapi.DeleteCronJob404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.DeleteCronJob500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	if err := a.cronJobStore.Delete(ctx, cronJob.Id); err != nil {
+		return oapi.DeleteCronJob500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	if err := a.syncCronJobs(ctx, app.Id, env.Id); err != nil {
+		return oapi.DeleteCronJob500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("cron job deleted but failed to sync the cell: %s", err)}}, nil
+	}
+	return oapi.DeleteCronJob204Response{}, nil
+}
+
+func (a api) GetCronJobRuns(ctx context.Context, request oapi.GetCronJobRunsRequestObject) (oapi.GetCronJobRunsResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.GetCronJobRuns404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.GetCronJobRuns500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+
+	cronJob, err := a.cronJobStore.GetByName(ctx, app.Id, env.Id, request.Name)
+	if err != nil {
+		if errors.Is(err, store.ErrCronJobNotFound) {
+			return oapi.GetCronJobRuns404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.GetCronJobRuns500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	cell, cellProvider, err := a.cronJobCellProvider(ctx, app.Id, env.Id)
+	if err != nil {
+		return oapi.GetCronJobRuns500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	} else if cell == nil {
+		return oapi.GetCronJobRuns200JSONResponse{}, nil
+	}
+
+	runs, err := cellProvider.CronJobRuns(ctx, cell.Id, cronJob)
+	if err != nil {
+		return oapi.GetCronJobRuns500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	return oapi.GetCronJobRuns200JSONResponse(lo.Map(runs, func(r cellprovider.CronJobRun, _ int) oapi.CronJobRun {
+		return oapi.CronJobRun{
+			Name:         r.Name,
+			Status:       oapi.CronJobRunStatus(r.Status),
+			StatusReason: r.StatusReason,
+			StartedAt:    r.StartedAt,
+			CompletedAt:  r.CompletedAt,
+		}
+	})), nil
+}
+
+func (a api) GetCronJobRunLogs(ctx context.Context, request oapi.GetCronJobRunLogsRequestObject) (oapi.GetCronJobRunLogsResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.GetCronJobRunLogs404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.GetCronJobRunLogs500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+
+	cronJob, err := a.cronJobStore.GetByName(ctx, app.Id, env.Id, request.Name)
+	if err != nil {
+		if errors.Is(err, store.ErrCronJobNotFound) {
+			return oapi.GetCronJobRunLogs404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.GetCronJobRunLogs500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	cell, cellProvider, err := a.cronJobCellProvider(ctx, app.Id, env.Id)
+	if err != nil {
+		return oapi.GetCronJobRunLogs500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	} else if cell == nil {
+		return oapi.GetCronJobRunLogs404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: fmt.Sprintf("run %s not found", request.RunName)}}, nil
+	}
+
+	logs, err := cellProvider.CronJobRunLogs(ctx, cell.Id, cronJob, request.RunName)
+	if err != nil {
+		return oapi.GetCronJobRunLogs500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	return oapi.GetCronJobRunLogs200JSONResponse(lo.Map(logs, func(l cellprovider.LogEntry, _ int) oapi.LogEntry {
+		return oapi.LogEntry{Time: l.Timestamp, Message: l.Message}
+	})), nil
+}
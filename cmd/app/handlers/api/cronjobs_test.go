@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/onmetal-dev/metal/lib/store/mock"
+	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.jetify.com/typeid"
+)
+
+func TestCreateCronJob(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	appId := typeid.Must(typeid.WithPrefix("app")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId})
+
+	newCronJobTestAPI := func() api {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+		return api
+	}
+
+	t.Run("app not found", func(t *testing.T) {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{}, store.ErrAppNotFound)
+
+		resp, err := api.CreateCronJob(ctx, oapi.CreateCronJobRequestObject{AppId: appId, EnvId: envId, Body: &oapi.CreateCronJobJSONRequestBody{Name: "nightly", Schedule: "0 3 * * *", Command: "echo hi"}})
+		require.NoError(t, err)
+		_, ok := resp.(oapi.CreateCronJob404JSONResponse)
+		require.True(t, ok, "Expected 404 response")
+	})
+
+	t.Run("invalid schedule", func(t *testing.T) {
+		api := newCronJobTestAPI()
+
+		resp, err := api.CreateCronJob(ctx, oapi.CreateCronJobRequestObject{AppId: appId, EnvId: envId, Body: &oapi.CreateCronJobJSONRequestBody{Name: "nightly", Schedule: "every night", Command: "echo hi"}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.CreateCronJob400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Contains(t, badReq.Error, "Schedule")
+	})
+
+	t.Run("invalid timezone", func(t *testing.T) {
+		api := newCronJobTestAPI()
+		tz := "Mars/Olympus_Mons"
+
+		resp, err := api.CreateCronJob(ctx, oapi.CreateCronJobRequestObject{AppId: appId, EnvId: envId, Body: &oapi.CreateCronJobJSONRequestBody{Name: "nightly", Schedule: "0 3 * * *", Command: "echo hi", Timezone: &tz}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.CreateCronJob400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Contains(t, badReq.Error, "Timezone")
+	})
+
+	t.Run("name already taken", func(t *testing.T) {
+		api := newCronJobTestAPI()
+		api.cronJobStore.(*mock.CronJobStoreMock).On("GetByName", testifymock.Anything, appId, envId, "nightly").Return(store.CronJob{Name: "nightly"}, nil)
+
+		resp, err := api.CreateCronJob(ctx, oapi.CreateCronJobRequestObject{AppId: appId, EnvId: envId, Body: &oapi.CreateCronJobJSONRequestBody{Name: "nightly", Schedule: "0 3 * * *", Command: "echo hi"}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.CreateCronJob400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Contains(t, badReq.Error, "cron job nightly already exists")
+	})
+}
+
+func TestDeleteCronJob(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	appId := typeid.Must(typeid.WithPrefix("app")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId})
+
+	t.Run("cron job not found", func(t *testing.T) {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+		api.cronJobStore.(*mock.CronJobStoreMock).On("GetByName", testifymock.Anything, appId, envId, "nightly").Return(store.CronJob{}, store.ErrCronJobNotFound)
+
+		resp, err := api.DeleteCronJob(ctx, oapi.DeleteCronJobRequestObject{AppId: appId, EnvId: envId, Name: "nightly"})
+		require.NoError(t, err)
+		_, ok := resp.(oapi.DeleteCronJob404JSONResponse)
+		require.True(t, ok, "Expected 404 response")
+	})
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/logger"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+)
+
+func (a api) DeployImage(ctx context.Context, request oapi.DeployImageRequestObject) (oapi.DeployImageResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.DeployImage404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.DeployImage500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	if err := a.checkEnvUnlocked(ctx, env); err != nil {
+		return oapi.DeployImage400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	image, err := store.ParseImageArtifact(request.Body.Image)
+	if err != nil {
+		return oapi.DeployImage400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+	canarySteps, err := parseCanarySteps(lo.FromPtr(request.Body.CanarySteps))
+	if err != nil {
+		return oapi.DeployImage400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: fmt.Sprintf("invalid canary_steps: %s", err)}}, nil
+	}
+	if len(canarySteps) > 0 {
+		if err := a.checkCanCanary(ctx, app.Id, env.Id); err != nil {
+			return oapi.DeployImage400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+		}
+	}
+
+	cells, err := a.cellStore.GetForTeam(ctx, token.TeamId)
+	if err != nil {
+		return oapi.DeployImage500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to get cells: %s", err)}}, nil
+	}
+	latest, err := a.deploymentStore.GetLatestForAppEnv(ctx, app.Id, env.Id)
+	if err != nil {
+		return oapi.DeployImage500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to get latest deployment: %s", err)}}, nil
+	}
+	cell, cellIds, err := selectUpCells(cells, lo.FromPtr(request.Body.CellId), env, latest)
+	if err != nil {
+		return oapi.DeployImage400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	return customDeployImageResponse{
+		artifactRollout: a.artifactRollout(ctx, token, app, env, canarySteps, cell, cellIds),
+		image:           image,
+		imageName:       request.Body.Image,
+	}, nil
+}
+
+type customDeployImageResponse struct {
+	artifactRollout
+	image     *store.ImageArtifact
+	imageName string
+}
+
+func (c customDeployImageResponse) VisitDeployImageResponse(w http.ResponseWriter) error {
+	log := logger.FromContext(c.ctx).With("appId", c.app.Id, "envId", c.env.Id, "teamId", c.token.TeamId, "image", c.imageName)
+	log.Info("deploying image")
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	// the stream has started, so failures are reported on it for the CLI to exit with an error
+	fw := &flusherWriter{w: w}
+	fmt.Fprintf(fw, "🚀 deploying %s\n", c.imageName)
+	if err := c.deploy(fw, c.image); err != nil {
+		log.Error("failed to deploy image", "error", err)
+		fmt.Fprintf(fw, "error: %s\n", err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/onmetal-dev/metal/lib/store/mock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.jetify.com/typeid"
+)
+
+func TestDeployImage(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	appId := typeid.Must(typeid.WithPrefix("app")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId})
+	cell := store.Cell{Common: store.Common{Id: "cell_fsn1"}, Name: "fsn1"}
+
+	newDeployImageTestAPI := func() api {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetLatestForAppEnv", testifymock.Anything, appId, envId).Return((*store.Deployment)(nil), nil)
+		api.cellStore.(*mock.CellStoreMock).On("GetForTeam", testifymock.Anything, teamId).Return([]store.Cell{cell}, nil)
+		return api
+	}
+
+	t.Run("deploys to the team's only cell", func(t *testing.T) {
+		api := newDeployImageTestAPI()
+
+		resp, err := api.DeployImage(ctx, oapi.DeployImageRequestObject{AppId: appId, EnvId: envId, Body: &oapi.DeployImageJSONRequestBody{Image: "ghcr.io/acme/api:v1.2"}})
+		require.NoError(t, err)
+		deploy, ok := resp.(customDeployImageResponse)
+		require.True(t, ok, "Expected 200 response")
+		assert.Equal(t, store.ImageArtifact{Registry: "ghcr.io", Repository: "acme/api", Tag: "v1.2"}, *deploy.image)
+		assert.Equal(t, cell.Id, deploy.cell.Id)
+		assert.Equal(t, []string{cell.Id}, deploy.cellIds)
+	})
+
+	t.Run("invalid image", func(t *testing.T) {
+		api := newDeployImageTestAPI()
+
+		resp, err := api.DeployImage(ctx, oapi.DeployImageRequestObject{AppId: appId, EnvId: envId, Body: &oapi.DeployImageJSONRequestBody{Image: "ghcr.io/acme/api:"}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.DeployImage400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Contains(t, badReq.Error, "empty tag")
+	})
+
+	t.Run("invalid canary steps", func(t *testing.T) {
+		api := newDeployImageTestAPI()
+
+		resp, err := api.DeployImage(ctx, oapi.DeployImageRequestObject{AppId: appId, EnvId: envId, Body: &oapi.DeployImageJSONRequestBody{Image: "busybox", CanarySteps: lo.ToPtr("50,10")}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.DeployImage400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Contains(t, badReq.Error, "invalid canary_steps")
+	})
+
+	t.Run("other team's app", func(t *testing.T) {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: "team_other"}, nil)
+
+		resp, err := api.DeployImage(ctx, oapi.DeployImageRequestObject{AppId: appId, EnvId: envId, Body: &oapi.DeployImageJSONRequestBody{Image: "busybox"}})
+		require.NoError(t, err)
+		_, ok := resp.(oapi.DeployImage404JSONResponse)
+		require.True(t, ok, "Expected 404 response")
+	})
+}
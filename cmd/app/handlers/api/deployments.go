@@ -0,0 +1,264 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/background/deployment"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+)
+
+func deploymentFromStore(d store.Deployment) oapi.Deployment {
+	deployment := oapi.Deployment{
+		Id:           int(d.Id),
+		AppId:        d.AppId,
+		EnvId:        d.EnvId,
+		TeamId:       d.TeamId,
+		Type:         oapi.DeploymentType(d.Type),
+		Status:       oapi.DeploymentStatus(d.Status),
+		StatusReason: d.StatusReason,
+		Replicas:     d.Replicas,
+		CreatedAt:    d.CreatedAt,
+		UpdatedAt:    d.UpdatedAt,
+	}
+	if d.IsCanary() {
+		deployment.CanarySteps = lo.ToPtr(d.CanarySteps.Data())
+		deployment.CanaryWeight = lo.ToPtr(d.CanaryWeight)
+	}
+	if d.RollbackOf != 0 {
+		deployment.RollbackOf = lo.ToPtr(int(d.RollbackOf))
+	}
+	if len(d.Cells) > 0 {
+		deployment.Cells = lo.ToPtr(lo.Map(d.Cells, func(c store.Cell, _ int) oapi.CellStatus {
+			s := d.CellStatus(c.Id)
+			return oapi.CellStatus{CellId: s.CellId, Status: oapi.DeploymentStatus(s.Status), StatusReason: s.StatusReason}
+		}))
+	}
+	if review := d.Review.Data(); review != nil {
+		deployment.Review = &oapi.DeploymentReview{Approved: review.Approved, Reviewer: review.Reviewer, Comment: review.Comment, ReviewedAt: review.ReviewedAt}
+	}
+	return deployment
+}
+
+// appEnvForTeam fetches an app and env, returning store.ErrAppNotFound or store.ErrEnvNotFound if either doesn't belong to the team
+func (a api) appEnvForTeam(ctx context.Context, teamId string, appId string, envId string) (store.App, store.Env, error) {
+	app, err := a.appStore.Get(ctx, appId)
+	if err != nil {
+		return store.App{}, store.Env{}, err
+	} else if app.TeamId != teamId {
+		return store.App{}, store.Env{}, store.ErrAppNotFound
+	}
+	env, err := a.deploymentStore.GetEnv(envId)
+	if err != nil {
+		return store.App{}, store.Env{}, err
+	} else if env.TeamId != teamId {
+		return store.App{}, store.Env{}, store.ErrEnvNotFound
+	}
+	return app, env, nil
+}
+
+func (a api) enqueueDeployment(ctx context.Context, d store.Deployment) error {
+	return a.producerDeployment.Send(ctx, deployment.Message{
+		DeploymentId: d.Id,
+		AppId:        d.AppId,
+		EnvId:        d.EnvId,
+	})
+}
+
+// runningDeployment returns the deployment running the app in the env, or nil if there is none. Scale and restart change what is running,
+// so they start from it rather than from the latest deployment, which may have failed or still be rolling out
+func (a api) runningDeployment(ctx context.Context, appId string, envId string) (*store.Deployment, error) {
+	deployments, err := a.deploymentStore.GetForAppEnv(ctx, appId, envId)
+	if err != nil {
+		return nil, err
+	}
+	if d, ok := lo.Find(deployments, func(d store.Deployment) bool { return d.Status == store.DeploymentStatusRunning }); ok {
+		return &d, nil
+	}
+	return nil, nil
+}
+
+func (a api) Rollback(ctx context.Context, request oapi.RollbackRequestObject) (oapi.RollbackResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.Rollback404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.Rollback500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	if err := a.checkEnvUnlocked(ctx, env); err != nil {
+		return oapi.Rollback400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	if request.Body.DeploymentId <= 0 {
+		return oapi.Rollback400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: "invalid deployment_id"}}, nil
+	}
+	target, err := a.deploymentStore.Get(app.Id, env.Id, uint(request.Body.DeploymentId))
+	if err != nil {
+		if errors.Is(err, store.ErrDeploymentNotFound) {
+			return oapi.Rollback404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: "deployment not found"}}, nil
+		}
+		return oapi.Rollback500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to get deployment: %s", err)}}, nil
+	} else if target.TeamId != token.TeamId {
+		return oapi.Rollback404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: "deployment not found"}}, nil
+	}
+	if !target.CanRollbackTo() {
+		return oapi.Rollback400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: fmt.Sprintf("cannot roll back to deployment %d with status %s", target.Id, target.Status)}}, nil
+	}
+
+	d, err := a.deploymentStore.Create(store.CreateDeploymentOptions{
+		TeamId:        token.TeamId,
+		EnvId:         env.Id,
+		AppId:         app.Id,
+		Type:          store.DeploymentTypeRollback,
+		Actor:         middleware.Actor(ctx),
+		OverrideLock:  a.overridesLock(ctx),
+		RequestedBy:   middleware.ActorUserId(ctx),
+		AppSettingsId: target.AppSettingsId,
+		AppEnvVarsId:  target.AppEnvVarsId,
+		EnvVarSetId:   target.EnvVarSetId,
+		CellIds:       lo.Map(target.Cells, func(c store.Cell, _ int) string { return c.Id }),
+		Replicas:      target.Replicas,
+	})
+	if err != nil {
+		return oapi.Rollback500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to create deployment: %s", err)}}, nil
+	}
+	if err := a.enqueueDeployment(ctx, d); err != nil {
+		return oapi.Rollback500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to send deployment message to queue: %s", err)}}, nil
+	}
+
+	return oapi.Rollback201JSONResponse(deploymentFromStore(d)), nil
+}
+
+func (a api) Scale(ctx context.Context, request oapi.ScaleRequestObject) (oapi.ScaleResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.Scale404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.Scale500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	if err := a.checkEnvUnlocked(ctx, env); err != nil {
+		return oapi.Scale400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	if request.Body.Replicas < 1 {
+		return oapi.Scale400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: "replicas must be at least 1"}}, nil
+	}
+	running, err := a.runningDeployment(ctx, app.Id, env.Id)
+	if err != nil {
+		return oapi.Scale500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	} else if running == nil {
+		return oapi.Scale400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: "app is not running in this env"}}, nil
+	}
+
+	// apps with their own processes keep per-process replica counts in their settings, so scaling one of them mints new settings
+	appSettingsId := running.AppSettingsId
+	replicas := request.Body.Replicas
+	process := lo.FromPtr(request.Body.Process)
+	if p, ok := lo.Find(running.Processes(), func(p store.Process) bool {
+		return p.Autoscaling != nil && (p.Name == process || !running.AppSettings.HasProcesses())
+	}); ok {
+		return oapi.Scale400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: fmt.Sprintf("process %s autoscales between %d and %d replicas. change or remove its autoscaling instead", p.Name, p.Autoscaling.MinReplicas, p.Autoscaling.MaxReplicas)}}, nil
+	}
+	scaled := store.Processes{{Name: store.DefaultProcessName, Replicas: replicas}}
+	if running.AppSettings.HasProcesses() {
+		if process == "" {
+			return oapi.Scale400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: "process is required since the app defines its own processes"}}, nil
+		}
+		if scaled, err = running.AppSettings.Processes.Data().Scale(process, replicas); err != nil {
+			return oapi.Scale400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+		}
+	} else if process != "" && process != store.DefaultProcessName {
+		return oapi.Scale400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: fmt.Sprintf("process %s does not exist", process)}}, nil
+	}
+	if err := store.ValidateVolumes(running.AppSettings.Volumes.Data(), scaled); err != nil {
+		return oapi.Scale400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+	if running.AppSettings.HasProcesses() {
+		opts := running.AppSettings.CreateOptions()
+		opts.Processes = scaled
+		appSettings, err := a.appStore.CreateAppSettings(opts)
+		if err != nil {
+			return oapi.Scale500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to create app settings: %s", err)}}, nil
+		}
+		appSettingsId = appSettings.Id
+		replicas = running.Replicas
+	}
+
+	d, err := a.deploymentStore.Create(store.CreateDeploymentOptions{
+		TeamId:        token.TeamId,
+		EnvId:         env.Id,
+		AppId:         app.Id,
+		Type:          store.DeploymentTypeScale,
+		Actor:         middleware.Actor(ctx),
+		OverrideLock:  a.overridesLock(ctx),
+		RequestedBy:   middleware.ActorUserId(ctx),
+		AppSettingsId: appSettingsId,
+		AppEnvVarsId:  running.AppEnvVarsId,
+		EnvVarSetId:   running.EnvVarSetId,
+		CellIds:       lo.Map(running.Cells, func(c store.Cell, _ int) string { return c.Id }),
+		Replicas:      replicas,
+	})
+	if err != nil {
+		return oapi.Scale500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to create deployment: %s", err)}}, nil
+	}
+	if err := a.enqueueDeployment(ctx, d); err != nil {
+		return oapi.Scale500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to send deployment message to queue: %s", err)}}, nil
+	}
+
+	return oapi.Scale201JSONResponse(deploymentFromStore(d)), nil
+}
+
+func (a api) Restart(ctx context.Context, request oapi.RestartRequestObject) (oapi.RestartResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.Restart404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.Restart500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	if err := a.checkEnvUnlocked(ctx, env); err != nil {
+		return oapi.Restart400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	running, err := a.runningDeployment(ctx, app.Id, env.Id)
+	if err != nil {
+		return oapi.Restart500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	} else if running == nil {
+		return oapi.Restart400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: "app is not running in this env"}}, nil
+	}
+
+	d, err := a.deploymentStore.Create(store.CreateDeploymentOptions{
+		TeamId:        token.TeamId,
+		EnvId:         env.Id,
+		AppId:         app.Id,
+		Type:          store.DeploymentTypeRestart,
+		Actor:         middleware.Actor(ctx),
+		OverrideLock:  a.overridesLock(ctx),
+		RequestedBy:   middleware.ActorUserId(ctx),
+		AppSettingsId: running.AppSettingsId,
+		AppEnvVarsId:  running.AppEnvVarsId,
+		EnvVarSetId:   running.EnvVarSetId,
+		CellIds:       lo.Map(running.Cells, func(c store.Cell, _ int) string { return c.Id }),
+		Replicas:      running.Replicas,
+	})
+	if err != nil {
+		return oapi.Restart500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to create deployment: %s", err)}}, nil
+	}
+	if err := a.enqueueDeployment(ctx, d); err != nil {
+		return oapi.Restart500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to send deployment message to queue: %s", err)}}, nil
+	}
+
+	return oapi.Restart201JSONResponse(deploymentFromStore(d)), nil
+}
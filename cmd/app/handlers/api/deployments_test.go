@@ -0,0 +1,548 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/onmetal-dev/metal/lib/store/mock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.jetify.com/typeid"
+	"gorm.io/datatypes"
+)
+
+func TestRollback(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	appId := typeid.Must(typeid.WithPrefix("app")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	otherTeamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId})
+
+	t.Run("app not found", func(t *testing.T) {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{}, store.ErrAppNotFound)
+
+		resp, err := api.Rollback(ctx, oapi.RollbackRequestObject{AppId: appId, EnvId: envId, Body: &oapi.RollbackJSONRequestBody{DeploymentId: 1}})
+		require.NoError(t, err)
+		_, ok := resp.(oapi.Rollback404JSONResponse)
+		require.True(t, ok, "Expected 404 response")
+	})
+
+	t.Run("app belongs to another team", func(t *testing.T) {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: otherTeamId}, nil)
+
+		resp, err := api.Rollback(ctx, oapi.RollbackRequestObject{AppId: appId, EnvId: envId, Body: &oapi.RollbackJSONRequestBody{DeploymentId: 1}})
+		require.NoError(t, err)
+		_, ok := resp.(oapi.Rollback404JSONResponse)
+		require.True(t, ok, "Expected 404 response")
+	})
+
+	getTarget := func(target store.Deployment, err error) oapi.RollbackResponseObject {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("Get", appId, envId, uint(3)).Return(target, err)
+
+		resp, rollbackErr := api.Rollback(ctx, oapi.RollbackRequestObject{AppId: appId, EnvId: envId, Body: &oapi.RollbackJSONRequestBody{DeploymentId: 3}})
+		require.NoError(t, rollbackErr)
+		return resp
+	}
+
+	t.Run("deployment not found", func(t *testing.T) {
+		notFound, ok := getTarget(store.Deployment{}, store.ErrDeploymentNotFound).(oapi.Rollback404JSONResponse)
+		require.True(t, ok, "Expected 404 response")
+		assert.Equal(t, "deployment not found", notFound.Error)
+	})
+
+	t.Run("deployment of another team", func(t *testing.T) {
+		notFound, ok := getTarget(store.Deployment{Id: 3, TeamId: "team_other", Status: store.DeploymentStatusStopped}, nil).(oapi.Rollback404JSONResponse)
+		require.True(t, ok, "Expected 404 response")
+		assert.Equal(t, "deployment not found", notFound.Error)
+	})
+
+	t.Run("error getting the deployment", func(t *testing.T) {
+		internalErr, ok := getTarget(store.Deployment{}, errors.New("connection refused")).(oapi.Rollback500JSONResponse)
+		require.True(t, ok, "Expected 500 response")
+		assert.Equal(t, "failed to get deployment: connection refused", internalErr.Error)
+	})
+
+	t.Run("cannot roll back to a failed deployment", func(t *testing.T) {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("Get", appId, envId, uint(2)).Return(store.Deployment{Id: 2, TeamId: teamId, Status: store.DeploymentStatusFailed}, nil)
+
+		resp, err := api.Rollback(ctx, oapi.RollbackRequestObject{AppId: appId, EnvId: envId, Body: &oapi.RollbackJSONRequestBody{DeploymentId: 2}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.Rollback400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Contains(t, badReq.Error, "cannot roll back to deployment 2")
+	})
+}
+
+func TestScale(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	appId := typeid.Must(typeid.WithPrefix("app")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId})
+
+	newScaleTestAPI := func() api {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+		return api
+	}
+
+	t.Run("replicas must be positive", func(t *testing.T) {
+		api := newScaleTestAPI()
+		resp, err := api.Scale(ctx, oapi.ScaleRequestObject{AppId: appId, EnvId: envId, Body: &oapi.ScaleJSONRequestBody{Replicas: 0}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.Scale400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Contains(t, badReq.Error, "replicas must be at least 1")
+	})
+
+	t.Run("process required when the app defines processes", func(t *testing.T) {
+		api := newScaleTestAPI()
+		// the failed deployment after the running one didn't define processes, so scaling starts from the running one
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetForAppEnv", testifymock.Anything, appId, envId).Return([]store.Deployment{
+			{Id: 2, Status: store.DeploymentStatusFailed},
+			{Id: 1, Status: store.DeploymentStatusRunning, AppSettings: store.AppSettings{Processes: datatypes.NewJSONType(store.Processes{{Name: "web", Replicas: 1}, {Name: "worker", Replicas: 1}})}},
+		}, nil)
+		resp, err := api.Scale(ctx, oapi.ScaleRequestObject{AppId: appId, EnvId: envId, Body: &oapi.ScaleJSONRequestBody{Replicas: 3}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.Scale400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Contains(t, badReq.Error, "process is required")
+
+		resp, err = api.Scale(ctx, oapi.ScaleRequestObject{AppId: appId, EnvId: envId, Body: &oapi.ScaleJSONRequestBody{Replicas: 3, Process: lo.ToPtr("cron")}})
+		require.NoError(t, err)
+		badReq, ok = resp.(oapi.Scale400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Contains(t, badReq.Error, "process cron does not exist")
+	})
+
+	testCases := []struct {
+		name        string
+		deployments []store.Deployment
+	}{
+		{"app never deployed", []store.Deployment{}},
+		{"app only failed to deploy", []store.Deployment{{Id: 1, Status: store.DeploymentStatusFailed}}},
+		{"first deployment still rolling out", []store.Deployment{{Id: 1, Status: store.DeploymentStatusDeploying}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := newScaleTestAPI()
+			api.deploymentStore.(*mock.DeploymentStoreMock).On("GetForAppEnv", testifymock.Anything, appId, envId).Return(tc.deployments, nil)
+			resp, err := api.Scale(ctx, oapi.ScaleRequestObject{AppId: appId, EnvId: envId, Body: &oapi.ScaleJSONRequestBody{Replicas: 3}})
+			require.NoError(t, err)
+			badReq, ok := resp.(oapi.Scale400JSONResponse)
+			require.True(t, ok, "Expected 400 response")
+			assert.Equal(t, "app is not running in this env", badReq.Error)
+		})
+	}
+}
+
+func TestRestart(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	appId := typeid.Must(typeid.WithPrefix("app")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId})
+
+	t.Run("env not found", func(t *testing.T) {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{}, store.ErrEnvNotFound)
+		resp, err := api.Restart(ctx, oapi.RestartRequestObject{AppId: appId, EnvId: envId})
+		require.NoError(t, err)
+		_, ok := resp.(oapi.Restart404JSONResponse)
+		require.True(t, ok, "Expected 404 response")
+	})
+
+	testCases := []struct {
+		name        string
+		deployments []store.Deployment
+	}{
+		{"app never deployed", []store.Deployment{}},
+		{"app only failed to deploy", []store.Deployment{{Id: 1, Status: store.DeploymentStatusFailed}}},
+		{"previous deployment was superseded by one still rolling out", []store.Deployment{{Id: 2, Status: store.DeploymentStatusDeploying}, {Id: 1, Status: store.DeploymentStatusStopped}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := newTestAPI()
+			api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+			api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+			api.deploymentStore.(*mock.DeploymentStoreMock).On("GetForAppEnv", testifymock.Anything, appId, envId).Return(tc.deployments, nil)
+			resp, err := api.Restart(ctx, oapi.RestartRequestObject{AppId: appId, EnvId: envId})
+			require.NoError(t, err)
+			badReq, ok := resp.(oapi.Restart400JSONResponse)
+			require.True(t, ok, "Expected 400 response")
+			assert.Equal(t, "app is not running in this env", badReq.Error)
+		})
+	}
+}
+
+func TestUpdateHealthCheck(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	appId := typeid.Must(typeid.WithPrefix("app")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId})
+
+	testCases := []struct {
+		name        string
+		healthCheck oapi.HealthCheck
+		errMsg      string
+	}{
+		{"relative path", oapi.HealthCheck{Path: lo.ToPtr("healthz"), PortName: "http"}, "invalid health_check"},
+		{"unknown port", oapi.HealthCheck{Path: lo.ToPtr("/healthz"), PortName: "grpc"}, "references port grpc, which none of the processes have"},
+		{"http port without a path", oapi.HealthCheck{PortName: "http"}, "health check of http port http needs a path or a command"},
+		{"tcp port with a path", oapi.HealthCheck{Path: lo.ToPtr("/healthz"), PortName: "postgres"}, "health check of tcp port postgres can't have a path"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := newTestAPI()
+			api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+			api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+			api.deploymentStore.(*mock.DeploymentStoreMock).On("GetLatestForAppEnv", testifymock.Anything, appId, envId).Return(&store.Deployment{
+				AppSettings: store.AppSettings{Ports: datatypes.NewJSONType(store.Ports{{Name: "http", Port: 8080, Proto: "http"}, {Name: "postgres", Port: 5432, Proto: "tcp"}})},
+			}, nil)
+
+			resp, err := api.UpdateHealthCheck(ctx, oapi.UpdateHealthCheckRequestObject{AppId: appId, EnvId: envId, Body: &oapi.UpdateHealthCheckJSONRequestBody{HealthCheck: &tc.healthCheck}})
+			require.NoError(t, err)
+			badReq, ok := resp.(oapi.UpdateHealthCheck400JSONResponse)
+			require.True(t, ok, "Expected 400 response")
+			assert.Contains(t, badReq.Error, tc.errMsg)
+		})
+	}
+}
+
+func TestUpdateAutoscaling(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	appId := typeid.Must(typeid.WithPrefix("app")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId})
+
+	worker := store.Processes{{Name: "worker", Replicas: 1}}
+	testCases := []struct {
+		name        string
+		processes   store.Processes
+		process     *string
+		autoscaling oapi.Autoscaling
+		errMsg      string
+	}{
+		{"no target", nil, nil, oapi.Autoscaling{MinReplicas: 1, MaxReplicas: 3}, "invalid autoscaling"},
+		{"max below min", nil, nil, oapi.Autoscaling{MinReplicas: 3, MaxReplicas: 2, TargetCpuUtilizationPercent: lo.ToPtr(70)}, "invalid autoscaling"},
+		{"zero min", nil, nil, oapi.Autoscaling{MinReplicas: 0, MaxReplicas: 2, TargetMemoryUtilizationPercent: lo.ToPtr(80)}, "invalid autoscaling"},
+		{"unknown process", nil, lo.ToPtr("worker"), oapi.Autoscaling{MinReplicas: 1, MaxReplicas: 3, TargetCpuUtilizationPercent: lo.ToPtr(70)}, "process worker does not exist"},
+		{"process required", worker, nil, oapi.Autoscaling{MinReplicas: 1, MaxReplicas: 3, TargetCpuUtilizationPercent: lo.ToPtr(70)}, "process is required"},
+		{"unknown process of many", worker, lo.ToPtr("web"), oapi.Autoscaling{MinReplicas: 1, MaxReplicas: 3, TargetCpuUtilizationPercent: lo.ToPtr(70)}, "process web does not exist"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := newTestAPI()
+			api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+			api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+			api.deploymentStore.(*mock.DeploymentStoreMock).On("GetLatestForAppEnv", testifymock.Anything, appId, envId).Return(&store.Deployment{
+				AppSettings: store.AppSettings{Processes: datatypes.NewJSONType(tc.processes)},
+			}, nil)
+
+			resp, err := api.UpdateAutoscaling(ctx, oapi.UpdateAutoscalingRequestObject{AppId: appId, EnvId: envId, Body: &oapi.UpdateAutoscalingJSONRequestBody{Process: tc.process, Autoscaling: &tc.autoscaling}})
+			require.NoError(t, err)
+			badReq, ok := resp.(oapi.UpdateAutoscaling400JSONResponse)
+			require.True(t, ok, "Expected 400 response")
+			assert.Contains(t, badReq.Error, tc.errMsg)
+		})
+	}
+
+	t.Run("scaling an autoscaled process", func(t *testing.T) {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetForAppEnv", testifymock.Anything, appId, envId).Return([]store.Deployment{{
+			Status:      store.DeploymentStatusRunning,
+			AppSettings: store.AppSettings{Autoscaling: datatypes.NewJSONType(&store.Autoscaling{MinReplicas: 2, MaxReplicas: 5, TargetCPUUtilizationPercent: 70})},
+		}}, nil)
+
+		resp, err := api.Scale(ctx, oapi.ScaleRequestObject{AppId: appId, EnvId: envId, Body: &oapi.ScaleJSONRequestBody{Replicas: 3}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.Scale400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Contains(t, badReq.Error, "autoscales between 2 and 5 replicas")
+	})
+}
+
+func TestUpdateVolumes(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	appId := typeid.Must(typeid.WithPrefix("app")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId})
+
+	testCases := []struct {
+		name     string
+		replicas int
+		volumes  []oapi.Volume
+		errMsg   string
+	}{
+		{"relative mount path", 1, []oapi.Volume{{Name: "data", MountPath: "data", SizeGib: 1}}, "invalid volume data"},
+		{"zero size", 1, []oapi.Volume{{Name: "data", MountPath: "/data", SizeGib: 0}}, "invalid volume data"},
+		{"duplicate name", 1, []oapi.Volume{{Name: "data", MountPath: "/data", SizeGib: 1}, {Name: "data", MountPath: "/other", SizeGib: 1}}, "defined more than once"},
+		{"duplicate mount path", 1, []oapi.Volume{{Name: "data", MountPath: "/data", SizeGib: 1}, {Name: "other", MountPath: "/data", SizeGib: 1}}, "more than one volume is mounted at /data"},
+		{"unknown process", 1, []oapi.Volume{{Name: "data", MountPath: "/data", SizeGib: 1, Process: lo.ToPtr("worker")}}, "process worker, which does not exist"},
+		{"more than one replica", 2, []oapi.Volume{{Name: "data", MountPath: "/data", SizeGib: 1}}, "can't run more than one replica"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := newTestAPI()
+			api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+			api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+			api.deploymentStore.(*mock.DeploymentStoreMock).On("GetLatestForAppEnv", testifymock.Anything, appId, envId).Return(&store.Deployment{Replicas: tc.replicas}, nil)
+
+			resp, err := api.UpdateVolumes(ctx, oapi.UpdateVolumesRequestObject{AppId: appId, EnvId: envId, Body: &oapi.UpdateVolumesJSONRequestBody{Volumes: tc.volumes}})
+			require.NoError(t, err)
+			badReq, ok := resp.(oapi.UpdateVolumes400JSONResponse)
+			require.True(t, ok, "Expected 400 response")
+			assert.Contains(t, badReq.Error, tc.errMsg)
+		})
+	}
+
+	t.Run("scaling a process with a volume", func(t *testing.T) {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetForAppEnv", testifymock.Anything, appId, envId).Return([]store.Deployment{{
+			Status:      store.DeploymentStatusRunning,
+			Replicas:    1,
+			AppSettings: store.AppSettings{Volumes: datatypes.NewJSONType(store.Volumes{{Name: "data", MountPath: "/data", SizeGiB: 1}})},
+		}}, nil)
+
+		resp, err := api.Scale(ctx, oapi.ScaleRequestObject{AppId: appId, EnvId: envId, Body: &oapi.ScaleJSONRequestBody{Replicas: 2}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.Scale400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Contains(t, badReq.Error, "mounts volume data")
+	})
+
+	t.Run("deleting an app with volumes needs confirmation", func(t *testing.T) {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetForApp", testifymock.Anything, appId).Return([]store.Deployment{{
+			AppSettings: store.AppSettings{Volumes: datatypes.NewJSONType(store.Volumes{{Name: "data", MountPath: "/data", SizeGiB: 1}})},
+		}}, nil)
+
+		resp, err := api.DeleteApp(ctx, oapi.DeleteAppRequestObject{AppId: appId})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.DeleteApp400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Contains(t, badReq.Error, "delete_volumes=true")
+		api.appStore.(*mock.AppStoreMock).AssertNotCalled(t, "Delete", testifymock.Anything, appId)
+	})
+}
+
+func TestUpdateReleaseCommand(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	appId := typeid.Must(typeid.WithPrefix("app")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId})
+
+	t.Run("app never deployed", func(t *testing.T) {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetLatestForAppEnv", testifymock.Anything, appId, envId).Return((*store.Deployment)(nil), nil)
+		resp, err := api.UpdateReleaseCommand(ctx, oapi.UpdateReleaseCommandRequestObject{AppId: appId, EnvId: envId, Body: &oapi.UpdateReleaseCommandJSONRequestBody{ReleaseCommand: "./migrate up"}})
+		require.NoError(t, err)
+		_, ok := resp.(oapi.UpdateReleaseCommand400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+	})
+}
+
+func TestUpdateProcesses(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	appId := typeid.Must(typeid.WithPrefix("app")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId})
+
+	httpPort := []oapi.Port{{Name: "http", Port: 8080, Proto: oapi.PortProtoHttp}}
+	testCases := []struct {
+		name      string
+		processes []oapi.Process
+		errMsg    string
+	}{
+		{"duplicate process", []oapi.Process{{Name: "web", Replicas: 1, Ports: &httpPort}, {Name: "web", Replicas: 1}}, "process web is defined more than once"},
+		{"duplicate port", []oapi.Process{{Name: "web", Replicas: 1, Ports: &httpPort}, {Name: "admin", Replicas: 1, Ports: &httpPort}}, "port http is used by more than one process"},
+		{"invalid name", []oapi.Process{{Name: "Web", Replicas: 1, Ports: &httpPort}}, "invalid process Web"},
+		{"external port without a process", []oapi.Process{{Name: "worker", Replicas: 1}}, "external port expose-8080 references port http"},
+		{"external port on a port of another protocol", []oapi.Process{{Name: "web", Replicas: 1, Ports: &[]oapi.Port{{Name: "http", Port: 8080, Proto: oapi.PortProtoTcp}}}}, "port http has to be http, not tcp"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := newTestAPI()
+			api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+			api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+			api.deploymentStore.(*mock.DeploymentStoreMock).On("GetLatestForAppEnv", testifymock.Anything, appId, envId).Return(&store.Deployment{
+				AppSettings: store.AppSettings{
+					Ports:         datatypes.NewJSONType(store.Ports{{Name: "http", Port: 8080, Proto: "http"}}),
+					ExternalPorts: datatypes.NewJSONType(store.ExternalPorts{{Name: "expose-8080", PortName: "http", Port: 443, Proto: "https"}}),
+				},
+			}, nil)
+
+			resp, err := api.UpdateProcesses(ctx, oapi.UpdateProcessesRequestObject{AppId: appId, EnvId: envId, Body: &oapi.UpdateProcessesJSONRequestBody{Processes: tc.processes}})
+			require.NoError(t, err)
+			badReq, ok := resp.(oapi.UpdateProcesses400JSONResponse)
+			require.True(t, ok, "Expected 400 response")
+			assert.Contains(t, badReq.Error, tc.errMsg)
+		})
+	}
+}
+
+func TestUpdateExternalPorts(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	appId := typeid.Must(typeid.WithPrefix("app")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId})
+
+	testCases := []struct {
+		name          string
+		externalPorts []oapi.ExternalPort
+		errMsg        string
+	}{
+		{"invalid port", []oapi.ExternalPort{{Name: "db", PortName: "postgres", Port: 70000, Proto: oapi.ExternalPortProtoTcp}}, "invalid external port db"},
+		{"duplicate name", []oapi.ExternalPort{{Name: "db", PortName: "postgres", Port: 5432, Proto: oapi.ExternalPortProtoTcp}, {Name: "db", PortName: "postgres", Port: 5433, Proto: oapi.ExternalPortProtoTcp}}, "defined more than once"},
+		{"unknown port", []oapi.ExternalPort{{Name: "db", PortName: "mysql", Port: 3306, Proto: oapi.ExternalPortProtoTcp}}, "references port mysql"},
+		{"grpc on a tcp port", []oapi.ExternalPort{{Name: "api", PortName: "postgres", Port: 443, Proto: oapi.ExternalPortProtoGrpc}}, "port postgres has to be grpc, not tcp"},
+		{"tcp on a gateway port", []oapi.ExternalPort{{Name: "db", PortName: "postgres", Port: 443, Proto: oapi.ExternalPortProtoTcp}}, "can't use port 443"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := newTestAPI()
+			api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+			api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+			api.deploymentStore.(*mock.DeploymentStoreMock).On("GetLatestForAppEnv", testifymock.Anything, appId, envId).Return(&store.Deployment{
+				AppSettings: store.AppSettings{
+					Ports: datatypes.NewJSONType(store.Ports{{Name: "postgres", Port: 5432, Proto: "tcp"}}),
+				},
+			}, nil)
+
+			resp, err := api.UpdateExternalPorts(ctx, oapi.UpdateExternalPortsRequestObject{AppId: appId, EnvId: envId, Body: &oapi.UpdateExternalPortsJSONRequestBody{ExternalPorts: tc.externalPorts}})
+			require.NoError(t, err)
+			badReq, ok := resp.(oapi.UpdateExternalPorts400JSONResponse)
+			require.True(t, ok, "Expected 400 response")
+			assert.Contains(t, badReq.Error, tc.errMsg)
+		})
+	}
+}
+
+func TestUpdateDependencies(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	appId := typeid.Must(typeid.WithPrefix("app")).String()
+	dbAppId := typeid.Must(typeid.WithPrefix("app")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId})
+
+	testCases := []struct {
+		name         string
+		dependencies []string
+		dbDependsOn  []string
+		errMsg       string
+	}{
+		{"itself", []string{"api"}, nil, "can't depend on itself"},
+		{"duplicate", []string{"db", "db"}, nil, "listed more than once"},
+		{"unknown app", []string{"cache"}, nil, "dependency cache is not an app of the team"},
+		{"cycle", []string{"db"}, []string{"api"}, "can't depend on db, which depends on it already"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := newTestAPI()
+			app := store.App{Common: store.Common{Id: appId}, TeamId: teamId, Name: "api"}
+			dbApp := store.App{Common: store.Common{Id: dbAppId}, TeamId: teamId, Name: "db"}
+			api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(app, nil)
+			api.appStore.(*mock.AppStoreMock).On("GetForTeam", testifymock.Anything, teamId).Return([]store.App{app, dbApp}, nil)
+			api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+			api.deploymentStore.(*mock.DeploymentStoreMock).On("GetLatestForAppEnv", testifymock.Anything, appId, envId).Return(&store.Deployment{Id: 1, AppId: appId, App: app}, nil)
+			api.deploymentStore.(*mock.DeploymentStoreMock).On("GetForEnv", envId).Return([]store.Deployment{
+				{Id: 1, AppId: appId, App: app},
+				{Id: 2, AppId: dbAppId, App: dbApp, AppSettings: store.AppSettings{Dependencies: datatypes.NewJSONType(tc.dbDependsOn)}},
+			}, nil)
+
+			resp, err := api.UpdateDependencies(ctx, oapi.UpdateDependenciesRequestObject{AppId: appId, EnvId: envId, Body: &oapi.UpdateDependenciesJSONRequestBody{Dependencies: tc.dependencies}})
+			require.NoError(t, err)
+			badReq, ok := resp.(oapi.UpdateDependencies400JSONResponse)
+			require.True(t, ok, "Expected 400 response")
+			assert.Contains(t, badReq.Error, tc.errMsg)
+		})
+	}
+}
+
+func TestDiffDeployment(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	appId := typeid.Must(typeid.WithPrefix("app")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId})
+	latest := &store.Deployment{
+		Id:       3,
+		AppId:    appId,
+		EnvId:    envId,
+		Replicas: 1,
+		AppSettings: store.AppSettings{
+			Artifact: datatypes.NewJSONType(store.Artifact{Image: &store.ImageArtifact{Registry: "ghcr.io", Repository: "acme/api", Tag: "v1"}}),
+			Ports:    datatypes.NewJSONType(store.Ports{{Name: "http", Port: 8080, Proto: "http"}}),
+		},
+		AppEnvVars: store.AppEnvVars{EnvVars: datatypes.NewJSONType([]store.EnvVar{{Name: "DATABASE_URL", Value: "postgres://secret"}, {Name: "DEBUG", Value: "1"}})},
+	}
+
+	testCases := []struct {
+		name    string
+		body    oapi.DiffDeploymentJSONRequestBody
+		errMsg  string
+		changes []oapi.DeploymentChange
+	}{
+		{name: "invalid image", body: oapi.DiffDeploymentJSONRequestBody{Image: lo.ToPtr("ghcr.io/acme/api:")}, errMsg: "invalid image"},
+		{name: "unset missing env var", body: oapi.DiffDeploymentJSONRequestBody{UnsetEnvVars: &[]string{"NOPE"}}, errMsg: "env var NOPE is not set"},
+		{name: "health check on unknown port", body: oapi.DiffDeploymentJSONRequestBody{HealthCheck: &oapi.HealthCheck{Path: lo.ToPtr("/healthz"), PortName: "grpc"}}, errMsg: "references port grpc, which none of the processes have"},
+		{name: "no changes", body: oapi.DiffDeploymentJSONRequestBody{}, changes: []oapi.DeploymentChange{}},
+		{
+			name: "image, replicas and env vars",
+			body: oapi.DiffDeploymentJSONRequestBody{
+				Image:        lo.ToPtr("ghcr.io/acme/api:v2"),
+				Replicas:     lo.ToPtr(3),
+				SetEnvVars:   &[]oapi.EnvVar{{Name: "DATABASE_URL", Value: "postgres://other"}, {Name: "DEBUG", Value: "1"}, {Name: "API_KEY", Value: "hunter2"}},
+				UnsetEnvVars: &[]string{"DEBUG"},
+			},
+			changes: []oapi.DeploymentChange{
+				{Field: "image", From: lo.ToPtr("ghcr.io/acme/api:v1"), To: lo.ToPtr("ghcr.io/acme/api:v2")},
+				{Field: "replicas", From: lo.ToPtr("1"), To: lo.ToPtr("3")},
+				{Field: "env.API_KEY", To: lo.ToPtr("********")},
+				{Field: "env.DATABASE_URL", From: lo.ToPtr("********"), To: lo.ToPtr("********")},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := newTestAPI()
+			api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+			api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+			api.deploymentStore.(*mock.DeploymentStoreMock).On("GetLatestForAppEnv", testifymock.Anything, appId, envId).Return(latest, nil)
+
+			resp, err := api.DiffDeployment(ctx, oapi.DiffDeploymentRequestObject{AppId: appId, EnvId: envId, Body: &tc.body})
+			require.NoError(t, err)
+			if tc.errMsg != "" {
+				badReq, ok := resp.(oapi.DiffDeployment400JSONResponse)
+				require.True(t, ok, "Expected 400 response")
+				assert.Contains(t, badReq.Error, tc.errMsg)
+				return
+			}
+			diff, ok := resp.(oapi.DiffDeployment200JSONResponse)
+			require.True(t, ok, "Expected 200 response, got %#v", resp)
+			assert.Equal(t, tc.changes, diff.Changes)
+			// the latest deployment isn't on a cell, so there is nothing to plan there
+			assert.Empty(t, diff.Objects)
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/background/deployment"
+	"github.com/onmetal-dev/metal/lib/cellprovider"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/onmetal-dev/metal/lib/validate"
+	"github.com/samber/lo"
+	"gorm.io/datatypes"
+)
+
+// withEnvVarChanges returns envVars with set added or changed and the ones named in unset removed
+func withEnvVarChanges(envVars []store.EnvVar, set []oapi.EnvVar, unset []string) ([]store.EnvVar, error) {
+	result := append([]store.EnvVar{}, envVars...)
+	for _, name := range unset {
+		if !lo.ContainsBy(result, func(e store.EnvVar) bool { return e.Name == name }) {
+			return nil, fmt.Errorf("env var %s is not set", name)
+		}
+		result = lo.Reject(result, func(e store.EnvVar, _ int) bool { return e.Name == name })
+	}
+	for _, envVar := range set {
+		if envVar.Name == "" {
+			return nil, fmt.Errorf("env vars need a name")
+		}
+		if i := lo.IndexOf(lo.Map(result, func(e store.EnvVar, _ int) string { return e.Name }), envVar.Name); i >= 0 {
+			result[i].Value = envVar.Value
+		} else {
+			result = append(result, store.EnvVar{Name: envVar.Name, Value: envVar.Value})
+		}
+	}
+	return result, nil
+}
+
+// plannedDeployment applies the changes of a diff request to the latest deployment, checking the result the way the update endpoints do
+func (a api) plannedDeployment(ctx context.Context, teamId string, app store.App, env store.Env, latest *store.Deployment, body *oapi.DiffDeploymentJSONRequestBody) (store.Deployment, error) {
+	planned := *latest
+	settings := latest.AppSettings
+	if body.Image != nil {
+		image, err := store.ParseImageArtifact(*body.Image)
+		if err != nil {
+			return planned, err
+		}
+		settings.Artifact = datatypes.NewJSONType(store.Artifact{Image: image})
+	}
+	if body.Replicas != nil {
+		if *body.Replicas < 1 {
+			return planned, fmt.Errorf("replicas must be at least 1")
+		} else if settings.HasProcesses() {
+			return planned, fmt.Errorf("the app defines its own processes, so replicas are set per process")
+		}
+		planned.Replicas = *body.Replicas
+	}
+	if body.ReleaseCommand != nil {
+		settings.ReleaseCommand = *body.ReleaseCommand
+	}
+	if body.HealthCheck != nil {
+		healthCheck := healthCheckToStore(body.HealthCheck)
+		if err := validate.Struct(healthCheck); err != nil {
+			return planned, fmt.Errorf("invalid health_check: %s", err)
+		}
+		settings.HealthCheck = datatypes.NewJSONType(healthCheck)
+	}
+	if body.Processes != nil {
+		settings.Processes = datatypes.NewJSONType(processesToStore(*body.Processes))
+	}
+	if body.ExternalPorts != nil {
+		externalPorts := externalPortsToStore(*body.ExternalPorts)
+		for _, e := range externalPorts {
+			if err := validate.Struct(e); err != nil {
+				return planned, fmt.Errorf("invalid external port %s: %s", e.Name, err)
+			}
+		}
+		settings.ExternalPorts = datatypes.NewJSONType(externalPorts)
+	}
+	if body.Volumes != nil {
+		volumes := volumesToStore(*body.Volumes)
+		for _, v := range volumes {
+			if err := validate.Struct(v); err != nil {
+				return planned, fmt.Errorf("invalid volume %s: %s", v.Name, err)
+			}
+		}
+		settings.Volumes = datatypes.NewJSONType(volumes)
+	}
+	if body.Dependencies != nil {
+		settings.Dependencies = datatypes.NewJSONType(*body.Dependencies)
+	}
+	planned.AppSettings = settings
+
+	if settings.HasProcesses() {
+		if err := validateProcesses(settings.Processes.Data(), settings); err != nil {
+			return planned, err
+		}
+	} else if err := store.ValidateExternalPorts(settings.ExternalPorts.Data(), settings.AllPorts()); err != nil {
+		return planned, err
+	}
+	if healthCheck := settings.HealthCheck.Data(); healthCheck != nil {
+		if err := store.ValidateHealthCheck(healthCheck, settings.AllPorts()); err != nil {
+			return planned, err
+		}
+	}
+	if err := store.ValidateVolumes(settings.Volumes.Data(), planned.Processes()); err != nil {
+		return planned, err
+	}
+	if body.Dependencies != nil {
+		appNames, dependenciesOf, err := a.dependenciesOfOtherApps(ctx, teamId, app, env)
+		if err != nil {
+			return planned, fmt.Errorf("error checking dependencies: %w", err)
+		}
+		if err := store.ValidateDependencies(app.Name, *body.Dependencies, appNames, dependenciesOf); err != nil {
+			return planned, err
+		}
+	}
+
+	envVars, err := withEnvVarChanges(latest.AppEnvVars.EnvVars.Data(), lo.FromPtr(body.SetEnvVars), lo.FromPtr(body.UnsetEnvVars))
+	if err != nil {
+		return planned, err
+	}
+	planned.AppEnvVars.EnvVars = datatypes.NewJSONType(envVars)
+	// a new deployment picks up the env vars shared by the env's apps as they are now
+	if env.EnvVarSetId != "" && env.EnvVarSetId != latest.EnvVarSetId {
+		envVarSet, err := a.deploymentStore.GetEnvVarSet(env.EnvVarSetId)
+		if err != nil {
+			return planned, fmt.Errorf("error fetching the env's shared env vars: %w", err)
+		}
+		planned.EnvVarSetId, planned.EnvVarSet = envVarSet.Id, envVarSet
+	}
+	return planned, nil
+}
+
+func planFromDeployment(plan *deployment.Plan) oapi.DeploymentDiff {
+	return oapi.DeploymentDiff{
+		Changes: lo.Map(plan.Changes, func(c deployment.Change, _ int) oapi.DeploymentChange {
+			return oapi.DeploymentChange{Field: c.Field, From: lo.EmptyableToPtr(c.From), To: lo.EmptyableToPtr(c.To)}
+		}),
+		Objects: lo.Map(plan.Objects, func(o cellprovider.PlannedObject, _ int) oapi.PlannedObject {
+			return oapi.PlannedObject{Kind: o.Kind, Name: o.Name, Action: oapi.PlannedObjectAction(o.Action), Diff: lo.EmptyableToPtr(o.Diff)}
+		}),
+	}
+}
+
+func (a api) DiffDeployment(ctx context.Context, request oapi.DiffDeploymentRequestObject) (oapi.DiffDeploymentResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.DiffDeployment404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.DiffDeployment500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+
+	latest, err := a.deploymentStore.GetLatestForAppEnv(ctx, app.Id, env.Id)
+	if err != nil {
+		return oapi.DiffDeployment500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	} else if latest == nil {
+		return oapi.DiffDeployment400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: "app has not been deployed to this env"}}, nil
+	}
+
+	body := lo.FromPtr(request.Body)
+	planned, err := a.plannedDeployment(ctx, token.TeamId, app, env, latest, &body)
+	if err != nil {
+		return oapi.DiffDeployment400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+	plan, err := deployment.PlanDeployment(ctx, a.deploymentStore, a.cellStore, a.cellProviderForType, latest, planned)
+	if err != nil {
+		return oapi.DiffDeployment500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	return oapi.DiffDeployment200JSONResponse(planFromDeployment(plan)), nil
+}
@@ -0,0 +1,187 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/background/deployment"
+	"github.com/onmetal-dev/metal/lib/cellprovider"
+	"github.com/onmetal-dev/metal/lib/domainverify"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/onmetal-dev/metal/lib/validate"
+	"github.com/samber/lo"
+)
+
+func domainFromStore(d store.Domain, cnameTargets []string, certificate cellprovider.DomainCertificate) oapi.Domain {
+	return oapi.Domain{
+		Id:       d.Id,
+		AppId:    d.AppId,
+		EnvId:    d.EnvId,
+		Hostname: d.Hostname,
+		Verification: oapi.DomainVerification{
+			Status:         oapi.DomainVerificationStatus(d.VerificationStatus),
+			StatusReason:   d.VerificationStatusReason,
+			TxtRecordName:  domainverify.TXTRecordName(d.Hostname),
+			TxtRecordValue: domainverify.TXTRecordValue(d.VerificationToken),
+			CnameTargets:   lo.Ternary(cnameTargets == nil, []string{}, cnameTargets),
+			VerifiedAt:     d.VerifiedAt,
+		},
+		Certificate: oapi.DomainCertificate{
+			Status:       oapi.DomainCertificateStatus(certificate.Status),
+			StatusReason: certificate.StatusReason,
+			ExpiresAt:    certificate.ExpiresAt,
+		},
+		CreatedAt: d.CreatedAt,
+	}
+}
+
+// domainsFromStore adds what verifying the domains takes and the state of their certificates
+func (a api) domainsFromStore(ctx context.Context, teamId string, app store.App, env store.Env, domains []store.Domain) ([]oapi.Domain, error) {
+	cnameTargets, err := deployment.DomainCNAMETargets(ctx, a.cellStore, a.cellProviderForType, teamId, app, env)
+	if err != nil {
+		return nil, err
+	}
+	certificates, err := deployment.DomainCertificates(ctx, a.cellStore, a.cellProviderForType, teamId, domains)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(domains, func(d store.Domain, i int) oapi.Domain { return domainFromStore(d, cnameTargets, certificates[i]) }), nil
+}
+
+// domainForAppEnv fetches a domain by hostname, making sure it belongs to the app and env
+func (a api) domainForAppEnv(ctx context.Context, app store.App, env store.Env, hostname string) (store.Domain, error) {
+	domain, err := a.domainStore.GetByHostname(ctx, strings.ToLower(hostname))
+	if err != nil {
+		return store.Domain{}, err
+	} else if domain.AppId != app.Id || domain.EnvId != env.Id {
+		return store.Domain{}, store.ErrDomainNotFound
+	}
+	return domain, nil
+}
+
+func (a api) GetDomains(ctx context.Context, request oapi.GetDomainsRequestObject) (oapi.GetDomainsResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.GetDomains404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.GetDomains500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+
+	domains, err := a.domainStore.GetForAppEnv(ctx, app.Id, env.Id)
+	if err != nil {
+		return oapi.GetDomains500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	result, err := a.domainsFromStore(ctx, token.TeamId, app, env, domains)
+	if err != nil {
+		return oapi.GetDomains500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	return oapi.GetDomains200JSONResponse(result), nil
+}
+
+func (a api) CreateDomain(ctx context.Context, request oapi.CreateDomainRequestObject) (oapi.CreateDomainResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.CreateDomain404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.CreateDomain500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+
+	opts := store.CreateDomainOptions{
+		TeamId:   token.TeamId,
+		AppId:    app.Id,
+		EnvId:    env.Id,
+		Hostname: strings.ToLower(strings.TrimSuffix(strings.TrimSpace(request.Body.Hostname), ".")),
+	}
+	if err := validate.Struct(opts); err != nil {
+		return oapi.CreateDomain400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+	if opts.Hostname == "onmetal.run" || strings.HasSuffix(opts.Hostname, ".onmetal.run") {
+		return oapi.CreateDomain400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: "onmetal.run hostnames can't be added as custom domains"}}, nil
+	}
+	if _, err := a.domainStore.GetByHostname(ctx, opts.Hostname); err == nil {
+		return oapi.CreateDomain400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: fmt.Sprintf("domain %s is already in use", opts.Hostname)}}, nil
+	} else if !errors.Is(err, store.ErrDomainNotFound) {
+		return oapi.CreateDomain500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+
+	domain, err := a.domainStore.Create(ctx, opts)
+	if err != nil {
+		return oapi.CreateDomain500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	result, err := a.domainsFromStore(ctx, token.TeamId, app, env, []store.Domain{domain})
+	if err != nil {
+		return oapi.CreateDomain500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	return oapi.CreateDomain201JSONResponse(result[0]), nil
+}
+
+func (a api) VerifyDomain(ctx context.Context, request oapi.VerifyDomainRequestObject) (oapi.VerifyDomainResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.VerifyDomain404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.VerifyDomain500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+
+	domain, err := a.domainForAppEnv(ctx, app, env, request.Hostname)
+	if err != nil {
+		if errors.Is(err, store.ErrDomainNotFound) {
+			return oapi.VerifyDomain404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.VerifyDomain500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	domain, err = deployment.VerifyDomain(ctx, a.domainStore, a.deploymentStore, a.cellStore, a.cellProviderForType, a.domainResolver, domain)
+	if err != nil {
+		if domain.Verified() {
+			return oapi.VerifyDomain500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("domain verified but failed to sync it to the cells: %s", err)}}, nil
+		}
+		return oapi.VerifyDomain500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	result, err := a.domainsFromStore(ctx, token.TeamId, app, env, []store.Domain{domain})
+	if err != nil {
+		return oapi.VerifyDomain500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	return oapi.VerifyDomain200JSONResponse(result[0]), nil
+}
+
+func (a api) DeleteDomain(ctx context.Context, request oapi.DeleteDomainRequestObject) (oapi.DeleteDomainResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.DeleteDomain404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.DeleteDomain500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+
+	domain, err := a.domainForAppEnv(ctx, app, env, request.Hostname)
+	if err != nil {
+		if errors.Is(err, store.ErrDomainNotFound) {
+			return oapi.DeleteDomain404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.DeleteDomain500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	if err := a.domainStore.Delete(ctx, domain.Id); err != nil {
+		return oapi.DeleteDomain500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	if domain.Verified() {
+		if err := deployment.SyncDomains(ctx, a.deploymentStore, a.cellStore, a.cellProviderForType, token.TeamId, app.Id, env.Id); err != nil {
+			return oapi.DeleteDomain500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("domain deleted but failed to remove it from the cells: %s", err)}}, nil
+		}
+	}
+	return oapi.DeleteDomain204Response{}, nil
+}
@@ -0,0 +1,148 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/onmetal-dev/metal/lib/store/mock"
+	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.jetify.com/typeid"
+)
+
+type fakeResolver struct {
+	txts map[string][]string
+}
+
+func (r fakeResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	txts, ok := r.txts[name]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return txts, nil
+}
+
+func (r fakeResolver) LookupCNAME(ctx context.Context, host string) (string, error) {
+	return "", errors.New("no such host")
+}
+
+func TestDomains(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	appId := typeid.Must(typeid.WithPrefix("app")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId})
+	pending := store.Domain{
+		Common:             store.Common{Id: "domain_1"},
+		TeamId:             teamId,
+		AppId:              appId,
+		EnvId:              envId,
+		Hostname:           "www.example.com",
+		VerificationToken:  "abc123",
+		VerificationStatus: store.DomainVerificationStatusPending,
+	}
+
+	newDomainTestAPI := func() api {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+		api.cellStore.(*mock.CellStoreMock).On("GetForTeam", testifymock.Anything, teamId).Return([]store.Cell{}, nil)
+		return api
+	}
+
+	t.Run("create rejects invalid hostnames", func(t *testing.T) {
+		for _, hostname := range []string{"not a hostname", "localhost", "myapp-production.cell-1.up.onmetal.run"} {
+			api := newDomainTestAPI()
+			resp, err := api.CreateDomain(ctx, oapi.CreateDomainRequestObject{AppId: appId, EnvId: envId, Body: &oapi.CreateDomainJSONRequestBody{Hostname: hostname}})
+			require.NoError(t, err)
+			_, ok := resp.(oapi.CreateDomain400JSONResponse)
+			require.True(t, ok, "Expected 400 response for %s", hostname)
+		}
+	})
+
+	t.Run("create rejects hostnames in use", func(t *testing.T) {
+		api := newDomainTestAPI()
+		api.domainStore.(*mock.DomainStoreMock).On("GetByHostname", testifymock.Anything, "www.example.com").Return(store.Domain{AppId: "app_other"}, nil)
+
+		resp, err := api.CreateDomain(ctx, oapi.CreateDomainRequestObject{AppId: appId, EnvId: envId, Body: &oapi.CreateDomainJSONRequestBody{Hostname: "WWW.example.com."}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.CreateDomain400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Contains(t, badReq.Error, "already in use")
+	})
+
+	t.Run("create returns how to verify", func(t *testing.T) {
+		api := newDomainTestAPI()
+		api.domainStore.(*mock.DomainStoreMock).On("GetByHostname", testifymock.Anything, "www.example.com").Return(store.Domain{}, store.ErrDomainNotFound)
+		api.domainStore.(*mock.DomainStoreMock).On("Create", testifymock.Anything, store.CreateDomainOptions{TeamId: teamId, AppId: appId, EnvId: envId, Hostname: "www.example.com"}).Return(pending, nil)
+
+		resp, err := api.CreateDomain(ctx, oapi.CreateDomainRequestObject{AppId: appId, EnvId: envId, Body: &oapi.CreateDomainJSONRequestBody{Hostname: "www.example.com"}})
+		require.NoError(t, err)
+		created, ok := resp.(oapi.CreateDomain201JSONResponse)
+		require.True(t, ok, "Expected 201 response")
+		assert.Equal(t, oapi.DomainVerificationStatusPending, created.Verification.Status)
+		assert.Equal(t, "_onmetal-verification.www.example.com", created.Verification.TxtRecordName)
+		assert.Equal(t, "onmetal-verification=abc123", created.Verification.TxtRecordValue)
+		assert.Equal(t, oapi.DomainCertificateStatusPending, created.Certificate.Status)
+	})
+
+	t.Run("verify a domain of another app", func(t *testing.T) {
+		api := newDomainTestAPI()
+		api.domainStore.(*mock.DomainStoreMock).On("GetByHostname", testifymock.Anything, "www.example.com").Return(store.Domain{AppId: "app_other", EnvId: envId}, nil)
+
+		resp, err := api.VerifyDomain(ctx, oapi.VerifyDomainRequestObject{AppId: appId, EnvId: envId, Hostname: "www.example.com"})
+		require.NoError(t, err)
+		_, ok := resp.(oapi.VerifyDomain404JSONResponse)
+		require.True(t, ok, "Expected 404 response")
+	})
+
+	t.Run("verify without dns records", func(t *testing.T) {
+		api := newDomainTestAPI()
+		api.domainResolver = fakeResolver{}
+		failed := pending
+		failed.VerificationStatus = store.DomainVerificationStatusFailed
+		failed.VerificationStatusReason = "found no TXT record"
+		api.domainStore.(*mock.DomainStoreMock).On("GetByHostname", testifymock.Anything, "www.example.com").Return(pending, nil).Once()
+		api.domainStore.(*mock.DomainStoreMock).On("UpdateVerification", testifymock.Anything, pending.Id, store.DomainVerificationStatusFailed, testifymock.Anything).Return(nil)
+		api.domainStore.(*mock.DomainStoreMock).On("GetByHostname", testifymock.Anything, "www.example.com").Return(failed, nil).Once()
+
+		resp, err := api.VerifyDomain(ctx, oapi.VerifyDomainRequestObject{AppId: appId, EnvId: envId, Hostname: "www.example.com"})
+		require.NoError(t, err)
+		checked, ok := resp.(oapi.VerifyDomain200JSONResponse)
+		require.True(t, ok, "Expected 200 response")
+		assert.Equal(t, oapi.DomainVerificationStatusFailed, checked.Verification.Status)
+		assert.NotEmpty(t, checked.Verification.StatusReason)
+	})
+
+	t.Run("verify with a txt record", func(t *testing.T) {
+		api := newDomainTestAPI()
+		api.domainResolver = fakeResolver{txts: map[string][]string{"_onmetal-verification.www.example.com": {"onmetal-verification=abc123"}}}
+		verified := pending
+		verified.VerificationStatus = store.DomainVerificationStatusVerified
+		api.domainStore.(*mock.DomainStoreMock).On("GetByHostname", testifymock.Anything, "www.example.com").Return(pending, nil).Once()
+		api.domainStore.(*mock.DomainStoreMock).On("UpdateVerification", testifymock.Anything, pending.Id, store.DomainVerificationStatusVerified, "").Return(nil)
+		api.domainStore.(*mock.DomainStoreMock).On("GetByHostname", testifymock.Anything, "www.example.com").Return(verified, nil).Once()
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetForAppEnv", testifymock.Anything, appId, envId).Return([]store.Deployment{}, nil)
+
+		resp, err := api.VerifyDomain(ctx, oapi.VerifyDomainRequestObject{AppId: appId, EnvId: envId, Hostname: "www.example.com"})
+		require.NoError(t, err)
+		checked, ok := resp.(oapi.VerifyDomain200JSONResponse)
+		require.True(t, ok, "Expected 200 response")
+		assert.Equal(t, oapi.DomainVerificationStatusVerified, checked.Verification.Status)
+		api.domainStore.(*mock.DomainStoreMock).AssertExpectations(t)
+	})
+
+	t.Run("delete a domain that doesn't exist", func(t *testing.T) {
+		api := newDomainTestAPI()
+		api.domainStore.(*mock.DomainStoreMock).On("GetByHostname", testifymock.Anything, "www.example.com").Return(store.Domain{}, store.ErrDomainNotFound)
+
+		resp, err := api.DeleteDomain(ctx, oapi.DeleteDomainRequestObject{AppId: appId, EnvId: envId, Hostname: "www.example.com"})
+		require.NoError(t, err)
+		_, ok := resp.(oapi.DeleteDomain404JSONResponse)
+		require.True(t, ok, "Expected 404 response")
+	})
+}
@@ -2,21 +2,48 @@ package api
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/onmetal-dev/metal/cmd/app/middleware"
 	"github.com/onmetal-dev/metal/lib/oapi"
 	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/onmetal-dev/metal/lib/validate"
 	"github.com/samber/lo"
 	"go.jetify.com/typeid"
+	"gorm.io/datatypes"
 )
 
 func envFromStore(env store.Env) oapi.Env {
-	return oapi.Env{
-		Id:        env.Id,
-		Name:      env.Name,
-		CreatedAt: env.CreatedAt,
-		UpdatedAt: env.UpdatedAt,
+	e := oapi.Env{
+		Id:                      env.Id,
+		Name:                    env.Name,
+		AutoRollback:            env.AutoRollback,
+		ProgressDeadlineSeconds: env.ProgressDeadlineSeconds,
+		DefaultCellId:           env.DefaultCellId,
+		FreezeWindows:           freezeWindowsFromStore(env.FreezeWindows.Data()),
+		Protected:               env.Protected,
+		CreatedAt:               env.CreatedAt,
+		UpdatedAt:               env.UpdatedAt,
 	}
+	if env.Locked() {
+		e.Lock = &oapi.EnvLock{LockedBy: env.LockedBy, Reason: env.LockReason, LockedAt: *env.LockedAt}
+	}
+	if env.IsPreview() {
+		e.Preview = &oapi.EnvPreview{Branch: env.PreviewBranch, IdleTtlSeconds: env.PreviewIdleTTLSeconds}
+	}
+	return e
+}
+
+func freezeWindowsFromStore(windows []store.FreezeWindow) []oapi.FreezeWindow {
+	return lo.Map(windows, func(w store.FreezeWindow, _ int) oapi.FreezeWindow {
+		return oapi.FreezeWindow{Start: w.Start, End: w.End, Timezone: lo.EmptyableToPtr(w.Timezone), Reason: lo.EmptyableToPtr(w.Reason)}
+	})
+}
+
+func freezeWindowsToStore(windows []oapi.FreezeWindow) []store.FreezeWindow {
+	return lo.Map(windows, func(w oapi.FreezeWindow, _ int) store.FreezeWindow {
+		return store.FreezeWindow{Start: w.Start, End: w.End, Timezone: lo.FromPtr(w.Timezone), Reason: lo.FromPtr(w.Reason)}
+	})
 }
 
 func envsFromStore(envs []store.Env) []oapi.Env {
@@ -70,6 +97,128 @@ func (a api) GetEnv(ctx context.Context, request oapi.GetEnvRequestObject) (oapi
 	return oapi.GetEnv200JSONResponse(envFromStore(env)), nil
 }
 
+func (a api) UpdateEnv(ctx context.Context, request oapi.UpdateEnvRequestObject) (oapi.UpdateEnvResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	env, err := a.deploymentStore.GetEnv(request.EnvId)
+	if err != nil {
+		if err == store.ErrEnvNotFound {
+			return oapi.UpdateEnv404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: "not found"}}, nil
+		}
+		return oapi.UpdateEnv500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	} else if env.TeamId != token.TeamId {
+		return oapi.UpdateEnv404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: "not found"}}, nil
+	}
+
+	// any member can create an admin token, so it's the token's creator who must be an admin to loosen or tighten an env's guards
+	if request.Body != nil && ((request.Body.Protected != nil && *request.Body.Protected != env.Protected) || request.Body.FreezeWindows != nil) {
+		admin, err := a.isTeamAdmin(ctx)
+		if err != nil {
+			return oapi.UpdateEnv500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+		} else if !admin {
+			return oapi.UpdateEnv400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: "only team admins can change whether an env is protected or its freeze windows"}}, nil
+		}
+	}
+
+	if request.Body != nil {
+		env.AutoRollback = lo.FromPtrOr(request.Body.AutoRollback, env.AutoRollback)
+		env.ProgressDeadlineSeconds = lo.FromPtrOr(request.Body.ProgressDeadlineSeconds, env.ProgressDeadlineSeconds)
+		env.DefaultCellId = lo.FromPtrOr(request.Body.DefaultCellId, env.DefaultCellId)
+		env.Protected = lo.FromPtrOr(request.Body.Protected, env.Protected)
+		if request.Body.FreezeWindows != nil {
+			env.FreezeWindows = datatypes.NewJSONType(freezeWindowsToStore(*request.Body.FreezeWindows))
+		}
+	}
+	opts := store.UpdateEnvOptions{
+		AutoRollback:            env.AutoRollback,
+		ProgressDeadlineSeconds: env.ProgressDeadlineSeconds,
+		DefaultCellId:           env.DefaultCellId,
+		FreezeWindows:           env.FreezeWindows.Data(),
+		Protected:               env.Protected,
+	}
+	if err := validate.Struct(opts); err != nil {
+		return oapi.UpdateEnv400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+	for _, w := range opts.FreezeWindows {
+		if err := w.Validate(); err != nil {
+			return oapi.UpdateEnv400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: fmt.Sprintf("invalid freeze window %s: %s", w, err)}}, nil
+		}
+	}
+	if request.Body != nil && request.Body.DefaultCellId != nil && env.DefaultCellId != "" {
+		cells, err := a.cellStore.GetForTeam(ctx, token.TeamId)
+		if err != nil {
+			return oapi.UpdateEnv500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+		} else if !lo.ContainsBy(cells, func(c store.Cell) bool { return c.Id == env.DefaultCellId }) {
+			return oapi.UpdateEnv400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: fmt.Sprintf("cell %s does not exist", env.DefaultCellId)}}, nil
+		}
+	}
+	if err := a.deploymentStore.UpdateEnv(env.Id, opts); err != nil {
+		return oapi.UpdateEnv500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+
+	return oapi.UpdateEnv200JSONResponse(envFromStore(env)), nil
+}
+
+func (a api) LockEnv(ctx context.Context, request oapi.LockEnvRequestObject) (oapi.LockEnvResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	env, err := a.deploymentStore.GetEnv(request.EnvId)
+	if err != nil {
+		if err == store.ErrEnvNotFound {
+			return oapi.LockEnv404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: "not found"}}, nil
+		}
+		return oapi.LockEnv500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	} else if env.TeamId != token.TeamId {
+		return oapi.LockEnv404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: "not found"}}, nil
+	}
+	if env.Locked() {
+		return oapi.LockEnv400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: fmt.Sprintf("%s is already locked by %s", env.Name, env.LockedBy)}}, nil
+	}
+
+	opts := store.LockEnvOptions{LockedBy: middleware.Actor(ctx)}
+	if request.Body != nil {
+		opts.Reason = lo.FromPtr(request.Body.Reason)
+	}
+	if err := a.deploymentStore.LockEnv(env.Id, opts); err != nil {
+		return oapi.LockEnv500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	if env, err = a.deploymentStore.GetEnv(env.Id); err != nil {
+		return oapi.LockEnv500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+
+	return oapi.LockEnv200JSONResponse(envFromStore(env)), nil
+}
+
+func (a api) UnlockEnv(ctx context.Context, request oapi.UnlockEnvRequestObject) (oapi.UnlockEnvResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	env, err := a.deploymentStore.GetEnv(request.EnvId)
+	if err != nil {
+		if err == store.ErrEnvNotFound {
+			return oapi.UnlockEnv404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: "not found"}}, nil
+		}
+		return oapi.UnlockEnv500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	} else if env.TeamId != token.TeamId {
+		return oapi.UnlockEnv404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: "not found"}}, nil
+	}
+	// a lock usually stands for an incident, so only whoever put it on or a team admin may take it off
+	if env.Locked() && env.LockedBy != middleware.Actor(ctx) {
+		admin, err := a.isTeamAdmin(ctx)
+		if err != nil {
+			return oapi.UnlockEnv500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+		} else if !admin {
+			return oapi.UnlockEnv400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: fmt.Sprintf("%s was locked by %s, only team admins can unlock it", env.Name, env.LockedBy)}}, nil
+		}
+	}
+
+	if err := a.deploymentStore.UnlockEnv(env.Id); err != nil {
+		return oapi.UnlockEnv500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	env.LockedBy, env.LockReason, env.LockedAt = "", "", nil
+
+	return oapi.UnlockEnv200JSONResponse(envFromStore(env)), nil
+}
+
 func (a api) CreateEnv(ctx context.Context, request oapi.CreateEnvRequestObject) (oapi.CreateEnvResponseObject, error) {
 	token := middleware.MustGetApiToken(ctx)
 
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/onmetal-dev/metal/lib/store/mock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.jetify.com/typeid"
+)
+
+func TestUpdateEnv(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId, CreatorId: "user_admin"})
+	team := &store.Team{Common: store.Common{Id: teamId}, Members: []store.TeamMember{
+		{UserId: "user_admin", TeamId: teamId, Role: store.TeamRoleAdmin},
+		{UserId: "user_member", TeamId: teamId, Role: store.TeamRoleMember},
+	}}
+
+	t.Run("keeps omitted settings", func(t *testing.T) {
+		api := newTestAPI()
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId, ProgressDeadlineSeconds: 120, Protected: true}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("UpdateEnv", envId, store.UpdateEnvOptions{AutoRollback: true, ProgressDeadlineSeconds: 120, Protected: true}).Return(nil)
+
+		resp, err := api.UpdateEnv(ctx, oapi.UpdateEnvRequestObject{EnvId: envId, Body: &oapi.UpdateEnvJSONRequestBody{AutoRollback: lo.ToPtr(true)}})
+		require.NoError(t, err)
+		updated, ok := resp.(oapi.UpdateEnv200JSONResponse)
+		require.True(t, ok, "Expected 200 response")
+		assert.True(t, updated.AutoRollback)
+		assert.Equal(t, 120, updated.ProgressDeadlineSeconds)
+		assert.True(t, updated.Protected)
+	})
+
+	t.Run("admin unprotects", func(t *testing.T) {
+		api := newTestAPI()
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId, ProgressDeadlineSeconds: 120, Protected: true}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("UpdateEnv", envId, store.UpdateEnvOptions{ProgressDeadlineSeconds: 120}).Return(nil)
+		api.teamStore.(*mock.TeamStoreMock).On("GetTeam", testifymock.Anything, teamId).Return(team, nil)
+
+		resp, err := api.UpdateEnv(ctx, oapi.UpdateEnvRequestObject{EnvId: envId, Body: &oapi.UpdateEnvJSONRequestBody{Protected: lo.ToPtr(false)}})
+		require.NoError(t, err)
+		updated, ok := resp.(oapi.UpdateEnv200JSONResponse)
+		require.True(t, ok, "Expected 200 response")
+		assert.False(t, updated.Protected)
+	})
+
+	t.Run("member's admin token can't unprotect or clear freeze windows", func(t *testing.T) {
+		memberCtx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId, CreatorId: "user_member", Scope: store.ApiTokenScopeAdmin})
+		for _, body := range []oapi.UpdateEnvJSONRequestBody{{Protected: lo.ToPtr(false)}, {FreezeWindows: &[]oapi.FreezeWindow{}}} {
+			api := newTestAPI()
+			api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId, Protected: true}, nil)
+			api.teamStore.(*mock.TeamStoreMock).On("GetTeam", testifymock.Anything, teamId).Return(team, nil)
+
+			resp, err := api.UpdateEnv(memberCtx, oapi.UpdateEnvRequestObject{EnvId: envId, Body: &body})
+			require.NoError(t, err)
+			badReq, ok := resp.(oapi.UpdateEnv400JSONResponse)
+			require.True(t, ok, "Expected 400 response")
+			assert.Equal(t, "only team admins can change whether an env is protected or its freeze windows", badReq.Error)
+			api.deploymentStore.(*mock.DeploymentStoreMock).AssertNotCalled(t, "UpdateEnv", testifymock.Anything, testifymock.Anything)
+		}
+	})
+
+	t.Run("progress deadline out of range", func(t *testing.T) {
+		api := newTestAPI()
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+
+		resp, err := api.UpdateEnv(ctx, oapi.UpdateEnvRequestObject{EnvId: envId, Body: &oapi.UpdateEnvJSONRequestBody{ProgressDeadlineSeconds: lo.ToPtr(5)}})
+		require.NoError(t, err)
+		_, ok := resp.(oapi.UpdateEnv400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+	})
+
+	t.Run("default cell of another team", func(t *testing.T) {
+		api := newTestAPI()
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+		api.cellStore.(*mock.CellStoreMock).On("GetForTeam", testifymock.Anything, teamId).Return([]store.Cell{{Common: store.Common{Id: "cell_fsn1"}}}, nil)
+
+		resp, err := api.UpdateEnv(ctx, oapi.UpdateEnvRequestObject{EnvId: envId, Body: &oapi.UpdateEnvJSONRequestBody{DefaultCellId: lo.ToPtr("cell_other")}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.UpdateEnv400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Equal(t, "cell cell_other does not exist", badReq.Error)
+	})
+
+	t.Run("invalid freeze window", func(t *testing.T) {
+		api := newTestAPI()
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+		api.teamStore.(*mock.TeamStoreMock).On("GetTeam", testifymock.Anything, teamId).Return(team, nil)
+
+		resp, err := api.UpdateEnv(ctx, oapi.UpdateEnvRequestObject{EnvId: envId, Body: &oapi.UpdateEnvJSONRequestBody{FreezeWindows: &[]oapi.FreezeWindow{{Start: "Friday 16:00", End: "Someday 09:00"}}}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.UpdateEnv400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Equal(t, `invalid freeze window Friday 16:00 to Someday 09:00 UTC: invalid end: "Someday" is not a day of the week`, badReq.Error)
+	})
+
+	t.Run("other team's env", func(t *testing.T) {
+		api := newTestAPI()
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: "team_other"}, nil)
+
+		resp, err := api.UpdateEnv(ctx, oapi.UpdateEnvRequestObject{EnvId: envId, Body: &oapi.UpdateEnvJSONRequestBody{}})
+		require.NoError(t, err)
+		_, ok := resp.(oapi.UpdateEnv404JSONResponse)
+		require.True(t, ok, "Expected 404 response")
+	})
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+)
+
+func deploymentEventFromStore(e store.DeploymentEvent) oapi.DeploymentEvent {
+	event := oapi.DeploymentEvent{
+		DeploymentId: int(e.DeploymentId),
+		Status:       oapi.DeploymentStatus(e.Status),
+		Reason:       e.Reason,
+		Actor:        e.Actor,
+		CreatedAt:    e.CreatedAt,
+	}
+	if k8sEvents := e.K8sEvents.Data(); len(k8sEvents) > 0 {
+		event.K8sEvents = lo.ToPtr(lo.Map(k8sEvents, func(k store.K8sEvent, _ int) oapi.K8sEvent {
+			return oapi.K8sEvent{Type: k.Type, Reason: k.Reason, Object: k.Object, Message: k.Message, Count: k.Count, LastSeen: k.LastSeen}
+		}))
+	}
+	return event
+}
+
+func (a api) GetDeploymentEvents(ctx context.Context, request oapi.GetDeploymentEventsRequestObject) (oapi.GetDeploymentEventsResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.GetDeploymentEvents404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.GetDeploymentEvents500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+
+	if request.DeploymentId <= 0 {
+		return oapi.GetDeploymentEvents404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: "deployment not found"}}, nil
+	}
+	if _, err := a.deploymentStore.Get(app.Id, env.Id, uint(request.DeploymentId)); err != nil {
+		return oapi.GetDeploymentEvents404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: "deployment not found"}}, nil
+	}
+	events, err := a.deploymentStore.GetEvents(ctx, app.Id, env.Id, uint(request.DeploymentId))
+	if err != nil {
+		return oapi.GetDeploymentEvents500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	return oapi.GetDeploymentEvents200JSONResponse(lo.Map(events, func(e store.DeploymentEvent, _ int) oapi.DeploymentEvent {
+		return deploymentEventFromStore(e)
+	})), nil
+}
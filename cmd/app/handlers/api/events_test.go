@@ -0,0 +1,65 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/onmetal-dev/metal/lib/store/mock"
+	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.jetify.com/typeid"
+	"gorm.io/datatypes"
+)
+
+func TestGetDeploymentEvents(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	appId := typeid.Must(typeid.WithPrefix("app")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId})
+
+	newEventsTestAPI := func() api {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+		return api
+	}
+
+	t.Run("timeline", func(t *testing.T) {
+		api := newEventsTestAPI()
+		created := time.Now().Add(-time.Minute)
+		failed := store.DeploymentEvent{DeploymentId: 1, Status: store.DeploymentStatusFailed, Reason: "back-off restarting failed container", Actor: store.SystemActor, CreatedAt: time.Now()}
+		failed.K8sEvents = datatypes.NewJSONType([]store.K8sEvent{{Type: "Warning", Reason: "BackOff", Object: "Pod/myapp-web-5d9f-x2x7q", Count: 4}})
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("Get", appId, envId, uint(1)).Return(store.Deployment{Id: 1}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEvents", testifymock.Anything, appId, envId, uint(1)).Return([]store.DeploymentEvent{
+			{DeploymentId: 1, Status: store.DeploymentStatusPending, Reason: "deploy created", Actor: "api token ci", CreatedAt: created},
+			failed,
+		}, nil)
+
+		resp, err := api.GetDeploymentEvents(ctx, oapi.GetDeploymentEventsRequestObject{AppId: appId, EnvId: envId, DeploymentId: 1})
+		require.NoError(t, err)
+		events, ok := resp.(oapi.GetDeploymentEvents200JSONResponse)
+		require.True(t, ok, "Expected 200 response")
+		require.Len(t, events, 2)
+		assert.Equal(t, "api token ci", events[0].Actor)
+		assert.Nil(t, events[0].K8sEvents)
+		assert.Equal(t, oapi.DeploymentStatusFailed, events[1].Status)
+		require.NotNil(t, events[1].K8sEvents)
+		assert.Equal(t, "BackOff", (*events[1].K8sEvents)[0].Reason)
+	})
+
+	t.Run("unknown deployment", func(t *testing.T) {
+		api := newEventsTestAPI()
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("Get", appId, envId, uint(7)).Return(store.Deployment{}, errors.New("record not found"))
+
+		resp, err := api.GetDeploymentEvents(ctx, oapi.GetDeploymentEventsRequestObject{AppId: appId, EnvId: envId, DeploymentId: 7})
+		require.NoError(t, err)
+		_, ok := resp.(oapi.GetDeploymentEvents404JSONResponse)
+		require.True(t, ok, "Expected 404 response")
+	})
+}
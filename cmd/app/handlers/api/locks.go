@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/store"
+)
+
+// isTeamAdmin reports whether the team member who created the request's token is an admin of the team.
+// Any member can create an admin token, so the token's scope alone doesn't make a request an admin's
+func (a api) isTeamAdmin(ctx context.Context) (bool, error) {
+	token := middleware.MustGetApiToken(ctx)
+	team, err := a.teamStore.GetTeam(ctx, token.TeamId)
+	if err != nil {
+		return false, fmt.Errorf("failed to get team: %w", err)
+	}
+	return team != nil && team.IsAdmin(token.CreatorId), nil
+}
+
+// overridesLock reports whether the request may deploy to envs even while they are locked: admin tokens of team admins may,
+// by sending the override lock header
+func (a api) overridesLock(ctx context.Context) bool {
+	if !middleware.OverrideLock(ctx) || middleware.MustGetApiToken(ctx).Scope != store.ApiTokenScopeAdmin {
+		return false
+	}
+	admin, err := a.isTeamAdmin(ctx)
+	return err == nil && admin
+}
+
+// checkEnvUnlocked returns an error that says who locked the env and why, or which freeze window it is in, unless the request may deploy to it
+func (a api) checkEnvUnlocked(ctx context.Context, env store.Env) error {
+	if err := env.CheckUnlocked(time.Now()); err != nil && !a.overridesLock(ctx) {
+		return fmt.Errorf("%w. An admin can override the lock with the %s: true header", err, middleware.OverrideLockHeader)
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/onmetal-dev/metal/lib/store/mock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.jetify.com/typeid"
+	"gorm.io/datatypes"
+)
+
+func TestLockEnv(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId, Name: "ci"})
+
+	t.Run("records who locked the env and why", func(t *testing.T) {
+		api := newTestAPI()
+		lockedAt := time.Now()
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId, Name: "production"}, nil).Once()
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("LockEnv", envId, store.LockEnvOptions{LockedBy: "api token ci", Reason: "incident 42"}).Return(nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId, Name: "production", LockedBy: "api token ci", LockReason: "incident 42", LockedAt: &lockedAt}, nil).Once()
+
+		resp, err := api.LockEnv(ctx, oapi.LockEnvRequestObject{EnvId: envId, Body: &oapi.LockEnvJSONRequestBody{Reason: lo.ToPtr("incident 42")}})
+		require.NoError(t, err)
+		locked, ok := resp.(oapi.LockEnv200JSONResponse)
+		require.True(t, ok, "Expected 200 response")
+		require.NotNil(t, locked.Lock)
+		assert.Equal(t, "api token ci", locked.Lock.LockedBy)
+		assert.Equal(t, "incident 42", locked.Lock.Reason)
+	})
+
+	t.Run("already locked", func(t *testing.T) {
+		api := newTestAPI()
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId, Name: "production", LockedBy: "someone@example.com", LockedAt: lo.ToPtr(time.Now())}, nil)
+
+		resp, err := api.LockEnv(ctx, oapi.LockEnvRequestObject{EnvId: envId, Body: &oapi.LockEnvJSONRequestBody{}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.LockEnv400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Equal(t, "production is already locked by someone@example.com", badReq.Error)
+	})
+
+	t.Run("other team's env", func(t *testing.T) {
+		api := newTestAPI()
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: "team_other"}, nil)
+
+		resp, err := api.UnlockEnv(ctx, oapi.UnlockEnvRequestObject{EnvId: envId})
+		require.NoError(t, err)
+		_, ok := resp.(oapi.UnlockEnv404JSONResponse)
+		require.True(t, ok, "Expected 404 response")
+	})
+}
+
+func TestUnlockEnv(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	team := &store.Team{Common: store.Common{Id: teamId}, Members: []store.TeamMember{
+		{UserId: "user_admin", TeamId: teamId, Role: store.TeamRoleAdmin},
+		{UserId: "user_member", TeamId: teamId, Role: store.TeamRoleMember},
+	}}
+	lockedBy := func(actor string) store.Env {
+		return store.Env{Common: store.Common{Id: envId}, TeamId: teamId, Name: "production", LockedBy: actor, LockedAt: lo.ToPtr(time.Now())}
+	}
+
+	testCases := []struct {
+		name   string
+		token  store.ApiToken
+		env    store.Env
+		errMsg string
+	}{
+		{"admin removes someone else's lock", store.ApiToken{TeamId: teamId, CreatorId: "user_admin", Name: "ci"}, lockedBy("someone@example.com"), ""},
+		{"member removes their own lock", store.ApiToken{TeamId: teamId, CreatorId: "user_member", Name: "ci"}, lockedBy("api token ci"), ""},
+		{"member removes someone else's lock", store.ApiToken{TeamId: teamId, CreatorId: "user_member", Name: "ci", Scope: store.ApiTokenScopeAdmin}, lockedBy("someone@example.com"), "production was locked by someone@example.com, only team admins can unlock it"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := newTestAPI()
+			api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(tc.env, nil)
+			api.deploymentStore.(*mock.DeploymentStoreMock).On("UnlockEnv", envId).Return(nil)
+			api.teamStore.(*mock.TeamStoreMock).On("GetTeam", testifymock.Anything, teamId).Return(team, nil)
+
+			resp, err := api.UnlockEnv(middleware.WithApiToken(context.Background(), tc.token), oapi.UnlockEnvRequestObject{EnvId: envId})
+			require.NoError(t, err)
+			if tc.errMsg != "" {
+				badReq, ok := resp.(oapi.UnlockEnv400JSONResponse)
+				require.True(t, ok, "Expected 400 response")
+				assert.Equal(t, tc.errMsg, badReq.Error)
+				api.deploymentStore.(*mock.DeploymentStoreMock).AssertNotCalled(t, "UnlockEnv", envId)
+				return
+			}
+			unlocked, ok := resp.(oapi.UnlockEnv200JSONResponse)
+			require.True(t, ok, "Expected 200 response")
+			assert.Nil(t, unlocked.Lock)
+		})
+	}
+}
+
+func TestDeployToLockedEnv(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	appId := typeid.Must(typeid.WithPrefix("app")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	adminCtx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId, CreatorId: "user_admin", Scope: store.ApiTokenScopeAdmin})
+	team := &store.Team{Common: store.Common{Id: teamId}, Members: []store.TeamMember{
+		{UserId: "user_admin", TeamId: teamId, Role: store.TeamRoleAdmin},
+		{UserId: "user_member", TeamId: teamId, Role: store.TeamRoleMember},
+	}}
+	locked := store.Env{Common: store.Common{Id: envId}, TeamId: teamId, Name: "production", LockedBy: "someone@example.com", LockReason: "incident 42", LockedAt: lo.ToPtr(time.Now())}
+
+	restart := func(ctx context.Context, env store.Env) oapi.RestartResponseObject {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(env, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetForAppEnv", testifymock.Anything, appId, envId).Return([]store.Deployment{}, nil)
+		api.teamStore.(*mock.TeamStoreMock).On("GetTeam", testifymock.Anything, teamId).Return(team, nil)
+		resp, err := api.Restart(ctx, oapi.RestartRequestObject{AppId: appId, EnvId: envId})
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("locked", func(t *testing.T) {
+		badReq, ok := restart(adminCtx, locked).(oapi.Restart400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Contains(t, badReq.Error, "production was locked by someone@example.com")
+		assert.Contains(t, badReq.Error, "incident 42")
+		assert.Contains(t, badReq.Error, "Metal-Override-Lock")
+	})
+
+	t.Run("in a freeze window", func(t *testing.T) {
+		now := time.Now().UTC()
+		frozen := store.Env{Common: store.Common{Id: envId}, TeamId: teamId, Name: "production", FreezeWindows: datatypes.NewJSONType([]store.FreezeWindow{{
+			Start:  now.Add(-time.Hour).Format("Mon 15:04"),
+			End:    now.Add(time.Hour).Format("Mon 15:04"),
+			Reason: "holidays",
+		}})}
+		badReq, ok := restart(adminCtx, frozen).(oapi.Restart400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Contains(t, badReq.Error, "production is in the freeze window")
+		assert.Contains(t, badReq.Error, "holidays")
+	})
+
+	t.Run("outside of freeze windows", func(t *testing.T) {
+		now := time.Now().UTC()
+		thawed := store.Env{Common: store.Common{Id: envId}, TeamId: teamId, Name: "production", FreezeWindows: datatypes.NewJSONType([]store.FreezeWindow{{
+			Start: now.Add(time.Hour).Format("Mon 15:04"),
+			End:   now.Add(2 * time.Hour).Format("Mon 15:04"),
+		}})}
+		badReq, ok := restart(adminCtx, thawed).(oapi.Restart400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Equal(t, "app is not running in this env", badReq.Error)
+	})
+
+	t.Run("admin overrides the lock", func(t *testing.T) {
+		badReq, ok := restart(middleware.WithOverrideLock(adminCtx, true), locked).(oapi.Restart400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Equal(t, "app is not running in this env", badReq.Error)
+	})
+
+	t.Run("only admin tokens can override the lock", func(t *testing.T) {
+		ctx := middleware.WithOverrideLock(middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId, CreatorId: "user_admin"}), true)
+		badReq, ok := restart(ctx, locked).(oapi.Restart400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Contains(t, badReq.Error, "production was locked by someone@example.com")
+	})
+
+	t.Run("admin tokens of members can't override the lock", func(t *testing.T) {
+		ctx := middleware.WithOverrideLock(middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId, CreatorId: "user_member", Scope: store.ApiTokenScopeAdmin}), true)
+		badReq, ok := restart(ctx, locked).(oapi.Restart400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Contains(t, badReq.Error, "production was locked by someone@example.com")
+	})
+}
+
+func TestCheckEnvUnlocked(t *testing.T) {
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	now := time.Now().UTC()
+	frozen := store.Env{Name: "production", FreezeWindows: datatypes.NewJSONType([]store.FreezeWindow{{
+		Start: now.Add(-time.Hour).Format("Mon 15:04"),
+		End:   now.Add(time.Hour).Format("Mon 15:04"),
+	}})}
+	locked := store.Env{Name: "production", LockedBy: "someone@example.com", LockedAt: lo.ToPtr(now)}
+	team := &store.Team{Common: store.Common{Id: teamId}, Members: []store.TeamMember{
+		{UserId: "user_admin", TeamId: teamId, Role: store.TeamRoleAdmin},
+		{UserId: "user_member", TeamId: teamId, Role: store.TeamRoleMember},
+	}}
+
+	testCases := []struct {
+		name     string
+		env      store.Env
+		creator  string
+		scope    store.ApiTokenScope
+		override bool
+		locked   bool
+	}{
+		{"unlocked", store.Env{Name: "production"}, "user_admin", store.ApiTokenScopeAdmin, false, false},
+		{"locked", locked, "user_admin", store.ApiTokenScopeAdmin, false, true},
+		{"locked, overridden by an admin", locked, "user_admin", store.ApiTokenScopeAdmin, true, false},
+		{"locked, override by a non-admin token", locked, "user_admin", "", true, true},
+		{"locked, override by a member's admin token", locked, "user_member", store.ApiTokenScopeAdmin, true, true},
+		{"frozen", frozen, "user_admin", store.ApiTokenScopeAdmin, false, true},
+		{"frozen, overridden by an admin", frozen, "user_admin", store.ApiTokenScopeAdmin, true, false},
+		{"frozen, override by a non-admin token", frozen, "user_admin", "", true, true},
+		{"frozen, override by a member's admin token", frozen, "user_member", store.ApiTokenScopeAdmin, true, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := newTestAPI()
+			api.teamStore.(*mock.TeamStoreMock).On("GetTeam", testifymock.Anything, teamId).Return(team, nil)
+			ctx := middleware.WithOverrideLock(middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId, CreatorId: tc.creator, Scope: tc.scope}), tc.override)
+			err := api.checkEnvUnlocked(ctx, tc.env)
+			if !tc.locked {
+				assert.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, store.ErrEnvLocked)
+			assert.Contains(t, err.Error(), middleware.OverrideLockHeader)
+		})
+	}
+}
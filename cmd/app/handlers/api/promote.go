@@ -0,0 +1,131 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+)
+
+var errNotRunning = errors.New("app is not running")
+
+// promotionSource returns the deployment to promote from env: the one with deploymentId, or the one running there
+func (a api) promotionSource(ctx context.Context, appId string, env store.Env, deploymentId *int) (store.Deployment, error) {
+	if deploymentId != nil {
+		return a.deploymentStore.Get(appId, env.Id, uint(*deploymentId))
+	}
+	deployments, err := a.deploymentStore.GetForAppEnv(ctx, appId, env.Id)
+	if err != nil {
+		return store.Deployment{}, err
+	}
+	d, ok := lo.Find(deployments, func(d store.Deployment) bool { return d.Status == store.DeploymentStatusRunning })
+	if !ok {
+		return store.Deployment{}, fmt.Errorf("%w in %s", errNotRunning, env.Name)
+	}
+	return d, nil
+}
+
+func (a api) Promote(ctx context.Context, request oapi.PromoteRequestObject) (oapi.PromoteResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.Promote404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.Promote500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	fromEnv, err := a.deploymentStore.GetEnv(request.Body.FromEnvId)
+	if err != nil || fromEnv.TeamId != token.TeamId {
+		return oapi.Promote404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: store.ErrEnvNotFound.Error()}}, nil
+	}
+	if fromEnv.Id == env.Id {
+		return oapi.Promote400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: "cannot promote an env to itself"}}, nil
+	}
+	if err := a.checkEnvUnlocked(ctx, env); err != nil {
+		return oapi.Promote400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	source, err := a.promotionSource(ctx, app.Id, fromEnv, request.Body.DeploymentId)
+	if err != nil {
+		if errors.Is(err, store.ErrDeploymentNotFound) {
+			return oapi.Promote404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: fmt.Sprintf("deployment %d not found in %s", *request.Body.DeploymentId, fromEnv.Name)}}, nil
+		} else if errors.Is(err, errNotRunning) {
+			return oapi.Promote400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.Promote500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to get deployment to promote: %s", err)}}, nil
+	}
+	if !source.CanRollbackTo() {
+		return oapi.Promote400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: fmt.Sprintf("cannot promote deployment %d with status %s", source.Id, source.Status)}}, nil
+	}
+	if source.AppSettings.Artifact.Data().Image == nil {
+		return oapi.Promote400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: fmt.Sprintf("deployment %d has no image to promote", source.Id)}}, nil
+	}
+
+	cells, err := a.cellStore.GetForTeam(ctx, token.TeamId)
+	if err != nil {
+		return oapi.Promote500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to get cells: %s", err)}}, nil
+	}
+	latest, err := a.deploymentStore.GetLatestForAppEnv(ctx, app.Id, env.Id)
+	if err != nil {
+		return oapi.Promote500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to get latest deployment: %s", err)}}, nil
+	}
+	_, cellIds, err := selectUpCells(cells, "", env, latest)
+	if err != nil {
+		return oapi.Promote400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	// the env keeps its own env vars and settings. An app promoted to an env for the first time starts out with the source's
+	// settings, replicas included, and no env vars
+	settingsOpts, replicas := source.AppSettings.CreateOptions(), source.Replicas
+	var appEnvVarsId string
+	if latest != nil {
+		settingsOpts, replicas = latest.AppSettings.PromoteOptions(source.AppSettings), latest.Replicas
+		appEnvVarsId = latest.AppEnvVarsId
+	}
+	if err := store.ValidateVolumes(settingsOpts.Volumes, settingsOpts.Processes); err != nil {
+		return oapi.Promote400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+	appSettings, err := a.appStore.CreateAppSettings(settingsOpts)
+	if err != nil {
+		return oapi.Promote500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to create app settings: %s", err)}}, nil
+	}
+	if latest == nil {
+		appEnvVars, err := a.deploymentStore.CreateAppEnvVars(store.CreateAppEnvVarOptions{
+			TeamId:  token.TeamId,
+			EnvId:   env.Id,
+			AppId:   app.Id,
+			EnvVars: []store.EnvVar{},
+		})
+		if err != nil {
+			return oapi.Promote500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to create app env vars: %s", err)}}, nil
+		}
+		appEnvVarsId = appEnvVars.Id
+	}
+
+	d, err := a.deploymentStore.Create(store.CreateDeploymentOptions{
+		TeamId:        token.TeamId,
+		EnvId:         env.Id,
+		AppId:         app.Id,
+		Type:          store.DeploymentTypeDeploy,
+		Actor:         middleware.Actor(ctx),
+		OverrideLock:  a.overridesLock(ctx),
+		RequestedBy:   middleware.ActorUserId(ctx),
+		AppSettingsId: appSettings.Id,
+		AppEnvVarsId:  appEnvVarsId,
+		CellIds:       cellIds,
+		Replicas:      replicas,
+	})
+	if err != nil {
+		return oapi.Promote500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to create deployment: %s", err)}}, nil
+	}
+	if err := a.enqueueDeployment(ctx, d); err != nil {
+		return oapi.Promote500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to send deployment message to queue: %s", err)}}, nil
+	}
+
+	return oapi.Promote201JSONResponse(deploymentFromStore(d)), nil
+}
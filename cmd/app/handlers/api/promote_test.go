@@ -0,0 +1,155 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/onmetal-dev/metal/lib/store/mock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.jetify.com/typeid"
+	"gorm.io/datatypes"
+)
+
+func TestPromote(t *testing.T) {
+	stagingId := typeid.Must(typeid.WithPrefix("env")).String()
+	prodId := typeid.Must(typeid.WithPrefix("env")).String()
+	appId := typeid.Must(typeid.WithPrefix("app")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId})
+	cell := store.Cell{Common: store.Common{Id: "cell_fsn1"}, Name: "fsn1"}
+
+	image := &store.ImageArtifact{Registry: "ghcr.io", Repository: "acme/api", Tag: "v1.2"}
+	tested := store.Deployment{Id: 3, AppId: appId, EnvId: stagingId, Status: store.DeploymentStatusRunning, Replicas: 1, AppSettings: store.AppSettings{
+		TeamId:    teamId,
+		AppId:     appId,
+		Artifact:  datatypes.NewJSONType(store.Artifact{Image: image}),
+		Ports:     datatypes.NewJSONType(store.Ports{{Name: "http", Port: 8080, Proto: "http"}}),
+		Resources: datatypes.NewJSONType(store.Resources{Limits: store.ResourceLimits{CpuCores: 1, MemoryMiB: 512}}),
+		Processes: datatypes.NewJSONType(store.Processes{{Name: "web", Command: "./api", Replicas: 1}, {Name: "worker", Command: "./worker", Replicas: 1}}),
+	}}
+
+	newPromoteTestAPI := func(staging []store.Deployment, prod *store.Deployment) api {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", stagingId).Return(store.Env{Common: store.Common{Id: stagingId}, TeamId: teamId, Name: "staging"}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", prodId).Return(store.Env{Common: store.Common{Id: prodId}, TeamId: teamId, Name: "production"}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetForAppEnv", testifymock.Anything, appId, stagingId).Return(staging, nil)
+		for _, d := range staging {
+			api.deploymentStore.(*mock.DeploymentStoreMock).On("Get", appId, stagingId, d.Id).Return(d, nil)
+		}
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetLatestForAppEnv", testifymock.Anything, appId, prodId).Return(prod, nil)
+		api.cellStore.(*mock.CellStoreMock).On("GetForTeam", testifymock.Anything, teamId).Return([]store.Cell{cell}, nil)
+		return api
+	}
+
+	t.Run("keeps the target env's env vars and settings", func(t *testing.T) {
+		prod := &store.Deployment{Id: 9, AppId: appId, EnvId: prodId, Status: store.DeploymentStatusRunning, Replicas: 1, AppEnvVarsId: "appenvvars_prod", Cells: []store.Cell{cell}, AppSettings: store.AppSettings{
+			TeamId:         teamId,
+			AppId:          appId,
+			Artifact:       datatypes.NewJSONType(store.Artifact{Image: &store.ImageArtifact{Registry: "ghcr.io", Repository: "acme/api", Tag: "v1.1"}}),
+			ReleaseCommand: "./migrate up",
+			Processes:      datatypes.NewJSONType(store.Processes{{Name: "web", Command: "./api", Replicas: 4}}),
+		}}
+		api := newPromoteTestAPI([]store.Deployment{tested}, prod)
+		api.appStore.(*mock.AppStoreMock).On("CreateAppSettings", store.CreateAppSettingsOptions{
+			TeamId:         teamId,
+			AppId:          appId,
+			Artifact:       store.Artifact{Image: image},
+			Ports:          store.Ports{{Name: "http", Port: 8080, Proto: "http"}},
+			Resources:      store.Resources{Limits: store.ResourceLimits{CpuCores: 1, MemoryMiB: 512}},
+			ReleaseCommand: "./migrate up",
+			Processes:      store.Processes{{Name: "web", Command: "./api", Replicas: 4}, {Name: "worker", Command: "./worker", Replicas: 1}},
+		}).Return(store.AppSettings{Common: store.Common{Id: "appsettings_promoted"}}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("Create", testifymock.MatchedBy(func(opts store.CreateDeploymentOptions) bool {
+			return opts.AppSettingsId == "appsettings_promoted" && opts.AppEnvVarsId == "appenvvars_prod" && opts.EnvId == prodId
+		})).Return(store.Deployment{}, errors.New("db is down"))
+
+		resp, err := api.Promote(ctx, oapi.PromoteRequestObject{AppId: appId, EnvId: prodId, Body: &oapi.PromoteJSONRequestBody{FromEnvId: stagingId}})
+		require.NoError(t, err)
+		serverErr, ok := resp.(oapi.Promote500JSONResponse)
+		require.True(t, ok, "Expected 500 response")
+		assert.Equal(t, "failed to create deployment: db is down", serverErr.Error)
+	})
+
+	t.Run("not running in the source env", func(t *testing.T) {
+		api := newPromoteTestAPI([]store.Deployment{{Id: 4, Status: store.DeploymentStatusFailed}}, nil)
+
+		resp, err := api.Promote(ctx, oapi.PromoteRequestObject{AppId: appId, EnvId: prodId, Body: &oapi.PromoteJSONRequestBody{FromEnvId: stagingId}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.Promote400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Equal(t, "app is not running in staging", badReq.Error)
+	})
+
+	t.Run("deployment that never ran", func(t *testing.T) {
+		api := newPromoteTestAPI([]store.Deployment{{Id: 4, Status: store.DeploymentStatusFailed}, tested}, nil)
+
+		resp, err := api.Promote(ctx, oapi.PromoteRequestObject{AppId: appId, EnvId: prodId, Body: &oapi.PromoteJSONRequestBody{FromEnvId: stagingId, DeploymentId: lo.ToPtr(4)}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.Promote400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Equal(t, "cannot promote deployment 4 with status failed", badReq.Error)
+	})
+
+	t.Run("same env", func(t *testing.T) {
+		api := newPromoteTestAPI([]store.Deployment{tested}, nil)
+
+		resp, err := api.Promote(ctx, oapi.PromoteRequestObject{AppId: appId, EnvId: stagingId, Body: &oapi.PromoteJSONRequestBody{FromEnvId: stagingId}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.Promote400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Equal(t, "cannot promote an env to itself", badReq.Error)
+	})
+
+	t.Run("other team's source env", func(t *testing.T) {
+		api := newPromoteTestAPI(nil, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", "env_other").Return(store.Env{Common: store.Common{Id: "env_other"}, TeamId: "team_other"}, nil)
+
+		resp, err := api.Promote(ctx, oapi.PromoteRequestObject{AppId: appId, EnvId: prodId, Body: &oapi.PromoteJSONRequestBody{FromEnvId: "env_other"}})
+		require.NoError(t, err)
+		_, ok := resp.(oapi.Promote404JSONResponse)
+		require.True(t, ok, "Expected 404 response")
+	})
+
+	t.Run("unknown deployment", func(t *testing.T) {
+		api := newPromoteTestAPI([]store.Deployment{tested}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("Get", appId, stagingId, uint(7)).Return(store.Deployment{}, store.ErrDeploymentNotFound)
+
+		resp, err := api.Promote(ctx, oapi.PromoteRequestObject{AppId: appId, EnvId: prodId, Body: &oapi.PromoteJSONRequestBody{FromEnvId: stagingId, DeploymentId: lo.ToPtr(7)}})
+		require.NoError(t, err)
+		notFound, ok := resp.(oapi.Promote404JSONResponse)
+		require.True(t, ok, "Expected 404 response")
+		assert.Equal(t, "deployment 7 not found in staging", notFound.Error)
+	})
+
+	t.Run("failing to get the deployment", func(t *testing.T) {
+		api := newPromoteTestAPI([]store.Deployment{tested}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("Get", appId, stagingId, uint(7)).Return(store.Deployment{}, errors.New("db is down"))
+
+		resp, err := api.Promote(ctx, oapi.PromoteRequestObject{AppId: appId, EnvId: prodId, Body: &oapi.PromoteJSONRequestBody{FromEnvId: stagingId, DeploymentId: lo.ToPtr(7)}})
+		require.NoError(t, err)
+		serverErr, ok := resp.(oapi.Promote500JSONResponse)
+		require.True(t, ok, "Expected 500 response")
+		assert.Equal(t, "failed to get deployment to promote: db is down", serverErr.Error)
+	})
+
+	t.Run("failing to get the running deployment", func(t *testing.T) {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId).Return(store.App{Common: store.Common{Id: appId}, TeamId: teamId}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", stagingId).Return(store.Env{Common: store.Common{Id: stagingId}, TeamId: teamId, Name: "staging"}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", prodId).Return(store.Env{Common: store.Common{Id: prodId}, TeamId: teamId, Name: "production"}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetForAppEnv", testifymock.Anything, appId, stagingId).Return([]store.Deployment(nil), errors.New("db is down"))
+
+		resp, err := api.Promote(ctx, oapi.PromoteRequestObject{AppId: appId, EnvId: prodId, Body: &oapi.PromoteJSONRequestBody{FromEnvId: stagingId}})
+		require.NoError(t, err)
+		_, ok := resp.(oapi.Promote500JSONResponse)
+		require.True(t, ok, "Expected 500 response")
+	})
+}
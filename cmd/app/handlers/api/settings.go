@@ -0,0 +1,430 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/background/deployment"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/onmetal-dev/metal/lib/validate"
+	"github.com/samber/lo"
+)
+
+func healthCheckToStore(hc *oapi.HealthCheck) *store.HealthCheck {
+	if hc == nil {
+		return nil
+	}
+	return &store.HealthCheck{
+		Path:                lo.FromPtr(hc.Path),
+		PortName:            hc.PortName,
+		Command:             lo.FromPtr(hc.Command),
+		InitialDelaySeconds: lo.FromPtr(hc.InitialDelaySeconds),
+		PeriodSeconds:       lo.FromPtr(hc.PeriodSeconds),
+		FailureThreshold:    lo.FromPtr(hc.FailureThreshold),
+	}
+}
+
+func autoscalingToStore(a *oapi.Autoscaling) *store.Autoscaling {
+	if a == nil {
+		return nil
+	}
+	return &store.Autoscaling{
+		MinReplicas:                    a.MinReplicas,
+		MaxReplicas:                    a.MaxReplicas,
+		TargetCPUUtilizationPercent:    lo.FromPtr(a.TargetCpuUtilizationPercent),
+		TargetMemoryUtilizationPercent: lo.FromPtr(a.TargetMemoryUtilizationPercent),
+	}
+}
+
+func volumesToStore(volumes []oapi.Volume) store.Volumes {
+	return lo.Map(volumes, func(v oapi.Volume, _ int) store.Volume {
+		return store.Volume{
+			Name:      v.Name,
+			MountPath: v.MountPath,
+			SizeGiB:   v.SizeGib,
+			Process:   lo.FromPtr(v.Process),
+		}
+	})
+}
+
+func externalPortsToStore(externalPorts []oapi.ExternalPort) store.ExternalPorts {
+	return lo.Map(externalPorts, func(e oapi.ExternalPort, _ int) store.ExternalPort {
+		return store.ExternalPort{
+			Name:     e.Name,
+			PortName: e.PortName,
+			Proto:    string(e.Proto),
+			Port:     e.Port,
+		}
+	})
+}
+
+func processesToStore(processes []oapi.Process) store.Processes {
+	return lo.Map(processes, func(p oapi.Process, _ int) store.Process {
+		return store.Process{
+			Name:     p.Name,
+			Command:  lo.FromPtr(p.Command),
+			Replicas: p.Replicas,
+			Resources: store.Resources{
+				Limits:   store.ResourceLimits{CpuCores: p.Resources.Limits.CpuCores, MemoryMiB: p.Resources.Limits.MemoryMib},
+				Requests: store.ResourceRequests{CpuCores: p.Resources.Requests.CpuCores, MemoryMiB: p.Resources.Requests.MemoryMib},
+			},
+			Ports: lo.Map(lo.FromPtr(p.Ports), func(port oapi.Port, _ int) store.Port {
+				return store.Port{Name: port.Name, Port: port.Port, Proto: string(port.Proto)}
+			}),
+			Autoscaling: autoscalingToStore(p.Autoscaling),
+		}
+	})
+}
+
+// validateProcesses checks that process and port names are unique, and that the app's external ports and health check still point at a port one of the processes has, speaking the right protocol
+func validateProcesses(processes store.Processes, appSettings store.AppSettings) error {
+	portNames := map[string]bool{}
+	var ports store.Ports
+	for _, process := range processes {
+		if err := validate.Struct(process); err != nil {
+			return fmt.Errorf("invalid process %s: %s", process.Name, err)
+		}
+		for _, port := range process.Ports {
+			if portNames[port.Name] {
+				return fmt.Errorf("port %s is used by more than one process", port.Name)
+			}
+			portNames[port.Name] = true
+		}
+		ports = append(ports, process.Ports...)
+	}
+	if dupes := lo.FindDuplicatesBy(processes, func(p store.Process) string { return p.Name }); len(dupes) > 0 {
+		return fmt.Errorf("process %s is defined more than once", dupes[0].Name)
+	}
+	if len(processes) == 0 {
+		return nil
+	}
+	if err := store.ValidateExternalPorts(appSettings.ExternalPorts.Data(), ports); err != nil {
+		return err
+	}
+	if healthCheck := appSettings.HealthCheck.Data(); healthCheck != nil {
+		if err := store.ValidateHealthCheck(healthCheck, ports); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// redeployWithSettings redeploys the app with the latest deployment's settings, changed by modify, on behalf of the caller
+func (a api) redeployWithSettings(ctx context.Context, latest *store.Deployment, modify func(opts *store.CreateAppSettingsOptions)) (store.Deployment, error) {
+	return deployment.RedeployWithSettings(ctx, a.appStore, a.deploymentStore, a.producerDeployment, latest, deployment.RedeployOptions{
+		Actor:        middleware.Actor(ctx),
+		RequestedBy:  middleware.ActorUserId(ctx),
+		OverrideLock: a.overridesLock(ctx),
+	}, modify)
+}
+
+func (a api) UpdateHealthCheck(ctx context.Context, request oapi.UpdateHealthCheckRequestObject) (oapi.UpdateHealthCheckResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.UpdateHealthCheck404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.UpdateHealthCheck500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	if err := a.checkEnvUnlocked(ctx, env); err != nil {
+		return oapi.UpdateHealthCheck400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	latest, err := a.deploymentStore.GetLatestForAppEnv(ctx, app.Id, env.Id)
+	if err != nil {
+		return oapi.UpdateHealthCheck500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	} else if latest == nil {
+		return oapi.UpdateHealthCheck400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: "app has not been deployed to this env"}}, nil
+	}
+
+	healthCheck := healthCheckToStore(request.Body.HealthCheck)
+	if healthCheck != nil {
+		if err := validate.Struct(healthCheck); err != nil {
+			return oapi.UpdateHealthCheck400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: fmt.Sprintf("invalid health_check: %s", err)}}, nil
+		}
+		if err := store.ValidateHealthCheck(healthCheck, latest.AppSettings.AllPorts()); err != nil {
+			return oapi.UpdateHealthCheck400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+		}
+	}
+
+	d, err := a.redeployWithSettings(ctx, latest, func(opts *store.CreateAppSettingsOptions) {
+		opts.HealthCheck = healthCheck
+	})
+	if err != nil {
+		return oapi.UpdateHealthCheck500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	return oapi.UpdateHealthCheck201JSONResponse(deploymentFromStore(d)), nil
+}
+
+func (a api) UpdateReleaseCommand(ctx context.Context, request oapi.UpdateReleaseCommandRequestObject) (oapi.UpdateReleaseCommandResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.UpdateReleaseCommand404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.UpdateReleaseCommand500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	if err := a.checkEnvUnlocked(ctx, env); err != nil {
+		return oapi.UpdateReleaseCommand400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	latest, err := a.deploymentStore.GetLatestForAppEnv(ctx, app.Id, env.Id)
+	if err != nil {
+		return oapi.UpdateReleaseCommand500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	} else if latest == nil {
+		return oapi.UpdateReleaseCommand400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: "app has not been deployed to this env"}}, nil
+	}
+
+	d, err := a.redeployWithSettings(ctx, latest, func(opts *store.CreateAppSettingsOptions) {
+		opts.ReleaseCommand = request.Body.ReleaseCommand
+	})
+	if err != nil {
+		return oapi.UpdateReleaseCommand500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	return oapi.UpdateReleaseCommand201JSONResponse(deploymentFromStore(d)), nil
+}
+
+func (a api) UpdateProcesses(ctx context.Context, request oapi.UpdateProcessesRequestObject) (oapi.UpdateProcessesResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.UpdateProcesses404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.UpdateProcesses500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	if err := a.checkEnvUnlocked(ctx, env); err != nil {
+		return oapi.UpdateProcesses400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	latest, err := a.deploymentStore.GetLatestForAppEnv(ctx, app.Id, env.Id)
+	if err != nil {
+		return oapi.UpdateProcesses500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	} else if latest == nil {
+		return oapi.UpdateProcesses400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: "app has not been deployed to this env"}}, nil
+	}
+
+	processes := processesToStore(request.Body.Processes)
+	if err := validateProcesses(processes, latest.AppSettings); err != nil {
+		return oapi.UpdateProcesses400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+	withoutProcesses := store.Processes{{Name: store.DefaultProcessName, Replicas: latest.Replicas, Autoscaling: latest.AppSettings.Autoscaling.Data()}}
+	if err := store.ValidateVolumes(latest.AppSettings.Volumes.Data(), lo.Ternary(len(processes) > 0, processes, withoutProcesses)); err != nil {
+		return oapi.UpdateProcesses400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	d, err := a.redeployWithSettings(ctx, latest, func(opts *store.CreateAppSettingsOptions) {
+		opts.Processes = processes
+	})
+	if err != nil {
+		return oapi.UpdateProcesses500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	return oapi.UpdateProcesses201JSONResponse(deploymentFromStore(d)), nil
+}
+
+func (a api) UpdateAutoscaling(ctx context.Context, request oapi.UpdateAutoscalingRequestObject) (oapi.UpdateAutoscalingResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.UpdateAutoscaling404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.UpdateAutoscaling500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	if err := a.checkEnvUnlocked(ctx, env); err != nil {
+		return oapi.UpdateAutoscaling400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	latest, err := a.deploymentStore.GetLatestForAppEnv(ctx, app.Id, env.Id)
+	if err != nil {
+		return oapi.UpdateAutoscaling500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	} else if latest == nil {
+		return oapi.UpdateAutoscaling400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: "app has not been deployed to this env"}}, nil
+	}
+
+	autoscaling := autoscalingToStore(request.Body.Autoscaling)
+	if autoscaling != nil {
+		if err := validate.Struct(autoscaling); err != nil {
+			return oapi.UpdateAutoscaling400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: fmt.Sprintf("invalid autoscaling: %s", err)}}, nil
+		}
+	}
+
+	// apps with their own processes autoscale per process, the others autoscale their single web process from the app-level setting
+	process := lo.FromPtr(request.Body.Process)
+	var processes store.Processes
+	if latest.AppSettings.HasProcesses() {
+		if process == "" {
+			return oapi.UpdateAutoscaling400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: "process is required since the app defines its own processes"}}, nil
+		}
+		processes, err = latest.AppSettings.Processes.Data().Autoscale(process, autoscaling)
+		if err != nil {
+			return oapi.UpdateAutoscaling400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+		}
+	} else if process != "" && process != store.DefaultProcessName {
+		return oapi.UpdateAutoscaling400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: fmt.Sprintf("process %s does not exist", process)}}, nil
+	}
+	withoutProcesses := store.Processes{{Name: store.DefaultProcessName, Replicas: latest.Replicas, Autoscaling: autoscaling}}
+	if err := store.ValidateVolumes(latest.AppSettings.Volumes.Data(), lo.Ternary(processes != nil, processes, withoutProcesses)); err != nil {
+		return oapi.UpdateAutoscaling400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	d, err := a.redeployWithSettings(ctx, latest, func(opts *store.CreateAppSettingsOptions) {
+		if processes != nil {
+			opts.Processes = processes
+		} else {
+			opts.Autoscaling = autoscaling
+		}
+	})
+	if err != nil {
+		return oapi.UpdateAutoscaling500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	return oapi.UpdateAutoscaling201JSONResponse(deploymentFromStore(d)), nil
+}
+
+func (a api) UpdateVolumes(ctx context.Context, request oapi.UpdateVolumesRequestObject) (oapi.UpdateVolumesResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.UpdateVolumes404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.UpdateVolumes500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	if err := a.checkEnvUnlocked(ctx, env); err != nil {
+		return oapi.UpdateVolumes400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	latest, err := a.deploymentStore.GetLatestForAppEnv(ctx, app.Id, env.Id)
+	if err != nil {
+		return oapi.UpdateVolumes500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	} else if latest == nil {
+		return oapi.UpdateVolumes400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: "app has not been deployed to this env"}}, nil
+	}
+
+	volumes := volumesToStore(request.Body.Volumes)
+	for _, v := range volumes {
+		if err := validate.Struct(v); err != nil {
+			return oapi.UpdateVolumes400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: fmt.Sprintf("invalid volume %s: %s", v.Name, err)}}, nil
+		}
+	}
+	if err := store.ValidateVolumes(volumes, latest.Processes()); err != nil {
+		return oapi.UpdateVolumes400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	d, err := a.redeployWithSettings(ctx, latest, func(opts *store.CreateAppSettingsOptions) {
+		opts.Volumes = volumes
+	})
+	if err != nil {
+		return oapi.UpdateVolumes500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	return oapi.UpdateVolumes201JSONResponse(deploymentFromStore(d)), nil
+}
+
+func (a api) UpdateExternalPorts(ctx context.Context, request oapi.UpdateExternalPortsRequestObject) (oapi.UpdateExternalPortsResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.UpdateExternalPorts404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.UpdateExternalPorts500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	if err := a.checkEnvUnlocked(ctx, env); err != nil {
+		return oapi.UpdateExternalPorts400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	latest, err := a.deploymentStore.GetLatestForAppEnv(ctx, app.Id, env.Id)
+	if err != nil {
+		return oapi.UpdateExternalPorts500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	} else if latest == nil {
+		return oapi.UpdateExternalPorts400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: "app has not been deployed to this env"}}, nil
+	}
+
+	externalPorts := externalPortsToStore(request.Body.ExternalPorts)
+	for _, e := range externalPorts {
+		if err := validate.Struct(e); err != nil {
+			return oapi.UpdateExternalPorts400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: fmt.Sprintf("invalid external port %s: %s", e.Name, err)}}, nil
+		}
+	}
+	if err := store.ValidateExternalPorts(externalPorts, latest.AppSettings.AllPorts()); err != nil {
+		return oapi.UpdateExternalPorts400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	d, err := a.redeployWithSettings(ctx, latest, func(opts *store.CreateAppSettingsOptions) {
+		opts.ExternalPorts = externalPorts
+	})
+	if err != nil {
+		return oapi.UpdateExternalPorts500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	return oapi.UpdateExternalPorts201JSONResponse(deploymentFromStore(d)), nil
+}
+
+// dependenciesOfOtherApps returns the names of the team's apps, and what the other apps depend on in the env according to their latest deployment there
+func (a api) dependenciesOfOtherApps(ctx context.Context, teamId string, app store.App, env store.Env) ([]string, map[string][]string, error) {
+	apps, err := a.appStore.GetForTeam(ctx, teamId)
+	if err != nil {
+		return nil, nil, err
+	}
+	envDeployments, err := a.deploymentStore.GetForEnv(env.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+	dependenciesOf := map[string][]string{}
+	latestIds := map[string]uint{}
+	for _, d := range envDeployments {
+		if d.AppId != app.Id && d.Id >= latestIds[d.AppId] {
+			latestIds[d.AppId] = d.Id
+			dependenciesOf[d.App.Name] = d.AppSettings.Dependencies.Data()
+		}
+	}
+	return lo.Map(apps, func(a store.App, _ int) string { return a.Name }), dependenciesOf, nil
+}
+
+func (a api) UpdateDependencies(ctx context.Context, request oapi.UpdateDependenciesRequestObject) (oapi.UpdateDependenciesResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	app, env, err := a.appEnvForTeam(ctx, token.TeamId, request.AppId, request.EnvId)
+	if err != nil {
+		if errors.Is(err, store.ErrAppNotFound) || errors.Is(err, store.ErrEnvNotFound) {
+			return oapi.UpdateDependencies404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: err.Error()}}, nil
+		}
+		return oapi.UpdateDependencies500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	if err := a.checkEnvUnlocked(ctx, env); err != nil {
+		return oapi.UpdateDependencies400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	latest, err := a.deploymentStore.GetLatestForAppEnv(ctx, app.Id, env.Id)
+	if err != nil {
+		return oapi.UpdateDependencies500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	} else if latest == nil {
+		return oapi.UpdateDependencies400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: "app has not been deployed to this env"}}, nil
+	}
+
+	appNames, dependenciesOf, err := a.dependenciesOfOtherApps(ctx, token.TeamId, app, env)
+	if err != nil {
+		return oapi.UpdateDependencies500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	if err := store.ValidateDependencies(app.Name, request.Body.Dependencies, appNames, dependenciesOf); err != nil {
+		return oapi.UpdateDependencies400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	d, err := a.redeployWithSettings(ctx, latest, func(opts *store.CreateAppSettingsOptions) {
+		opts.Dependencies = request.Body.Dependencies
+	})
+	if err != nil {
+		return oapi.UpdateDependencies500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	return oapi.UpdateDependencies201JSONResponse(deploymentFromStore(d)), nil
+}
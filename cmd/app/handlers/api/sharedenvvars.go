@@ -0,0 +1,159 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+)
+
+// sharedEnvVars returns the env vars currently shared by the apps in an env
+func (a api) sharedEnvVars(env store.Env) ([]store.EnvVar, error) {
+	if env.EnvVarSetId == "" {
+		return nil, nil
+	}
+	envVarSet, err := a.deploymentStore.GetEnvVarSet(env.EnvVarSetId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shared env vars: %w", err)
+	}
+	return envVarSet.EnvVars.Data(), nil
+}
+
+// changedEnvVarNames returns the names of the env vars that are added, changed or removed going from one list of env vars to another
+func changedEnvVarNames(from []store.EnvVar, to []store.EnvVar) []string {
+	fromValues := lo.SliceToMap(from, func(e store.EnvVar) (string, string) { return e.Name, e.Value })
+	toValues := lo.SliceToMap(to, func(e store.EnvVar) (string, string) { return e.Name, e.Value })
+	var changed []string
+	for _, name := range lo.Uniq(append(lo.Keys(fromValues), lo.Keys(toValues)...)) {
+		fromValue, inFrom := fromValues[name]
+		toValue, inTo := toValues[name]
+		if inFrom != inTo || fromValue != toValue {
+			changed = append(changed, name)
+		}
+	}
+	return changed
+}
+
+// affectedBySharedEnvVars reports whether changing the shared env vars named changed changes the env vars of a deployment's containers.
+// Deployments that set all of them themselves aren't affected
+func affectedBySharedEnvVars(d store.Deployment, changed []string) bool {
+	return lo.SomeBy(changed, func(name string) bool {
+		return !lo.ContainsBy(d.AppEnvVars.EnvVars.Data(), func(e store.EnvVar) bool { return e.Name == name })
+	})
+}
+
+func envVarNames(envVars []store.EnvVar) []string {
+	return lo.Map(envVars, func(e store.EnvVar, _ int) string { return e.Name })
+}
+
+func (a api) GetSharedEnvVars(ctx context.Context, request oapi.GetSharedEnvVarsRequestObject) (oapi.GetSharedEnvVarsResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	env, err := a.deploymentStore.GetEnv(request.EnvId)
+	if err != nil {
+		if err == store.ErrEnvNotFound {
+			return oapi.GetSharedEnvVars404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: "not found"}}, nil
+		}
+		return oapi.GetSharedEnvVars500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	} else if env.TeamId != token.TeamId {
+		return oapi.GetSharedEnvVars404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: "not found"}}, nil
+	}
+
+	envVars, err := a.sharedEnvVars(env)
+	if err != nil {
+		return oapi.GetSharedEnvVars500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	return oapi.GetSharedEnvVars200JSONResponse{Names: envVarNames(envVars)}, nil
+}
+
+func (a api) UpdateSharedEnvVars(ctx context.Context, request oapi.UpdateSharedEnvVarsRequestObject) (oapi.UpdateSharedEnvVarsResponseObject, error) {
+	token := middleware.MustGetApiToken(ctx)
+
+	env, err := a.deploymentStore.GetEnv(request.EnvId)
+	if err != nil {
+		if err == store.ErrEnvNotFound {
+			return oapi.UpdateSharedEnvVars404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: "not found"}}, nil
+		}
+		return oapi.UpdateSharedEnvVars500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	} else if env.TeamId != token.TeamId {
+		return oapi.UpdateSharedEnvVars404JSONResponse{NotFoundJSONResponse: oapi.NotFoundJSONResponse{Error: "not found"}}, nil
+	}
+	if request.Body == nil {
+		return oapi.UpdateSharedEnvVars400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: "request body is required"}}, nil
+	}
+	redeploy := lo.FromPtr(request.Body.Redeploy)
+	if redeploy {
+		if err := a.checkEnvUnlocked(ctx, env); err != nil {
+			return oapi.UpdateSharedEnvVars400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+		}
+	}
+
+	current, err := a.sharedEnvVars(env)
+	if err != nil {
+		return oapi.UpdateSharedEnvVars500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	envVars, err := withEnvVarChanges(current, lo.FromPtr(request.Body.SetEnvVars), lo.FromPtr(request.Body.UnsetEnvVars))
+	if err != nil {
+		return oapi.UpdateSharedEnvVars400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+	changed := changedEnvVarNames(current, envVars)
+	if len(changed) == 0 {
+		return oapi.UpdateSharedEnvVars200JSONResponse{Names: envVarNames(envVars)}, nil
+	}
+	envVarSet, err := a.deploymentStore.CreateEnvVarSet(store.CreateEnvVarSetOptions{
+		TeamId:  token.TeamId,
+		EnvId:   env.Id,
+		EnvVars: envVars,
+	})
+	if err != nil {
+		return oapi.UpdateSharedEnvVars500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to create shared env vars: %s", err)}}, nil
+	}
+	resp := oapi.UpdateSharedEnvVars200JSONResponse{Names: envVarNames(envVars)}
+	if !redeploy {
+		return resp, nil
+	}
+
+	deployments, err := a.deploymentStore.GetForEnv(env.Id)
+	if err != nil {
+		return oapi.UpdateSharedEnvVars500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: err.Error()}}, nil
+	}
+	// the new env vars are saved already, so an app that fails to redeploy doesn't stop the others. The response says which ones did.
+	redeployed := []oapi.Deployment{}
+	redeployErrors := []oapi.RedeployError{}
+	for _, running := range deployments {
+		if running.Status != store.DeploymentStatusRunning || !affectedBySharedEnvVars(running, changed) {
+			continue
+		}
+		d, err := a.deploymentStore.Create(store.CreateDeploymentOptions{
+			TeamId:        token.TeamId,
+			EnvId:         env.Id,
+			AppId:         running.AppId,
+			Type:          store.DeploymentTypeDeploy,
+			Actor:         middleware.Actor(ctx),
+			OverrideLock:  a.overridesLock(ctx),
+			RequestedBy:   middleware.ActorUserId(ctx),
+			AppSettingsId: running.AppSettingsId,
+			AppEnvVarsId:  running.AppEnvVarsId,
+			EnvVarSetId:   envVarSet.Id,
+			CellIds:       lo.Map(running.Cells, func(c store.Cell, _ int) string { return c.Id }),
+			Replicas:      running.Replicas,
+		})
+		if err != nil {
+			redeployErrors = append(redeployErrors, oapi.RedeployError{AppId: running.AppId, AppName: running.App.Name, Error: fmt.Sprintf("failed to create deployment: %s", err)})
+			continue
+		}
+		if err := a.enqueueDeployment(ctx, d); err != nil {
+			redeployErrors = append(redeployErrors, oapi.RedeployError{AppId: running.AppId, AppName: running.App.Name, Error: fmt.Sprintf("failed to send deployment %d message to queue: %s", d.Id, err)})
+			continue
+		}
+		redeployed = append(redeployed, deploymentFromStore(d))
+	}
+	resp.Redeployed = &redeployed
+	if len(redeployErrors) > 0 {
+		resp.RedeployErrors = &redeployErrors
+	}
+	return resp, nil
+}
@@ -0,0 +1,227 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/onmetal-dev/metal/lib/store/mock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.jetify.com/typeid"
+	"gorm.io/datatypes"
+)
+
+func TestGetSharedEnvVars(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId})
+
+	t.Run("none set", func(t *testing.T) {
+		api := newTestAPI()
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId}, nil)
+
+		resp, err := api.GetSharedEnvVars(ctx, oapi.GetSharedEnvVarsRequestObject{EnvId: envId})
+		require.NoError(t, err)
+		shared, ok := resp.(oapi.GetSharedEnvVars200JSONResponse)
+		require.True(t, ok, "Expected 200 response")
+		assert.Empty(t, shared.Names)
+	})
+
+	t.Run("names only", func(t *testing.T) {
+		api := newTestAPI()
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: teamId, EnvVarSetId: "envvarset_1"}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnvVarSet", "envvarset_1").Return(store.EnvVarSet{EnvVars: datatypes.NewJSONType([]store.EnvVar{{Name: "SENTRY_DSN", Value: "https://sentry.example.com/1"}})}, nil)
+
+		resp, err := api.GetSharedEnvVars(ctx, oapi.GetSharedEnvVarsRequestObject{EnvId: envId})
+		require.NoError(t, err)
+		shared, ok := resp.(oapi.GetSharedEnvVars200JSONResponse)
+		require.True(t, ok, "Expected 200 response")
+		assert.Equal(t, []string{"SENTRY_DSN"}, shared.Names)
+	})
+
+	t.Run("other team's env", func(t *testing.T) {
+		api := newTestAPI()
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(store.Env{Common: store.Common{Id: envId}, TeamId: "team_other"}, nil)
+
+		resp, err := api.GetSharedEnvVars(ctx, oapi.GetSharedEnvVarsRequestObject{EnvId: envId})
+		require.NoError(t, err)
+		_, ok := resp.(oapi.GetSharedEnvVars404JSONResponse)
+		require.True(t, ok, "Expected 404 response")
+	})
+}
+
+func TestUpdateSharedEnvVars(t *testing.T) {
+	envId := typeid.Must(typeid.WithPrefix("env")).String()
+	teamId := typeid.Must(typeid.WithPrefix("team")).String()
+	ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId})
+	env := store.Env{Common: store.Common{Id: envId}, TeamId: teamId, Name: "production", EnvVarSetId: "envvarset_1"}
+	current := store.EnvVarSet{Common: store.Common{Id: "envvarset_1"}, EnvVars: datatypes.NewJSONType([]store.EnvVar{{Name: "SENTRY_DSN", Value: "https://sentry.example.com/1"}})}
+
+	t.Run("unsetting an env var that isn't set", func(t *testing.T) {
+		api := newTestAPI()
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(env, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnvVarSet", "envvarset_1").Return(current, nil)
+
+		resp, err := api.UpdateSharedEnvVars(ctx, oapi.UpdateSharedEnvVarsRequestObject{EnvId: envId, Body: &oapi.UpdateSharedEnvVarsJSONRequestBody{UnsetEnvVars: &[]string{"LOG_LEVEL"}}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.UpdateSharedEnvVars400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Equal(t, "env var LOG_LEVEL is not set", badReq.Error)
+	})
+
+	t.Run("redeploying to a locked env", func(t *testing.T) {
+		api := newTestAPI()
+		locked := env
+		locked.LockedBy, locked.LockedAt = "someone@example.com", lo.ToPtr(time.Now())
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(locked, nil)
+
+		resp, err := api.UpdateSharedEnvVars(ctx, oapi.UpdateSharedEnvVarsRequestObject{EnvId: envId, Body: &oapi.UpdateSharedEnvVarsJSONRequestBody{
+			SetEnvVars: &[]oapi.EnvVar{{Name: "LOG_LEVEL", Value: "debug"}},
+			Redeploy:   lo.ToPtr(true),
+		}})
+		require.NoError(t, err)
+		badReq, ok := resp.(oapi.UpdateSharedEnvVars400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Contains(t, badReq.Error, "production was locked by someone@example.com")
+	})
+
+	t.Run("without redeploying", func(t *testing.T) {
+		api := newTestAPI()
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(env, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnvVarSet", "envvarset_1").Return(current, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("CreateEnvVarSet", store.CreateEnvVarSetOptions{
+			TeamId:  teamId,
+			EnvId:   envId,
+			EnvVars: []store.EnvVar{{Name: "SENTRY_DSN", Value: "https://sentry.example.com/1"}, {Name: "LOG_LEVEL", Value: "debug"}},
+		}).Return(store.EnvVarSet{Common: store.Common{Id: "envvarset_2"}}, nil)
+
+		resp, err := api.UpdateSharedEnvVars(ctx, oapi.UpdateSharedEnvVarsRequestObject{EnvId: envId, Body: &oapi.UpdateSharedEnvVarsJSONRequestBody{
+			SetEnvVars: &[]oapi.EnvVar{{Name: "LOG_LEVEL", Value: "debug"}},
+		}})
+		require.NoError(t, err)
+		shared, ok := resp.(oapi.UpdateSharedEnvVars200JSONResponse)
+		require.True(t, ok, "Expected 200 response")
+		assert.Equal(t, []string{"SENTRY_DSN", "LOG_LEVEL"}, shared.Names)
+		assert.Nil(t, shared.Redeployed)
+	})
+
+	t.Run("nothing changes", func(t *testing.T) {
+		api := newTestAPI()
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(env, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnvVarSet", "envvarset_1").Return(current, nil)
+
+		resp, err := api.UpdateSharedEnvVars(ctx, oapi.UpdateSharedEnvVarsRequestObject{EnvId: envId, Body: &oapi.UpdateSharedEnvVarsJSONRequestBody{
+			SetEnvVars: &[]oapi.EnvVar{{Name: "SENTRY_DSN", Value: "https://sentry.example.com/1"}},
+			Redeploy:   lo.ToPtr(true),
+		}})
+		require.NoError(t, err)
+		_, ok := resp.(oapi.UpdateSharedEnvVars200JSONResponse)
+		require.True(t, ok, "Expected 200 response")
+		api.deploymentStore.(*mock.DeploymentStoreMock).AssertNotCalled(t, "CreateEnvVarSet")
+	})
+
+	t.Run("apps that set the changed env vars themselves aren't redeployed", func(t *testing.T) {
+		api := newTestAPI()
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(env, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnvVarSet", "envvarset_1").Return(current, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("CreateEnvVarSet", store.CreateEnvVarSetOptions{
+			TeamId:  teamId,
+			EnvId:   envId,
+			EnvVars: []store.EnvVar{{Name: "SENTRY_DSN", Value: "https://sentry.example.com/2"}},
+		}).Return(store.EnvVarSet{Common: store.Common{Id: "envvarset_2"}}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetForEnv", envId).Return([]store.Deployment{
+			{Id: 3, AppId: "app_1", Status: store.DeploymentStatusRunning, AppEnvVars: store.AppEnvVars{EnvVars: datatypes.NewJSONType([]store.EnvVar{{Name: "SENTRY_DSN", Value: "https://sentry.example.com/3"}})}},
+			{Id: 2, AppId: "app_2", Status: store.DeploymentStatusStopped},
+		}, nil)
+
+		resp, err := api.UpdateSharedEnvVars(ctx, oapi.UpdateSharedEnvVarsRequestObject{EnvId: envId, Body: &oapi.UpdateSharedEnvVarsJSONRequestBody{
+			SetEnvVars: &[]oapi.EnvVar{{Name: "SENTRY_DSN", Value: "https://sentry.example.com/2"}},
+			Redeploy:   lo.ToPtr(true),
+		}})
+		require.NoError(t, err)
+		shared, ok := resp.(oapi.UpdateSharedEnvVars200JSONResponse)
+		require.True(t, ok, "Expected 200 response")
+		require.NotNil(t, shared.Redeployed)
+		assert.Empty(t, *shared.Redeployed)
+	})
+
+	t.Run("apps that fail to redeploy don't stop the others", func(t *testing.T) {
+		api := newTestAPI()
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId).Return(env, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnvVarSet", "envvarset_1").Return(current, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("CreateEnvVarSet", testifymock.Anything).Return(store.EnvVarSet{Common: store.Common{Id: "envvarset_2"}}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetForEnv", envId).Return([]store.Deployment{
+			{Id: 3, AppId: "app_1", App: store.App{Name: "shop"}, Status: store.DeploymentStatusRunning},
+			{Id: 5, AppId: "app_2", App: store.App{Name: "billing"}, Status: store.DeploymentStatusRunning},
+		}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("Create", testifymock.MatchedBy(func(opts store.CreateDeploymentOptions) bool { return opts.AppId == "app_1" })).Return(store.Deployment{}, errors.New("app_1 is being deleted"))
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("Create", testifymock.MatchedBy(func(opts store.CreateDeploymentOptions) bool { return opts.AppId == "app_2" })).Return(store.Deployment{}, errors.New("connection reset"))
+
+		resp, err := api.UpdateSharedEnvVars(ctx, oapi.UpdateSharedEnvVarsRequestObject{EnvId: envId, Body: &oapi.UpdateSharedEnvVarsJSONRequestBody{
+			SetEnvVars: &[]oapi.EnvVar{{Name: "LOG_LEVEL", Value: "debug"}},
+			Redeploy:   lo.ToPtr(true),
+		}})
+		require.NoError(t, err)
+		shared, ok := resp.(oapi.UpdateSharedEnvVars200JSONResponse)
+		require.True(t, ok, "Expected 200 response since the env vars were saved")
+		assert.Equal(t, []string{"SENTRY_DSN", "LOG_LEVEL"}, shared.Names)
+		require.NotNil(t, shared.Redeployed)
+		assert.Empty(t, *shared.Redeployed)
+		require.NotNil(t, shared.RedeployErrors)
+		assert.Equal(t, []oapi.RedeployError{
+			{AppId: "app_1", AppName: "shop", Error: "failed to create deployment: app_1 is being deleted"},
+			{AppId: "app_2", AppName: "billing", Error: "failed to create deployment: connection reset"},
+		}, *shared.RedeployErrors)
+	})
+}
+
+func TestChangedEnvVarNames(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from     []store.EnvVar
+		to       []store.EnvVar
+		expected []string
+	}{
+		{"none", nil, nil, nil},
+		{"unchanged", []store.EnvVar{{Name: "A", Value: "1"}}, []store.EnvVar{{Name: "A", Value: "1"}}, nil},
+		{"added", []store.EnvVar{{Name: "A", Value: "1"}}, []store.EnvVar{{Name: "A", Value: "1"}, {Name: "B", Value: "2"}}, []string{"B"}},
+		{"removed", []store.EnvVar{{Name: "A", Value: "1"}, {Name: "B", Value: "2"}}, []store.EnvVar{{Name: "B", Value: "2"}}, []string{"A"}},
+		{"changed", []store.EnvVar{{Name: "A", Value: "1"}}, []store.EnvVar{{Name: "A", Value: "2"}}, []string{"A"}},
+		{"set to empty", []store.EnvVar{{Name: "A", Value: "1"}}, []store.EnvVar{{Name: "A", Value: ""}}, []string{"A"}},
+		{"reordered", []store.EnvVar{{Name: "A", Value: "1"}, {Name: "B", Value: "2"}}, []store.EnvVar{{Name: "B", Value: "2"}, {Name: "A", Value: "1"}}, nil},
+		{"all at once", []store.EnvVar{{Name: "A", Value: "1"}, {Name: "B", Value: "2"}, {Name: "C", Value: "3"}}, []store.EnvVar{{Name: "B", Value: "20"}, {Name: "C", Value: "3"}, {Name: "D", Value: "4"}}, []string{"A", "B", "D"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ElementsMatch(t, tc.expected, changedEnvVarNames(tc.from, tc.to))
+		})
+	}
+}
+
+func TestAffectedBySharedEnvVars(t *testing.T) {
+	deployment := store.Deployment{AppEnvVars: store.AppEnvVars{EnvVars: datatypes.NewJSONType([]store.EnvVar{{Name: "SENTRY_DSN", Value: "https://sentry.example.com/3"}, {Name: "DEBUG", Value: "1"}})}}
+	testCases := []struct {
+		name     string
+		changed  []string
+		expected bool
+	}{
+		{"nothing changed", nil, false},
+		{"the app sets the changed env var", []string{"SENTRY_DSN"}, false},
+		{"the app sets all the changed env vars", []string{"SENTRY_DSN", "DEBUG"}, false},
+		{"the app doesn't set the changed env var", []string{"LOG_LEVEL"}, true},
+		{"the app sets some of the changed env vars", []string{"SENTRY_DSN", "LOG_LEVEL"}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, affectedBySharedEnvVars(deployment, tc.changed))
+		})
+	}
+	assert.True(t, affectedBySharedEnvVars(store.Deployment{}, []string{"SENTRY_DSN"}), "Expected an app without env vars of its own to be affected")
+}
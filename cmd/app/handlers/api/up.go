@@ -10,12 +10,14 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/onmetal-dev/metal/cmd/app/middleware"
 	"github.com/onmetal-dev/metal/lib/background"
 	"github.com/onmetal-dev/metal/lib/background/deployment"
+	"github.com/onmetal-dev/metal/lib/background/previewenv"
 	"github.com/onmetal-dev/metal/lib/cellprovider"
 	"github.com/onmetal-dev/metal/lib/logger"
 	"github.com/onmetal-dev/metal/lib/oapi"
@@ -41,7 +43,7 @@ func (a api) Up(ctx context.Context, request oapi.UpRequestObject) (oapi.UpRespo
 	defer os.Remove(tempFile.Name())
 	defer tempFile.Close()
 
-	var envIdBytes, appIdBytes []byte
+	var envIdBytes, appIdBytes, canaryStepsBytes, cellIdBytes, previewBranchBytes, previewIdleTTLBytes []byte
 	var archiveReceived bool
 	for {
 		part, err := request.Body.NextPart()
@@ -57,6 +59,14 @@ func (a api) Up(ctx context.Context, request oapi.UpRequestObject) (oapi.UpRespo
 			envIdBytes, err = io.ReadAll(part)
 		case "app_id":
 			appIdBytes, err = io.ReadAll(part)
+		case "canary_steps":
+			canaryStepsBytes, err = io.ReadAll(part)
+		case "cell_id":
+			cellIdBytes, err = io.ReadAll(part)
+		case "preview_branch":
+			previewBranchBytes, err = io.ReadAll(part)
+		case "preview_idle_ttl_seconds":
+			previewIdleTTLBytes, err = io.ReadAll(part)
 		case "archive":
 			_, err = io.Copy(tempFile, part)
 			archiveReceived = true
@@ -95,6 +105,20 @@ func (a api) Up(ctx context.Context, request oapi.UpRequestObject) (oapi.UpRespo
 	} else if appId.Prefix() != "app" {
 		validationErrors = append(validationErrors, fmt.Errorf("invalid app_id: %s", appId.String()))
 	}
+	canarySteps, err := parseCanarySteps(string(canaryStepsBytes))
+	if err != nil {
+		validationErrors = append(validationErrors, fmt.Errorf("invalid canary_steps: %s", err))
+	}
+	previewBranch := string(previewBranchBytes)
+	previewIdleTTL, err := parsePreviewIdleTTL(string(previewIdleTTLBytes))
+	if err != nil {
+		validationErrors = append(validationErrors, fmt.Errorf("invalid preview_idle_ttl_seconds: %s", err))
+	}
+	if previewBranch != "" {
+		if _, err := store.PreviewEnvName(previewBranch); err != nil {
+			validationErrors = append(validationErrors, fmt.Errorf("invalid preview_branch: %s", err))
+		}
+	}
 	if len(validationErrors) > 0 {
 		return oapi.Up400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: joinErrors(validationErrors)}}, nil
 	}
@@ -114,6 +138,40 @@ func (a api) Up(ctx context.Context, request oapi.UpRequestObject) (oapi.UpRespo
 	if env.TeamId != token.TeamId {
 		return oapi.Up400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: "env does not belong to team"}}, nil
 	}
+	// previews are deployed to the preview env of their branch, starting out like the app in the env they are a preview of
+	var baseEnv *store.Env
+	if previewBranch != "" {
+		preview, err := a.previewEnv(ctx, token.TeamId, previewBranch, previewIdleTTL, env)
+		if err != nil {
+			if errors.Is(err, store.ErrEnvNotPreview) {
+				return oapi.Up400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+			}
+			return oapi.Up500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to get preview env: %s", err)}}, nil
+		}
+		baseEnv, env = &env, preview
+	}
+	if err := a.checkEnvUnlocked(ctx, env); err != nil {
+		return oapi.Up400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
+
+	if len(canarySteps) > 0 {
+		if err := a.checkCanCanary(ctx, app.Id, env.Id); err != nil {
+			return oapi.Up400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+		}
+	}
+
+	cells, err := a.cellStore.GetForTeam(ctx, token.TeamId)
+	if err != nil {
+		return oapi.Up500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to get cells: %s", err)}}, nil
+	}
+	latest, err := a.deploymentStore.GetLatestForAppEnv(ctx, app.Id, env.Id)
+	if err != nil {
+		return oapi.Up500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to get latest deployment: %s", err)}}, nil
+	}
+	buildCell, cellIds, err := selectUpCells(cells, string(cellIdBytes), env, latest)
+	if err != nil {
+		return oapi.Up400JSONResponse{BadRequestJSONResponse: oapi.BadRequestJSONResponse{Error: err.Error()}}, nil
+	}
 
 	// Reset the file pointer to the beginning
 	if _, err := tempFile.Seek(0, 0); err != nil {
@@ -174,35 +232,152 @@ func (a api) Up(ctx context.Context, request oapi.UpRequestObject) (oapi.UpRespo
 		return oapi.Up500JSONResponse{InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{Error: fmt.Sprintf("failed to initialize build: %s", err)}}, nil
 	}
 
+	rollout := a.artifactRollout(ctx, token, app, env, canarySteps, buildCell, cellIds)
+	rollout.baseEnv = baseEnv
 	return customUpResponse{
+		artifactRollout: rollout,
+		buildStore:      a.buildStore,
+		build:           build,
+		tempDir:         tempDir,
+	}, nil
+}
+
+func (a api) artifactRollout(ctx context.Context, token store.ApiToken, app store.App, env store.Env, canarySteps []int, cell store.Cell, cellIds []string) artifactRollout {
+	return artifactRollout{
 		ctx:                 ctx,
-		buildStore:          a.buildStore,
-		cellStore:           a.cellStore,
 		cellProviderForType: a.cellProviderForType,
 		deploymentStore:     a.deploymentStore,
 		appStore:            a.appStore,
 		producerDeployment:  a.producerDeployment,
-		build:               build,
-		tempDir:             tempDir,
 		app:                 app,
 		env:                 env,
 		token:               token,
-	}, nil
+		overrideLock:        a.overridesLock(ctx),
+		canarySteps:         canarySteps,
+		cell:                cell,
+		cellIds:             cellIds,
+	}
 }
 
-type customUpResponse struct {
+// parsePreviewIdleTTL parses the idle TTL of a preview env in seconds. Empty means store.DefaultPreviewIdleTTL
+func parsePreviewIdleTTL(s string) (time.Duration, error) {
+	if s == "" {
+		return store.DefaultPreviewIdleTTL, nil
+	}
+	seconds, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number of seconds", s)
+	}
+	ttl := time.Duration(seconds) * time.Second
+	if ttl < store.MinPreviewIdleTTL || ttl > store.MaxPreviewIdleTTL {
+		return 0, fmt.Errorf("must be between %s and %s", store.MinPreviewIdleTTL, store.MaxPreviewIdleTTL)
+	}
+	return ttl, nil
+}
+
+// previewEnv returns the preview env of a branch, creating it with base's default cell if it doesn't exist yet. New preview envs are
+// torn down by the previewenv queue once they go unused for idleTTL
+func (a api) previewEnv(ctx context.Context, teamId string, branch string, idleTTL time.Duration, base store.Env) (store.Env, error) {
+	env, created, err := a.deploymentStore.UpsertPreviewEnv(store.UpsertPreviewEnvOptions{
+		TeamId:         teamId,
+		Branch:         branch,
+		IdleTTLSeconds: int(idleTTL.Seconds()),
+		DefaultCellId:  base.DefaultCellId,
+	})
+	if err != nil {
+		return store.Env{}, err
+	}
+	if created {
+		if err := a.producerPreviewEnv.SendWithDelay(ctx, previewenv.Message{EnvId: env.Id}, idleTTL); err != nil {
+			return store.Env{}, fmt.Errorf("failed to schedule the teardown of the preview env: %w", err)
+		}
+	}
+	return env, nil
+}
+
+// rolloutOutcome reports whether a deployment that metal up or metal deploy waits for has settled. Deployments waiting for approval
+// have settled with a note for the user. err is set for deployments that won't roll out: failed, rejected, canceled or stopped ones
+func rolloutOutcome(d store.Deployment) (settled bool, note string, err error) {
+	switch d.Status {
+	case store.DeploymentStatusRunning, store.DeploymentStatusCanary:
+		return true, "", nil
+	case store.DeploymentStatusPendingApproval:
+		return true, fmt.Sprintf("⏳ deployment %d is waiting for approval by another admin", d.Id), nil
+	case store.DeploymentStatusRejected:
+		review := d.Review.Data()
+		if review == nil {
+			return true, "", fmt.Errorf("deployment %d was rejected", d.Id)
+		}
+		return true, "", fmt.Errorf("deployment %d was rejected by %s: %s", d.Id, review.Reviewer, lo.CoalesceOrEmpty(review.Comment, "no comment given"))
+	case store.DeploymentStatusFailed:
+		return true, "", fmt.Errorf("deployment failed: %s", d.StatusReason)
+	case store.DeploymentStatusCanceled:
+		return true, "", fmt.Errorf("deployment %d was canceled: %s", d.Id, d.StatusReason)
+	case store.DeploymentStatusStopped:
+		// a newer deployment superseded it
+		return true, "", fmt.Errorf("deployment %d was stopped: %s", d.Id, d.StatusReason)
+	}
+	return false, "", nil
+}
+
+// selectUpCells picks the cell to build on and the cells to deploy to. An explicitly requested cell wins. Otherwise apps stay on
+// the cells they are deployed to, and apps deployed to the env for the first time go to its default cell, or the team's only cell.
+func selectUpCells(cells []store.Cell, cellId string, env store.Env, latest *store.Deployment) (store.Cell, []string, error) {
+	find := func(id string) (store.Cell, error) {
+		cell, ok := lo.Find(cells, func(c store.Cell) bool { return c.Id == id })
+		if !ok {
+			return store.Cell{}, fmt.Errorf("cell %s does not exist", id)
+		}
+		return cell, nil
+	}
+
+	if cellId != "" {
+		cell, err := find(cellId)
+		return cell, []string{cellId}, err
+	}
+	if latest != nil && len(latest.Cells) > 0 {
+		cellIds := lo.Map(latest.Cells, func(c store.Cell, _ int) string { return c.Id })
+		cell, err := find(cellIds[0])
+		return cell, cellIds, err
+	}
+	if env.DefaultCellId != "" {
+		cell, err := find(env.DefaultCellId)
+		return cell, []string{env.DefaultCellId}, err
+	}
+	switch len(cells) {
+	case 0:
+		return store.Cell{}, nil, errors.New("team has no cells to deploy to")
+	case 1:
+		return cells[0], []string{cells[0].Id}, nil
+	}
+	return store.Cell{}, nil, fmt.Errorf("team has %d cells: pick one with cell_id, or set a default cell for env %s", len(cells), env.Name)
+}
+
+// artifactRollout deploys an image to an app's env and streams the deployment's logs until it is running. Up builds the image
+// first, deploy is given one
+type artifactRollout struct {
 	ctx                 context.Context
-	buildStore          store.BuildStore
-	cellStore           store.CellStore
 	cellProviderForType func(cellType store.CellType) cellprovider.CellProvider
 	deploymentStore     store.DeploymentStore
 	appStore            store.AppStore
 	producerDeployment  *background.QueueProducer[deployment.Message]
-	build               store.Build
-	tempDir             string
 	app                 store.App
 	env                 store.Env
 	token               store.ApiToken
+	overrideLock        bool
+	canarySteps         []int
+	// baseEnv is the env a preview env is a preview of. An app's first deployment to a preview env starts out with its settings and env vars there
+	baseEnv *store.Env
+	// cell is where the image is built and the logs are streamed from, cellIds where it is deployed to
+	cell    store.Cell
+	cellIds []string
+}
+
+type customUpResponse struct {
+	artifactRollout
+	buildStore store.BuildStore
+	build      store.Build
+	tempDir    string
 }
 
 type flusherWriter struct {
@@ -222,6 +397,8 @@ func (fw *flusherWriter) Write(p []byte) (n int, err error) {
 func (c customUpResponse) VisitUpResponse(w http.ResponseWriter) (err error) {
 	defer func() {
 		if err != nil {
+			// the stream has started, so failures are reported on it for the CLI to exit with an error
+			fmt.Fprintf(&flusherWriter{w: w}, "error: %s\n", err)
 			c.buildStore.UpdateStatus(context.Background(), c.build.Id, store.BuildStatusFailed, err.Error())
 		} else {
 			c.buildStore.UpdateStatus(context.Background(), c.build.Id, store.BuildStatusCompleted, "")
@@ -235,23 +412,12 @@ func (c customUpResponse) VisitUpResponse(w http.ResponseWriter) (err error) {
 
 	err = c.buildStore.UpdateStatus(context.Background(), c.build.Id, store.BuildStatusBuilding, "")
 	if err != nil {
-		fmt.Fprintf(w, "data: Error starting build: %s\n\n", err)
+		err = fmt.Errorf("error starting build: %w", err)
 		return
 	}
 
-	logger.Info("build started")
-	var cells []store.Cell
-	cells, err = c.cellStore.GetForTeam(c.ctx, c.token.TeamId)
-	if err != nil {
-		logger.Error("failed to get cells", "error", err)
-		return
-	}
-	if len(cells) != 1 {
-		logger.Error("TODO: support specifying selecting a subset of multiple cells for build+deploy", "numCells", len(cells))
-		err = fmt.Errorf("TODO: %d cells. support specifying selecting a subset of multiple cells for build+deploy", len(cells))
-		return
-	}
-	cell := cells[0]
+	logger.Info("build started", "cellId", c.cell.Id)
+	cell := c.cell
 
 	cp := c.cellProviderForType(cell.Type)
 	var artifact *store.ImageArtifact
@@ -277,30 +443,54 @@ func (c customUpResponse) VisitUpResponse(w http.ResponseWriter) (err error) {
 	}
 	fmt.Fprintf(fw, "✅ build complete! beginning deployment 🚀\n")
 
+	return c.deploy(fw, artifact)
+}
+
+// deploy creates app settings for the image and a deployment that uses them, and streams the deployment's logs until it is running
+func (c artifactRollout) deploy(fw io.Writer, artifact *store.ImageArtifact) error {
+	cp := c.cellProviderForType(c.cell.Type)
+	cell := c.cell
+
 	// get latest deployment for this app in this env. We will clone the app settings from this deployment so we match the previous deployment as much as possible.
 	ld, err := c.deploymentStore.GetLatestForAppEnv(c.ctx, c.app.Id, c.env.Id)
 	if err != nil {
 		return fmt.Errorf("failed to get latest deployment: %w", err)
 	}
 
+	// the first deployment to a preview env starts out like the latest deployment of the app in the env it is a preview of
+	template := ld
+	if ld == nil && c.baseEnv != nil {
+		if template, err = c.deploymentStore.GetLatestForAppEnv(c.ctx, c.app.Id, c.baseEnv.Id); err != nil {
+			return fmt.Errorf("failed to get latest deployment in %s: %w", c.baseEnv.Name, err)
+		}
+	}
+
 	var appSettings *store.AppSettings
 	var appEnvVars *store.AppEnvVars
-	if ld != nil {
-		appEnvVars = &ld.AppEnvVars
-		as, err := c.appStore.CreateAppSettings(store.CreateAppSettingsOptions{
-			TeamId: c.token.TeamId,
-			AppId:  c.app.Id,
-			Artifact: store.Artifact{
-				Image: artifact,
-			},
-			Ports:         ld.AppSettings.Ports.Data(),
-			ExternalPorts: ld.AppSettings.ExternalPorts.Data(),
-			Resources:     ld.AppSettings.Resources.Data(),
-		})
+	if template != nil {
+		opts := template.AppSettings.CreateOptions()
+		opts.Artifact = store.Artifact{
+			Image: artifact,
+		}
+		as, err := c.appStore.CreateAppSettings(opts)
 		if err != nil {
 			return fmt.Errorf("failed to create app settings: %w", err)
 		}
 		appSettings = &as
+		if ld != nil {
+			appEnvVars = &ld.AppEnvVars
+		} else {
+			aev, err := c.deploymentStore.CreateAppEnvVars(store.CreateAppEnvVarOptions{
+				TeamId:  c.token.TeamId,
+				EnvId:   c.env.Id,
+				AppId:   c.app.Id,
+				EnvVars: append([]store.EnvVar{}, template.AppEnvVars.EnvVars.Data()...),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create app env vars: %w", err)
+			}
+			appEnvVars = &aev
+		}
 	} else {
 		as, err := c.appStore.CreateAppSettings(store.CreateAppSettingsOptions{
 			TeamId: c.token.TeamId,
@@ -356,16 +546,17 @@ func (c customUpResponse) VisitUpResponse(w http.ResponseWriter) (err error) {
 		EnvId:         c.env.Id,
 		AppId:         c.app.Id,
 		Type:          store.DeploymentTypeDeploy,
+		Actor:         middleware.Actor(c.ctx),
+		OverrideLock:  c.overrideLock,
+		RequestedBy:   middleware.ActorUserId(c.ctx),
 		AppSettingsId: appSettings.Id,
 		AppEnvVarsId:  appEnvVars.Id,
-		CellIds:       []string{cell.Id},
+		CellIds:       c.cellIds,
 		Replicas:      1,
+		CanarySteps:   c.canarySteps,
 	}
 	if ld != nil {
 		cdo.Replicas = ld.Replicas
-		cdo.CellIds = lo.Map(ld.Cells, func(cell store.Cell, _ int) string {
-			return cell.Id
-		})
 	}
 	d, err := c.deploymentStore.Create(cdo)
 	if err != nil {
@@ -381,6 +572,8 @@ func (c customUpResponse) VisitUpResponse(w http.ResponseWriter) (err error) {
 
 	errChan := make(chan error, 2)
 	doneChan := make(chan struct{})
+	// note is what to tell the user about a deployment that settled without rolling out yet. It is set before doneChan is closed
+	var note string
 
 	// goroutine to poll deployment status
 	go func() {
@@ -400,12 +593,12 @@ func (c customUpResponse) VisitUpResponse(w http.ResponseWriter) (err error) {
 					errChan <- fmt.Errorf("failed to get deployment: %w", err)
 					return
 				}
-				switch updatedD.Status {
-				case store.DeploymentStatusRunning:
-					close(doneChan)
+				if settled, n, err := rolloutOutcome(updatedD); err != nil {
+					errChan <- err
 					return
-				case store.DeploymentStatusFailed:
-					errChan <- fmt.Errorf("deployment failed: %s", updatedD.StatusReason)
+				} else if settled {
+					note = n
+					close(doneChan)
 					return
 				}
 			}
@@ -444,6 +637,17 @@ func (c customUpResponse) VisitUpResponse(w http.ResponseWriter) (err error) {
 	case err := <-errChan:
 		return err
 	case <-doneChan:
+		if note != "" {
+			fmt.Fprintf(fw, "%s\n", note)
+			return nil
+		}
+		if len(c.canarySteps) > 0 {
+			fmt.Fprintf(fw, "🐤 canary is serving %d%% of traffic. promote or abort it when you're ready\n", c.canarySteps[0])
+		}
+		if c.env.IsPreview() {
+			fmt.Fprintf(fw, "🔍 preview of %s is up at https://%s. it is torn down once it goes %s without a deployment\n",
+				c.env.PreviewBranch, cp.AppHostname(cell.Id, c.app, c.env), time.Duration(c.env.PreviewIdleTTLSeconds)*time.Second)
+		}
 		return nil
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
@@ -20,6 +21,7 @@ import (
 	testifymock "github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.jetify.com/typeid"
+	"gorm.io/datatypes"
 )
 
 func TestUp(t *testing.T) {
@@ -96,10 +98,57 @@ func TestUp(t *testing.T) {
 		assert.Contains(t, internalErr.Error, "failed to get env")
 	})
 
+	t.Run("invalid preview", func(t *testing.T) {
+		testCases := []struct {
+			name    string
+			branch  string
+			idleTTL string
+			errMsg  string
+		}{
+			{"branch without letters or digits", "///", "", "invalid preview_branch"},
+			{"idle ttl too short", "feature/login", "60", "invalid preview_idle_ttl_seconds: must be between 10m0s and 720h0m0s"},
+			{"idle ttl not a number", "feature/login", "2d", "invalid preview_idle_ttl_seconds: \"2d\" is not a number of seconds"},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				api := newTestAPI()
+				ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId.String()})
+				resp, err := api.Up(ctx, oapi.UpRequestObject{Body: createPreviewMultipartBody(t, envId.String(), appId.String(), tc.branch, tc.idleTTL)})
+				require.NoError(t, err)
+
+				badReq, ok := resp.(oapi.Up400JSONResponse)
+				require.True(t, ok, "Expected 400 response")
+				assert.Contains(t, badReq.Error, tc.errMsg)
+			})
+		}
+	})
+
+	t.Run("preview env name taken by another env", func(t *testing.T) {
+		api := newTestAPI()
+		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId.String()).Return(store.App{TeamId: teamId.String()}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId.String()).Return(store.Env{Common: store.Common{Id: envId.String()}, TeamId: teamId.String(), DefaultCellId: "cell_1"}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("UpsertPreviewEnv", store.UpsertPreviewEnvOptions{
+			TeamId:         teamId.String(),
+			Branch:         "staging",
+			IdleTTLSeconds: int(store.DefaultPreviewIdleTTL.Seconds()),
+			DefaultCellId:  "cell_1",
+		}).Return(store.Env{}, false, fmt.Errorf("staging is not the preview env of branch staging: %w", store.ErrEnvNotPreview))
+
+		ctx := middleware.WithApiToken(context.Background(), store.ApiToken{TeamId: teamId.String()})
+		resp, err := api.Up(ctx, oapi.UpRequestObject{Body: createPreviewMultipartBody(t, envId.String(), appId.String(), "staging", "")})
+		require.NoError(t, err)
+
+		badReq, ok := resp.(oapi.Up400JSONResponse)
+		require.True(t, ok, "Expected 400 response")
+		assert.Contains(t, badReq.Error, "staging is not the preview env of branch staging")
+	})
+
 	t.Run("success case", func(t *testing.T) {
 		api := newTestAPI()
 		api.appStore.(*mock.AppStoreMock).On("Get", testifymock.Anything, appId.String()).Return(store.App{TeamId: teamId.String()}, nil)
 		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetEnv", envId.String()).Return(store.Env{TeamId: teamId.String()}, nil)
+		api.cellStore.(*mock.CellStoreMock).On("GetForTeam", testifymock.Anything, teamId.String()).Return([]store.Cell{{Common: store.Common{Id: "cell_1"}}}, nil)
+		api.deploymentStore.(*mock.DeploymentStoreMock).On("GetLatestForAppEnv", testifymock.Anything, testifymock.Anything, testifymock.Anything).Return((*store.Deployment)(nil), nil)
 		api.buildStore.(*mock.BuildStoreMock).On("Init", testifymock.Anything, store.InitBuildOptions{
 			TeamId: teamId.String(),
 		}).Return(store.Build{Common: store.Common{Id: buildId.String()}}, nil)
@@ -136,6 +185,81 @@ func TestUp(t *testing.T) {
 	})
 }
 
+func TestSelectUpCells(t *testing.T) {
+	fsn1 := store.Cell{Common: store.Common{Id: "cell_fsn1"}, Name: "fsn1"}
+	nbg1 := store.Cell{Common: store.Common{Id: "cell_nbg1"}, Name: "nbg1"}
+	env := store.Env{Name: "production"}
+	withDefault := store.Env{Name: "production", DefaultCellId: nbg1.Id}
+	deployed := &store.Deployment{Cells: []store.Cell{fsn1, nbg1}}
+
+	testCases := []struct {
+		name      string
+		cells     []store.Cell
+		cellId    string
+		env       store.Env
+		latest    *store.Deployment
+		buildCell string
+		cellIds   []string
+		errMsg    string
+	}{
+		{"only cell", []store.Cell{fsn1}, "", env, nil, fsn1.Id, []string{fsn1.Id}, ""},
+		{"no cells", nil, "", env, nil, "", nil, "team has no cells"},
+		{"several cells without a default", []store.Cell{fsn1, nbg1}, "", env, nil, "", nil, "team has 2 cells: pick one with cell_id, or set a default cell for env production"},
+		{"env default", []store.Cell{fsn1, nbg1}, "", withDefault, nil, nbg1.Id, []string{nbg1.Id}, ""},
+		{"stays on its cells", []store.Cell{fsn1, nbg1}, "", withDefault, deployed, fsn1.Id, []string{fsn1.Id, nbg1.Id}, ""},
+		{"requested cell", []store.Cell{fsn1, nbg1}, nbg1.Id, env, deployed, nbg1.Id, []string{nbg1.Id}, ""},
+		{"other team's cell", []store.Cell{fsn1}, "cell_other", env, nil, "", nil, "cell cell_other does not exist"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			buildCell, cellIds, err := selectUpCells(tc.cells, tc.cellId, tc.env, tc.latest)
+			if tc.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.buildCell, buildCell.Id)
+			assert.Equal(t, tc.cellIds, cellIds)
+		})
+	}
+}
+
+func TestRolloutOutcome(t *testing.T) {
+	rejected := store.Deployment{Id: 4, Status: store.DeploymentStatusRejected, Review: datatypes.NewJSONType(&store.DeploymentReview{Reviewer: "admin@example.com", Comment: "not during the sale"})}
+
+	testCases := []struct {
+		name       string
+		deployment store.Deployment
+		settled    bool
+		note       string
+		errMsg     string
+	}{
+		{"deploying", store.Deployment{Id: 4, Status: store.DeploymentStatusDeploying}, false, "", ""},
+		{"running", store.Deployment{Id: 4, Status: store.DeploymentStatusRunning}, true, "", ""},
+		{"canary", store.Deployment{Id: 4, Status: store.DeploymentStatusCanary}, true, "", ""},
+		{"failed", store.Deployment{Id: 4, Status: store.DeploymentStatusFailed, StatusReason: "CrashLoopBackOff"}, true, "", "deployment failed: CrashLoopBackOff"},
+		{"pending approval", store.Deployment{Id: 4, Status: store.DeploymentStatusPendingApproval}, true, "⏳ deployment 4 is waiting for approval by another admin", ""},
+		{"rejected", rejected, true, "", "deployment 4 was rejected by admin@example.com: not during the sale"},
+		{"rejected without a review", store.Deployment{Id: 4, Status: store.DeploymentStatusRejected}, true, "", "deployment 4 was rejected"},
+		{"canceled", store.Deployment{Id: 4, Status: store.DeploymentStatusCanceled, StatusReason: "canceled by someone@example.com"}, true, "", "deployment 4 was canceled: canceled by someone@example.com"},
+		{"superseded", store.Deployment{Id: 4, Status: store.DeploymentStatusStopped, StatusReason: "replaced by deployment 5"}, true, "", "deployment 4 was stopped: replaced by deployment 5"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			settled, note, err := rolloutOutcome(tc.deployment)
+			assert.Equal(t, tc.settled, settled)
+			assert.Equal(t, tc.note, note)
+			if tc.errMsg != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.errMsg, err.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func createMultipartBody(t *testing.T, envId, appId string, includeArchive bool) *multipart.Reader {
 	var body bytes.Buffer
 	writer := multipart.NewWriter(&body)
@@ -170,6 +294,37 @@ func createMultipartBody(t *testing.T, envId, appId string, includeArchive bool)
 	return reader
 }
 
+func createPreviewMultipartBody(t *testing.T, envId, appId, branch, idleTTL string) *multipart.Reader {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	fields := map[string]string{"env_id": envId, "app_id": appId, "preview_branch": branch}
+	if idleTTL != "" {
+		fields["preview_idle_ttl_seconds"] = idleTTL
+	}
+	for name, value := range fields {
+		err := writer.WriteField(name, value)
+		require.NoError(t, err)
+	}
+
+	part, err := writer.CreateFormFile("archive", "archive.tar.gz")
+	require.NoError(t, err)
+	_, err = part.Write([]byte("mock archive content"))
+	require.NoError(t, err)
+
+	err = writer.Close()
+	require.NoError(t, err)
+
+	req, err := http.NewRequest("POST", "", &body)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	reader, err := req.MultipartReader()
+	require.NoError(t, err)
+
+	return reader
+}
+
 func createMultipartBodyWithFiles(t *testing.T, envId, appId, dirPath string) *multipart.Reader {
 	var body bytes.Buffer
 	writer := multipart.NewWriter(&body)
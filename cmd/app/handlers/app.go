@@ -1,11 +1,14 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/dustin/go-humanize/english"
 	"github.com/go-chi/chi/v5"
 	"github.com/joho/godotenv"
 	"github.com/onmetal-dev/metal/cmd/app/middleware"
@@ -13,6 +16,8 @@ import (
 	"github.com/onmetal-dev/metal/cmd/app/urls"
 	"github.com/onmetal-dev/metal/lib/background"
 	"github.com/onmetal-dev/metal/lib/background/deployment"
+	"github.com/onmetal-dev/metal/lib/cellprovider"
+	"github.com/onmetal-dev/metal/lib/domainverify"
 	"github.com/onmetal-dev/metal/lib/form"
 	"github.com/onmetal-dev/metal/lib/logger"
 	"github.com/onmetal-dev/metal/lib/store"
@@ -21,24 +26,32 @@ import (
 )
 
 type AppDetailsHandler struct {
-	userStore          store.UserStore
-	teamStore          store.TeamStore
-	serverStore        store.ServerStore
-	cellStore          store.CellStore
-	deploymentStore    store.DeploymentStore
-	appStore           store.AppStore
-	producerDeployment *background.QueueProducer[deployment.Message]
+	userStore           store.UserStore
+	teamStore           store.TeamStore
+	serverStore         store.ServerStore
+	cellStore           store.CellStore
+	deploymentStore     store.DeploymentStore
+	appStore            store.AppStore
+	cronJobStore        store.CronJobStore
+	domainStore         store.DomainStore
+	domainResolver      domainverify.Resolver
+	cellProviderForType func(cellType store.CellType) cellprovider.CellProvider
+	producerDeployment  *background.QueueProducer[deployment.Message]
 }
 
-func NewAppDetailsHandler(userStore store.UserStore, teamStore store.TeamStore, serverStore store.ServerStore, cellStore store.CellStore, deploymentStore store.DeploymentStore, appStore store.AppStore, producerDeployment *background.QueueProducer[deployment.Message]) *AppDetailsHandler {
+func NewAppDetailsHandler(userStore store.UserStore, teamStore store.TeamStore, serverStore store.ServerStore, cellStore store.CellStore, deploymentStore store.DeploymentStore, appStore store.AppStore, cronJobStore store.CronJobStore, domainStore store.DomainStore, domainResolver domainverify.Resolver, cellProviderForType func(cellType store.CellType) cellprovider.CellProvider, producerDeployment *background.QueueProducer[deployment.Message]) *AppDetailsHandler {
 	return &AppDetailsHandler{
-		userStore:          userStore,
-		teamStore:          teamStore,
-		serverStore:        serverStore,
-		cellStore:          cellStore,
-		deploymentStore:    deploymentStore,
-		appStore:           appStore,
-		producerDeployment: producerDeployment,
+		userStore:           userStore,
+		teamStore:           teamStore,
+		serverStore:         serverStore,
+		cellStore:           cellStore,
+		deploymentStore:     deploymentStore,
+		appStore:            appStore,
+		cronJobStore:        cronJobStore,
+		domainStore:         domainStore,
+		domainResolver:      domainResolver,
+		cellProviderForType: cellProviderForType,
+		producerDeployment:  producerDeployment,
 	}
 }
 
@@ -120,6 +133,9 @@ func (h *AppDetailsHandler) ServeHTTPVariablesUpdate(w http.ResponseWriter, r *h
 		http.Error(w, "env not found", http.StatusNotFound)
 		return
 	}
+	if !checkEnvUnlocked(w, r, team, user, *env) {
+		return
+	}
 
 	var f templates.UpdateAppEnvVarsFormData
 	inputErrs, err := form.Decode(&f, r)
@@ -163,6 +179,9 @@ func (h *AppDetailsHandler) ServeHTTPVariablesUpdate(w http.ResponseWriter, r *h
 	d, err := h.deploymentStore.Create(store.CreateDeploymentOptions{
 		TeamId:        teamId,
 		Type:          store.DeploymentTypeDeploy,
+		Actor:         middleware.Actor(ctx),
+		OverrideLock:  overridesLock(r, team, user),
+		RequestedBy:   middleware.ActorUserId(ctx),
 		EnvId:         env.Id,
 		AppId:         appId,
 		AppSettingsId: latestDeployment.AppSettingsId,
@@ -224,11 +243,107 @@ func (h *AppDetailsHandler) ServeHTTPSettings(w http.ResponseWriter, r *http.Req
 		ActiveTeam: *team,
 		Envs:       team.Envs,
 		ActiveEnv:  env,
-	}, templates.AppDetailsLayout(*team, *env, latestDeployment.App, templates.AppMenuItemSettings, templates.AppDetailsSettings(latestDeployment.AppSettings))).Render(ctx, w); err != nil {
+	}, templates.AppDetailsLayout(*team, *env, latestDeployment.App, templates.AppMenuItemSettings,
+		templates.AppDetailsSettings(teamId, env.Name, latestDeployment.AppSettings, healthCheckFormData(latestDeployment.AppSettings.HealthCheck.Data())))).Render(ctx, w); err != nil {
 		http.Error(w, fmt.Sprintf("error rendering template: %v", err), http.StatusInternalServerError)
 	}
 }
 
+// redeployWithSettings redeploys the app with the latest deployment's settings, changed by modify, on behalf of the logged in user
+func (h *AppDetailsHandler) redeployWithSettings(ctx context.Context, latestDeployment *store.Deployment, overrideLock bool, modify func(opts *store.CreateAppSettingsOptions)) (store.Deployment, error) {
+	return deployment.RedeployWithSettings(ctx, h.appStore, h.deploymentStore, h.producerDeployment, latestDeployment, deployment.RedeployOptions{
+		Actor:        middleware.Actor(ctx),
+		RequestedBy:  middleware.ActorUserId(ctx),
+		OverrideLock: overrideLock,
+	}, modify)
+}
+
+func healthCheckFormData(hc *store.HealthCheck) templates.HealthCheckFormData {
+	if hc == nil {
+		return templates.HealthCheckFormData{}
+	}
+	return templates.HealthCheckFormData{
+		Path:                hc.Path,
+		PortName:            hc.PortName,
+		Command:             hc.Command,
+		InitialDelaySeconds: hc.InitialDelaySeconds,
+		PeriodSeconds:       hc.PeriodSeconds,
+		FailureThreshold:    hc.FailureThreshold,
+	}
+}
+
+func (h *AppDetailsHandler) ServeHTTPHealthCheckUpdate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	teamId := chi.URLParam(r, "teamId")
+	envName := chi.URLParam(r, "envName")
+	appId := chi.URLParam(r, "appId")
+	user := middleware.GetUser(ctx)
+	team, _ := validateAndFetchTeams(ctx, h.teamStore, w, teamId, user)
+	if team == nil {
+		return
+	}
+	var env *store.Env
+	for _, e := range team.Envs {
+		if e.Name == envName {
+			env = &e
+		}
+	}
+	if env == nil {
+		http.Error(w, "env not found", http.StatusNotFound)
+		return
+	}
+	if !checkEnvUnlocked(w, r, team, user, *env) {
+		return
+	}
+	latestDeployment, err := h.deploymentStore.GetLatestForAppEnv(ctx, appId, env.Id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	} else if latestDeployment == nil {
+		http.Error(w, "app has not been deployed to this env", http.StatusBadRequest)
+		return
+	}
+	ports := latestDeployment.AppSettings.AllPorts()
+
+	var f templates.HealthCheckFormData
+	inputErrs, err := form.Decode(&f, r)
+	if inputErrs.NotNil() || err != nil {
+		if err := templates.HealthCheckForm(teamId, envName, appId, ports, f, inputErrs, err).Render(ctx, w); err != nil {
+			http.Error(w, fmt.Sprintf("error rendering template: %v", err), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	var healthCheck *store.HealthCheck
+	if f.PortName != "" {
+		healthCheck = &store.HealthCheck{
+			Path:                f.Path,
+			PortName:            f.PortName,
+			Command:             f.Command,
+			InitialDelaySeconds: f.InitialDelaySeconds,
+			PeriodSeconds:       f.PeriodSeconds,
+			FailureThreshold:    f.FailureThreshold,
+		}
+		if err := store.ValidateHealthCheck(healthCheck, ports); err != nil {
+			if err := templates.HealthCheckForm(teamId, envName, appId, ports, f, inputErrs, err).Render(ctx, w); err != nil {
+				http.Error(w, fmt.Sprintf("error rendering template: %v", err), http.StatusInternalServerError)
+			}
+			return
+		}
+	}
+	d, err := h.redeployWithSettings(ctx, latestDeployment, overridesLock(r, team, user), func(opts *store.CreateAppSettingsOptions) {
+		opts.HealthCheck = healthCheck
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	middleware.AddFlash(ctx, fmt.Sprintf("health check updated successfully. deployment %d created", d.Id))
+	w.Header().Set("HX-Redirect", urls.EnvAppDeployments{TeamId: teamId, AppId: appId, EnvName: envName}.Render())
+	w.WriteHeader(http.StatusOK)
+}
+
 func byDateDescending(deployments []store.Deployment) []store.Deployment {
 	sort.Slice(deployments, func(i, j int) bool {
 		return deployments[i].CreatedAt.After(deployments[j].CreatedAt)
@@ -260,6 +375,7 @@ func (h *AppDetailsHandler) ServeHTTPDeployments(w http.ResponseWriter, r *http.
 
 	var (
 		deployments []store.Deployment
+		events      []store.DeploymentEvent
 		app         *store.App
 	)
 
@@ -270,6 +386,12 @@ func (h *AppDetailsHandler) ServeHTTPDeployments(w http.ResponseWriter, r *http.
 		return err
 	})
 
+	g.Go(func() error {
+		var err error
+		events, err = h.deploymentStore.GetEventsForAppEnv(ctx, appId, env.Id)
+		return err
+	})
+
 	g.Go(func() error {
 		a, err := h.appStore.Get(ctx, appId)
 		if err != nil {
@@ -297,13 +419,372 @@ func (h *AppDetailsHandler) ServeHTTPDeployments(w http.ResponseWriter, r *http.
 		}
 	}
 
+	var (
+		replicas  []cellprovider.ProcessReplicas
+		endpoints []cellprovider.Endpoint
+	)
+	if activeDeployment != nil {
+		replicas = h.processReplicas(ctx, activeDeployment)
+		endpoints = h.endpoints(ctx, activeDeployment)
+	}
+
+	eventsByDeployment := lo.GroupBy(events, func(e store.DeploymentEvent) uint { return e.DeploymentId })
 	if err := templates.DashboardLayout(templates.DashboardState{
 		User:       *user,
 		Teams:      teams,
 		ActiveTeam: *team,
 		Envs:       team.Envs,
 		ActiveEnv:  env,
-	}, templates.AppDetailsLayout(*team, *env, *app, templates.AppMenuItemDeployments, templates.AppDetailsDeployments(activeDeployment, sortedDeployments))).Render(ctx, w); err != nil {
+	}, templates.AppDetailsLayout(*team, *env, *app, templates.AppMenuItemDeployments, templates.AppDetailsDeployments(teamId, envName, activeDeployment, replicas, endpoints, sortedDeployments, eventsByDeployment))).Render(ctx, w); err != nil {
 		http.Error(w, fmt.Sprintf("error rendering template: %v", err), http.StatusInternalServerError)
 	}
 }
+
+// cellProviderForDeployment returns the provider and id of the cell the deployment runs on. Errors are logged and return a nil provider.
+func (h *AppDetailsHandler) cellProviderForDeployment(ctx context.Context, d *store.Deployment) (cellprovider.CellProvider, string) {
+	log := logger.FromContext(ctx)
+	if len(d.Cells) == 0 {
+		return nil, ""
+	}
+	cell, err := h.cellStore.Get(d.Cells[0].Id)
+	if err != nil {
+		log.Error("error fetching cell", "error", err)
+		return nil, ""
+	}
+	cellProvider := h.cellProviderForType(cell.Type)
+	if cellProvider == nil {
+		log.Error("no cell provider found for cell type", "type", cell.Type)
+		return nil, ""
+	}
+	return cellProvider, cell.Id
+}
+
+// processReplicas asks the cell how many replicas the deployment's processes have and want. Errors are logged and leave the counts out of the page.
+func (h *AppDetailsHandler) processReplicas(ctx context.Context, d *store.Deployment) []cellprovider.ProcessReplicas {
+	cellProvider, cellId := h.cellProviderForDeployment(ctx, d)
+	if cellProvider == nil {
+		return nil
+	}
+	replicas, err := cellProvider.ProcessReplicas(ctx, cellId, d)
+	if err != nil {
+		logger.FromContext(ctx).Error("error fetching process replicas", "error", err)
+		return nil
+	}
+	return replicas
+}
+
+// endpoints asks the cell where the deployment's external ports can be reached. Errors are logged and leave the endpoints out of the page.
+func (h *AppDetailsHandler) endpoints(ctx context.Context, d *store.Deployment) []cellprovider.Endpoint {
+	cellProvider, cellId := h.cellProviderForDeployment(ctx, d)
+	if cellProvider == nil {
+		return nil
+	}
+	endpoints, err := cellProvider.Endpoints(ctx, cellId, d)
+	if err != nil {
+		logger.FromContext(ctx).Error("error fetching endpoints", "error", err)
+		return nil
+	}
+	return endpoints
+}
+
+func (h *AppDetailsHandler) ServeHTTPRollback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	teamId := chi.URLParam(r, "teamId")
+	envName := chi.URLParam(r, "envName")
+	appId := chi.URLParam(r, "appId")
+	deploymentId, err := strconv.ParseUint(chi.URLParam(r, "deploymentId"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid deployment id", http.StatusBadRequest)
+		return
+	}
+	user := middleware.GetUser(ctx)
+	team, _ := validateAndFetchTeams(ctx, h.teamStore, w, teamId, user)
+	if team == nil {
+		return
+	}
+	var env *store.Env
+	for _, e := range team.Envs {
+		if e.Name == envName {
+			env = &e
+		}
+	}
+	if env == nil {
+		http.Error(w, "env not found", http.StatusNotFound)
+		return
+	}
+	if !checkEnvUnlocked(w, r, team, user, *env) {
+		return
+	}
+
+	target, err := h.deploymentStore.Get(appId, env.Id, uint(deploymentId))
+	if err != nil {
+		http.Error(w, "deployment not found", http.StatusNotFound)
+		return
+	}
+	if !target.CanRollbackTo() {
+		http.Error(w, fmt.Sprintf("cannot roll back to deployment %d with status %s", target.Id, target.Status), http.StatusBadRequest)
+		return
+	}
+
+	d, err := h.deploymentStore.Create(store.CreateDeploymentOptions{
+		TeamId:        teamId,
+		Type:          store.DeploymentTypeRollback,
+		Actor:         middleware.Actor(ctx),
+		OverrideLock:  overridesLock(r, team, user),
+		RequestedBy:   middleware.ActorUserId(ctx),
+		EnvId:         env.Id,
+		AppId:         appId,
+		AppSettingsId: target.AppSettingsId,
+		AppEnvVarsId:  target.AppEnvVarsId,
+		EnvVarSetId:   target.EnvVarSetId,
+		CellIds:       lo.Map(target.Cells, func(c store.Cell, _ int) string { return c.Id }),
+		Replicas:      target.Replicas,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := h.producerDeployment.Send(ctx, deployment.Message{
+		DeploymentId: d.Id,
+		AppId:        d.AppId,
+		EnvId:        d.EnvId,
+	}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	middleware.AddFlash(ctx, fmt.Sprintf("rolling back to deployment %d. deployment %d created", target.Id, d.Id))
+	w.Header().Set("HX-Redirect", urls.EnvAppDeployments{TeamId: teamId, AppId: appId, EnvName: envName}.Render())
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *AppDetailsHandler) ServeHTTPScale(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	teamId := chi.URLParam(r, "teamId")
+	envName := chi.URLParam(r, "envName")
+	appId := chi.URLParam(r, "appId")
+	user := middleware.GetUser(ctx)
+	team, _ := validateAndFetchTeams(ctx, h.teamStore, w, teamId, user)
+	if team == nil {
+		return
+	}
+	var env *store.Env
+	for _, e := range team.Envs {
+		if e.Name == envName {
+			env = &e
+		}
+	}
+	if env == nil {
+		http.Error(w, "env not found", http.StatusNotFound)
+		return
+	}
+	if !checkEnvUnlocked(w, r, team, user, *env) {
+		return
+	}
+
+	var f templates.ScaleFormData
+	inputErrs, err := form.Decode(&f, r)
+	if inputErrs.NotNil() || err != nil {
+		if err := templates.ScaleForm(teamId, envName, appId, f, inputErrs, err).Render(ctx, w); err != nil {
+			http.Error(w, fmt.Sprintf("error rendering template: %v", err), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// create a new deployment using the latest deployment as a template, changing only the replica count
+	latestDeployment, err := h.deploymentStore.GetLatestForAppEnv(ctx, appId, env.Id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	} else if latestDeployment == nil {
+		http.Error(w, "app has not been deployed to this env", http.StatusBadRequest)
+		return
+	}
+	if p, ok := lo.Find(latestDeployment.Processes(), func(p store.Process) bool {
+		return p.Autoscaling != nil && (p.Name == f.Process || !latestDeployment.AppSettings.HasProcesses())
+	}); ok {
+		http.Error(w, fmt.Sprintf("process %s autoscales between %d and %d replicas", p.Name, p.Autoscaling.MinReplicas, p.Autoscaling.MaxReplicas), http.StatusBadRequest)
+		return
+	}
+	// apps with their own processes keep per-process replica counts in their settings, so scaling one of them mints new settings
+	appSettingsId := latestDeployment.AppSettingsId
+	replicas := f.Replicas
+	scaledProcesses := store.Processes{{Name: store.DefaultProcessName, Replicas: f.Replicas}}
+	if latestDeployment.AppSettings.HasProcesses() {
+		if scaledProcesses, err = latestDeployment.AppSettings.Processes.Data().Scale(f.Process, f.Replicas); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	if err := store.ValidateVolumes(latestDeployment.AppSettings.Volumes.Data(), scaledProcesses); err != nil {
+		inputErrs.Set("Replicas", err)
+		if err := templates.ScaleForm(teamId, envName, appId, f, inputErrs, nil).Render(ctx, w); err != nil {
+			http.Error(w, fmt.Sprintf("error rendering template: %v", err), http.StatusInternalServerError)
+		}
+		return
+	}
+	if latestDeployment.AppSettings.HasProcesses() {
+		opts := latestDeployment.AppSettings.CreateOptions()
+		opts.Processes = scaledProcesses
+		appSettings, err := h.appStore.CreateAppSettings(opts)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("error creating app settings: %v", err), http.StatusInternalServerError)
+			return
+		}
+		appSettingsId = appSettings.Id
+		replicas = latestDeployment.Replicas
+	}
+	d, err := h.deploymentStore.Create(store.CreateDeploymentOptions{
+		TeamId:        teamId,
+		Type:          store.DeploymentTypeScale,
+		Actor:         middleware.Actor(ctx),
+		OverrideLock:  overridesLock(r, team, user),
+		RequestedBy:   middleware.ActorUserId(ctx),
+		EnvId:         env.Id,
+		AppId:         appId,
+		AppSettingsId: appSettingsId,
+		AppEnvVarsId:  latestDeployment.AppEnvVarsId,
+		CellIds:       lo.Map(latestDeployment.Cells, func(c store.Cell, _ int) string { return c.Id }),
+		Replicas:      replicas,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := h.producerDeployment.Send(ctx, deployment.Message{
+		DeploymentId: d.Id,
+		AppId:        d.AppId,
+		EnvId:        d.EnvId,
+	}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	scaled := ""
+	if f.Process != "" {
+		scaled = f.Process + " "
+	}
+	middleware.AddFlash(ctx, fmt.Sprintf("scaling %sto %s. deployment %d created", scaled, english.Plural(f.Replicas, "replica", ""), d.Id))
+	w.Header().Set("HX-Redirect", urls.EnvAppDeployments{TeamId: teamId, AppId: appId, EnvName: envName}.Render())
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *AppDetailsHandler) ServeHTTPRestart(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	teamId := chi.URLParam(r, "teamId")
+	envName := chi.URLParam(r, "envName")
+	appId := chi.URLParam(r, "appId")
+	user := middleware.GetUser(ctx)
+	team, _ := validateAndFetchTeams(ctx, h.teamStore, w, teamId, user)
+	if team == nil {
+		return
+	}
+	var env *store.Env
+	for _, e := range team.Envs {
+		if e.Name == envName {
+			env = &e
+		}
+	}
+	if env == nil {
+		http.Error(w, "env not found", http.StatusNotFound)
+		return
+	}
+	if !checkEnvUnlocked(w, r, team, user, *env) {
+		return
+	}
+
+	latestDeployment, err := h.deploymentStore.GetLatestForAppEnv(ctx, appId, env.Id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	} else if latestDeployment == nil {
+		http.Error(w, "app has not been deployed to this env", http.StatusBadRequest)
+		return
+	}
+	d, err := h.deploymentStore.Create(store.CreateDeploymentOptions{
+		TeamId:        teamId,
+		Type:          store.DeploymentTypeRestart,
+		Actor:         middleware.Actor(ctx),
+		OverrideLock:  overridesLock(r, team, user),
+		RequestedBy:   middleware.ActorUserId(ctx),
+		EnvId:         env.Id,
+		AppId:         appId,
+		AppSettingsId: latestDeployment.AppSettingsId,
+		AppEnvVarsId:  latestDeployment.AppEnvVarsId,
+		CellIds:       lo.Map(latestDeployment.Cells, func(c store.Cell, _ int) string { return c.Id }),
+		Replicas:      latestDeployment.Replicas,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := h.producerDeployment.Send(ctx, deployment.Message{
+		DeploymentId: d.Id,
+		AppId:        d.AppId,
+		EnvId:        d.EnvId,
+	}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	middleware.AddFlash(ctx, fmt.Sprintf("restarting. deployment %d created", d.Id))
+	w.Header().Set("HX-Redirect", urls.EnvAppDeployments{TeamId: teamId, AppId: appId, EnvName: envName}.Render())
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *AppDetailsHandler) ServeHTTPReleaseCommandUpdate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	teamId := chi.URLParam(r, "teamId")
+	envName := chi.URLParam(r, "envName")
+	appId := chi.URLParam(r, "appId")
+	user := middleware.GetUser(ctx)
+	team, _ := validateAndFetchTeams(ctx, h.teamStore, w, teamId, user)
+	if team == nil {
+		return
+	}
+	var env *store.Env
+	for _, e := range team.Envs {
+		if e.Name == envName {
+			env = &e
+		}
+	}
+	if env == nil {
+		http.Error(w, "env not found", http.StatusNotFound)
+		return
+	}
+	if !checkEnvUnlocked(w, r, team, user, *env) {
+		return
+	}
+
+	var f templates.ReleaseCommandFormData
+	inputErrs, err := form.Decode(&f, r)
+	if inputErrs.NotNil() || err != nil {
+		if err := templates.ReleaseCommandForm(teamId, envName, appId, f, inputErrs, err).Render(ctx, w); err != nil {
+			http.Error(w, fmt.Sprintf("error rendering template: %v", err), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	latestDeployment, err := h.deploymentStore.GetLatestForAppEnv(ctx, appId, env.Id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	} else if latestDeployment == nil {
+		http.Error(w, "app has not been deployed to this env", http.StatusBadRequest)
+		return
+	}
+	d, err := h.redeployWithSettings(ctx, latestDeployment, overridesLock(r, team, user), func(opts *store.CreateAppSettingsOptions) {
+		opts.ReleaseCommand = strings.TrimSpace(f.ReleaseCommand)
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	middleware.AddFlash(ctx, fmt.Sprintf("release command updated successfully. deployment %d created", d.Id))
+	w.Header().Set("HX-Redirect", urls.EnvAppDeployments{TeamId: teamId, AppId: appId, EnvName: envName}.Render())
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/cmd/app/urls"
+	"github.com/onmetal-dev/metal/lib/background/deployment"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+)
+
+// reviewFromRequest approves or rejects the deployment the request is for, with the comment the reviewer typed into the prompt.
+// It writes an error response and returns nil if the user can't review it
+func (h *AppDetailsHandler) reviewFromRequest(w http.ResponseWriter, r *http.Request, approved bool) *store.Deployment {
+	ctx := r.Context()
+	teamId := chi.URLParam(r, "teamId")
+	envName := chi.URLParam(r, "envName")
+	appId := chi.URLParam(r, "appId")
+	deploymentId, err := strconv.ParseUint(chi.URLParam(r, "deploymentId"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid deployment id", http.StatusBadRequest)
+		return nil
+	}
+	user := middleware.GetUser(ctx)
+	team, _ := validateAndFetchTeams(ctx, h.teamStore, w, teamId, user)
+	if team == nil {
+		return nil
+	}
+	env, ok := lo.Find(team.Envs, func(e store.Env) bool { return e.Name == envName })
+	if !ok {
+		http.Error(w, "env not found", http.StatusNotFound)
+		return nil
+	}
+
+	d, err := h.deploymentStore.Get(appId, env.Id, uint(deploymentId))
+	if err != nil || d.TeamId != team.Id {
+		http.Error(w, "deployment not found", http.StatusNotFound)
+		return nil
+	}
+	if err := d.CheckReviewer(*team, user.Id); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil
+	}
+
+	if err := h.deploymentStore.ReviewDeployment(store.ReviewDeploymentOptions{
+		AppId:        d.AppId,
+		EnvId:        d.EnvId,
+		DeploymentId: d.Id,
+		Approved:     approved,
+		ReviewerId:   user.Id,
+		Reviewer:     user.Email,
+		Comment:      r.Header.Get("HX-Prompt"),
+	}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return nil
+	}
+	return &d
+}
+
+func (h *AppDetailsHandler) ServeHTTPApprove(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	d := h.reviewFromRequest(w, r, true)
+	if d == nil {
+		return
+	}
+	if err := h.producerDeployment.Send(ctx, deployment.Message{
+		DeploymentId: d.Id,
+		AppId:        d.AppId,
+		EnvId:        d.EnvId,
+	}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	middleware.AddFlash(ctx, fmt.Sprintf("approved deployment %d", d.Id))
+	w.Header().Set("HX-Redirect", urls.EnvAppDeployments{TeamId: chi.URLParam(r, "teamId"), AppId: d.AppId, EnvName: chi.URLParam(r, "envName")}.Render())
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *AppDetailsHandler) ServeHTTPReject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	d := h.reviewFromRequest(w, r, false)
+	if d == nil {
+		return
+	}
+
+	middleware.AddFlash(ctx, fmt.Sprintf("rejected deployment %d", d.Id))
+	w.Header().Set("HX-Redirect", urls.EnvAppDeployments{TeamId: chi.URLParam(r, "teamId"), AppId: d.AppId, EnvName: chi.URLParam(r, "envName")}.Render())
+	w.WriteHeader(http.StatusOK)
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -156,6 +157,11 @@ func (h *PostAppsNewHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// apps are first deployed to dev, so don't create any while dev is locked
+	if dev, ok := lo.Find(team.Envs, func(e store.Env) bool { return e.Name == "dev" }); ok && !checkEnvUnlocked(w, r, team, user, dev) {
+		return
+	}
+
 	// 3. Create app, appenv, env, and deployment objects
 	log.Info("creating app")
 	app, err := h.appStore.Create(store.CreateAppOptions{
@@ -264,6 +270,9 @@ func (h *PostAppsNewHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		EnvId:         devEnv.Id,
 		AppId:         app.Id,
 		Type:          store.DeploymentTypeDeploy,
+		Actor:         middleware.Actor(ctx),
+		OverrideLock:  overridesLock(r, team, user),
+		RequestedBy:   middleware.ActorUserId(ctx),
 		AppSettingsId: appSettings.Id,
 		AppEnvVarsId:  appEnvVars.Id,
 		CellIds:       []string{f.CellId},
@@ -302,6 +311,7 @@ type DeleteAppHandler struct {
 	cellStore           store.CellStore
 	appStore            store.AppStore
 	deploymentStore     store.DeploymentStore
+	domainStore         store.DomainStore
 	cellProviderForType func(cellType store.CellType) cellprovider.CellProvider
 }
 
@@ -312,6 +322,7 @@ func NewDeleteAppHandler(
 	cellStore store.CellStore,
 	appStore store.AppStore,
 	deploymentStore store.DeploymentStore,
+	domainStore store.DomainStore,
 	cellProviderForType func(cellType store.CellType) cellprovider.CellProvider,
 ) *DeleteAppHandler {
 	return &DeleteAppHandler{
@@ -321,6 +332,7 @@ func NewDeleteAppHandler(
 		cellStore:           cellStore,
 		appStore:            appStore,
 		deploymentStore:     deploymentStore,
+		domainStore:         domainStore,
 		cellProviderForType: cellProviderForType,
 	}
 }
@@ -333,11 +345,6 @@ func (h *DeleteAppHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if team == nil {
 		return
 	}
-	cells, err := h.cellStore.GetForTeam(ctx, teamId)
-	if err != nil {
-		http.Error(w, "error fetching cells", http.StatusInternalServerError)
-		return
-	}
 
 	appId := chi.URLParam(r, "appId")
 	if appId == "" {
@@ -356,39 +363,15 @@ func (h *DeleteAppHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// get all deployments for the app
-	deployments, err := h.deploymentStore.GetForApp(ctx, app.Id)
-	if err != nil {
-		http.Error(w, fmt.Sprintf("error fetching deployments: %v", err), http.StatusInternalServerError)
-		return
-	}
-
-	for _, cell := range cells {
-		deploymentsForCell := lo.Filter(deployments, func(deployment store.Deployment, _ int) bool {
-			inThisCell := false
-			for _, c := range deployment.Cells {
-				if c.Id == cell.Id {
-					inThisCell = true
-					break
-				}
-			}
-			return inThisCell
-		})
-		if err := h.cellProviderForType(cell.Type).DestroyDeployments(ctx, cell.Id, deploymentsForCell); err != nil {
-			http.Error(w, fmt.Sprintf("error destroying deployments: %v", err), http.StatusInternalServerError)
+	// apps with volumes are only deleted once the user typed the app's name, since their data goes too
+	deleteVolumes := r.Header.Get("HX-Prompt") == app.Name
+	if err := deployment.DestroyApp(ctx, h.deploymentStore, h.domainStore, h.cellStore, h.cellProviderForType, app, deleteVolumes); err != nil {
+		if errors.Is(err, deployment.ErrAppHasVolumes) {
+			http.Error(w, fmt.Sprintf("app %s has volumes. type its name to confirm deleting it along with their data", app.Name), http.StatusBadRequest)
 			return
 		}
-		// update deployment status for all deployments, then delete them so they don't appear in the dashboard
-		for _, d := range deploymentsForCell {
-			if err := h.deploymentStore.UpdateDeploymentStatus(app.Id, d.EnvId, d.Id, store.DeploymentStatusStopped, "app deleted"); err != nil {
-				http.Error(w, fmt.Sprintf("error updating deployment status: %v", err), http.StatusInternalServerError)
-				return
-			}
-			if err := h.deploymentStore.DeleteDeployment(app.Id, d.EnvId, d.Id); err != nil {
-				http.Error(w, fmt.Sprintf("error deleting deployment: %v", err), http.StatusInternalServerError)
-				return
-			}
-		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
 	}
 
 	if err := h.appStore.Delete(ctx, app.Id); err != nil {
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/cmd/app/urls"
+	"github.com/onmetal-dev/metal/lib/background/deployment"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+)
+
+// canaryFromRequest fetches the canary deployment a promote or abort request is for, writing an error response and returning nil if it isn't a canary waiting on one
+func (h *AppDetailsHandler) canaryFromRequest(w http.ResponseWriter, r *http.Request) *store.Deployment {
+	ctx := r.Context()
+	teamId := chi.URLParam(r, "teamId")
+	envName := chi.URLParam(r, "envName")
+	appId := chi.URLParam(r, "appId")
+	deploymentId, err := strconv.ParseUint(chi.URLParam(r, "deploymentId"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid deployment id", http.StatusBadRequest)
+		return nil
+	}
+	user := middleware.GetUser(ctx)
+	team, _ := validateAndFetchTeams(ctx, h.teamStore, w, teamId, user)
+	if team == nil {
+		return nil
+	}
+	env, ok := lo.Find(team.Envs, func(e store.Env) bool { return e.Name == envName })
+	if !ok {
+		http.Error(w, "env not found", http.StatusNotFound)
+		return nil
+	}
+
+	d, err := h.deploymentStore.Get(appId, env.Id, uint(deploymentId))
+	if err != nil || d.TeamId != team.Id {
+		http.Error(w, "deployment not found", http.StatusNotFound)
+		return nil
+	}
+	if d.Status != store.DeploymentStatusCanary {
+		http.Error(w, fmt.Sprintf("deployment %d is not a canary waiting to be promoted or aborted", d.Id), http.StatusBadRequest)
+		return nil
+	}
+	return &d
+}
+
+func (h *AppDetailsHandler) ServeHTTPPromote(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	d := h.canaryFromRequest(w, r)
+	if d == nil {
+		return
+	}
+
+	weight := d.NextCanaryWeight()
+	statusReason := fmt.Sprintf("shifting %d%% of traffic to canary", weight)
+	if weight == 100 {
+		statusReason = "rolling out canary fully"
+	}
+	if err := h.deploymentStore.UpdateCanaryWeight(d.AppId, d.EnvId, d.Id, weight); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := h.deploymentStore.RecordDeploymentStatus(store.RecordDeploymentStatusOptions{
+		AppId:        d.AppId,
+		EnvId:        d.EnvId,
+		DeploymentId: d.Id,
+		Status:       store.DeploymentStatusPromoting,
+		Reason:       statusReason,
+		Actor:        middleware.Actor(ctx),
+	}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := h.producerDeployment.Send(ctx, deployment.Message{
+		DeploymentId: d.Id,
+		AppId:        d.AppId,
+		EnvId:        d.EnvId,
+	}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	middleware.AddFlash(ctx, fmt.Sprintf("deployment %d: %s", d.Id, statusReason))
+	w.Header().Set("HX-Redirect", urls.EnvAppDeployments{TeamId: chi.URLParam(r, "teamId"), AppId: d.AppId, EnvName: chi.URLParam(r, "envName")}.Render())
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *AppDetailsHandler) ServeHTTPAbort(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	d := h.canaryFromRequest(w, r)
+	if d == nil {
+		return
+	}
+
+	if err := h.deploymentStore.RecordDeploymentStatus(store.RecordDeploymentStatusOptions{
+		AppId:        d.AppId,
+		EnvId:        d.EnvId,
+		DeploymentId: d.Id,
+		Status:       store.DeploymentStatusAborting,
+		Reason:       "sending all traffic back to the previous deployment",
+		Actor:        middleware.Actor(ctx),
+	}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := h.producerDeployment.Send(ctx, deployment.Message{
+		DeploymentId: d.Id,
+		AppId:        d.AppId,
+		EnvId:        d.EnvId,
+	}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	middleware.AddFlash(ctx, fmt.Sprintf("aborting canary deployment %d", d.Id))
+	w.Header().Set("HX-Redirect", urls.EnvAppDeployments{TeamId: chi.URLParam(r, "teamId"), AppId: d.AppId, EnvName: chi.URLParam(r, "envName")}.Render())
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/cmd/app/urls"
+	"github.com/onmetal-dev/metal/lib/background/deployment"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+)
+
+func (h *AppDetailsHandler) ServeHTTPCancel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	teamId := chi.URLParam(r, "teamId")
+	envName := chi.URLParam(r, "envName")
+	appId := chi.URLParam(r, "appId")
+	deploymentId, err := strconv.ParseUint(chi.URLParam(r, "deploymentId"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid deployment id", http.StatusBadRequest)
+		return
+	}
+	user := middleware.GetUser(ctx)
+	team, _ := validateAndFetchTeams(ctx, h.teamStore, w, teamId, user)
+	if team == nil {
+		return
+	}
+	env, ok := lo.Find(team.Envs, func(e store.Env) bool { return e.Name == envName })
+	if !ok {
+		http.Error(w, "env not found", http.StatusNotFound)
+		return
+	}
+
+	d, err := h.deploymentStore.Get(appId, env.Id, uint(deploymentId))
+	if err != nil || d.TeamId != team.Id {
+		http.Error(w, "deployment not found", http.StatusNotFound)
+		return
+	}
+	if !d.CanCancel() {
+		http.Error(w, fmt.Sprintf("deployment %d is %s, only pending, releasing and deploying deployments can be canceled", d.Id, d.Status), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.deploymentStore.RecordDeploymentStatus(store.RecordDeploymentStatusOptions{
+		AppId:        d.AppId,
+		EnvId:        d.EnvId,
+		DeploymentId: d.Id,
+		Status:       store.DeploymentStatusCanceling,
+		Reason:       "rolling back to the previous deployment",
+		Actor:        middleware.Actor(ctx),
+	}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := h.producerDeployment.Send(ctx, deployment.Message{
+		DeploymentId: d.Id,
+		AppId:        d.AppId,
+		EnvId:        d.EnvId,
+	}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	middleware.AddFlash(ctx, fmt.Sprintf("canceling deployment %d", d.Id))
+	w.Header().Set("HX-Redirect", urls.EnvAppDeployments{TeamId: teamId, AppId: d.AppId, EnvName: envName}.Render())
+	w.WriteHeader(http.StatusOK)
+}
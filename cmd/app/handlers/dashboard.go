@@ -15,6 +15,7 @@ import (
 	"github.com/onmetal-dev/metal/lib/cellprovider"
 	"github.com/onmetal-dev/metal/lib/logger"
 	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -220,8 +221,11 @@ func (h *DashboardHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	if activeEnv.Id == "" {
 		if envName == urls.DefaultEnvSentinel {
-			// redirect to the first env
+			// redirect to the first env, skipping preview envs since they come and go
 			envName = envs[0].Name
+			if env, ok := lo.Find(envs, func(e store.Env) bool { return !e.IsPreview() }); ok {
+				envName = env.Name
+			}
 			http.Redirect(w, r, urls.Home{TeamId: teamId, EnvName: envName}.Render(), http.StatusTemporaryRedirect)
 			return
 		}
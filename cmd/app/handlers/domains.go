@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/cmd/app/templates"
+	"github.com/onmetal-dev/metal/cmd/app/urls"
+	"github.com/onmetal-dev/metal/lib/background/deployment"
+	"github.com/onmetal-dev/metal/lib/form"
+	"github.com/onmetal-dev/metal/lib/logger"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+)
+
+func (h *AppDetailsHandler) ServeHTTPDomains(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	teamId := chi.URLParam(r, "teamId")
+	envName := chi.URLParam(r, "envName")
+	appId := chi.URLParam(r, "appId")
+	team, teams, env, app := h.teamEnvApp(ctx, w, teamId, envName, appId)
+	if team == nil {
+		return
+	}
+
+	domains, err := h.domainStore.GetForAppEnv(ctx, app.Id, env.Id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	cnameTargets, err := deployment.DomainCNAMETargets(ctx, h.cellStore, h.cellProviderForType, team.Id, *app, *env)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	certificates, err := deployment.DomainCertificates(ctx, h.cellStore, h.cellProviderForType, team.Id, domains)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	domainsWithCertificates := lo.Map(domains, func(d store.Domain, i int) templates.DomainWithCertificate {
+		return templates.DomainWithCertificate{Domain: d, Certificate: certificates[i]}
+	})
+
+	if err := templates.DashboardLayout(templates.DashboardState{
+		User:       *middleware.GetUser(ctx),
+		Teams:      teams,
+		ActiveTeam: *team,
+		Envs:       team.Envs,
+		ActiveEnv:  env,
+	}, templates.AppDetailsLayout(*team, *env, *app, templates.AppMenuItemDomains,
+		templates.AppDetailsDomains(teamId, env.Name, app.Id, domainsWithCertificates, cnameTargets))).Render(ctx, w); err != nil {
+		http.Error(w, fmt.Sprintf("error rendering template: %v", err), http.StatusInternalServerError)
+	}
+}
+
+func (h *AppDetailsHandler) ServeHTTPDomainCreate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	teamId := chi.URLParam(r, "teamId")
+	envName := chi.URLParam(r, "envName")
+	appId := chi.URLParam(r, "appId")
+	team, _, env, app := h.teamEnvApp(ctx, w, teamId, envName, appId)
+	if team == nil {
+		return
+	}
+
+	var f templates.CreateDomainFormData
+	inputErrs, err := form.Decode(&f, r)
+	if inputErrs.NotNil() || err != nil {
+		if err := templates.CreateDomainForm(teamId, envName, appId, f, inputErrs, err).Render(ctx, w); err != nil {
+			http.Error(w, fmt.Sprintf("error rendering template: %v", err), http.StatusInternalServerError)
+		}
+		return
+	}
+	hostname := strings.ToLower(strings.TrimSuffix(strings.TrimSpace(f.Hostname), "."))
+	if hostname == "onmetal.run" || strings.HasSuffix(hostname, ".onmetal.run") {
+		inputErrs.Set("Hostname", errors.New("onmetal.run hostnames can't be added as custom domains"))
+	} else if _, err := h.domainStore.GetByHostname(ctx, hostname); err == nil {
+		inputErrs.Set("Hostname", fmt.Errorf("domain %s is already in use", hostname))
+	}
+	if inputErrs.NotNil() {
+		if err := templates.CreateDomainForm(teamId, envName, appId, f, inputErrs, nil).Render(ctx, w); err != nil {
+			http.Error(w, fmt.Sprintf("error rendering template: %v", err), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	domain, err := h.domainStore.Create(ctx, store.CreateDomainOptions{
+		TeamId:   teamId,
+		AppId:    app.Id,
+		EnvId:    env.Id,
+		Hostname: hostname,
+	})
+	if err != nil {
+		if err := templates.CreateDomainForm(teamId, envName, appId, f, inputErrs, err).Render(ctx, w); err != nil {
+			http.Error(w, fmt.Sprintf("error rendering template: %v", err), http.StatusInternalServerError)
+		}
+		return
+	}
+	middleware.AddFlash(ctx, fmt.Sprintf("domain %s added. add one of the DNS records below to verify it", domain.Hostname))
+	w.Header().Set("HX-Redirect", urls.EnvAppDomains{TeamId: teamId, AppId: appId, EnvName: envName}.Render())
+	w.WriteHeader(http.StatusOK)
+}
+
+// domainFromRequest fetches the domain a verify or delete request is for, writing an error response and returning nil if it isn't one of the app's in the env
+func (h *AppDetailsHandler) domainFromRequest(w http.ResponseWriter, r *http.Request) *store.Domain {
+	ctx := r.Context()
+	team, _, env, app := h.teamEnvApp(ctx, w, chi.URLParam(r, "teamId"), chi.URLParam(r, "envName"), chi.URLParam(r, "appId"))
+	if team == nil {
+		return nil
+	}
+	domain, err := h.domainStore.GetByHostname(ctx, chi.URLParam(r, "hostname"))
+	if err != nil || domain.AppId != app.Id || domain.EnvId != env.Id {
+		http.Error(w, "domain not found", http.StatusNotFound)
+		return nil
+	}
+	return &domain
+}
+
+func (h *AppDetailsHandler) ServeHTTPDomainVerify(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	d := h.domainFromRequest(w, r)
+	if d == nil {
+		return
+	}
+
+	domain, err := deployment.VerifyDomain(ctx, h.domainStore, h.deploymentStore, h.cellStore, h.cellProviderForType, h.domainResolver, *d)
+	if err != nil && !domain.Verified() {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	} else if err != nil {
+		logger.FromContext(ctx).Error("error syncing domains", "error", err)
+		middleware.AddFlash(ctx, fmt.Sprintf("domain %s verified, but syncing it to the cell failed: %v", domain.Hostname, err))
+	} else if domain.Verified() {
+		middleware.AddFlash(ctx, fmt.Sprintf("domain %s verified. a certificate is being issued for it", domain.Hostname))
+	} else {
+		middleware.AddFlash(ctx, fmt.Sprintf("domain %s could not be verified yet. DNS changes can take a while to show up", domain.Hostname))
+	}
+	w.Header().Set("HX-Redirect", urls.EnvAppDomains{TeamId: chi.URLParam(r, "teamId"), AppId: domain.AppId, EnvName: chi.URLParam(r, "envName")}.Render())
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *AppDetailsHandler) ServeHTTPDomainDelete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	domain := h.domainFromRequest(w, r)
+	if domain == nil {
+		return
+	}
+
+	if err := h.domainStore.Delete(ctx, domain.Id); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !domain.Verified() {
+		middleware.AddFlash(ctx, fmt.Sprintf("domain %s deleted", domain.Hostname))
+	} else if err := deployment.SyncDomains(ctx, h.deploymentStore, h.cellStore, h.cellProviderForType, domain.TeamId, domain.AppId, domain.EnvId); err != nil {
+		logger.FromContext(ctx).Error("error syncing domains", "error", err)
+		middleware.AddFlash(ctx, fmt.Sprintf("domain %s deleted, but removing it from the cell failed: %v", domain.Hostname, err))
+	} else {
+		middleware.AddFlash(ctx, fmt.Sprintf("domain %s deleted", domain.Hostname))
+	}
+	w.Header().Set("HX-Redirect", urls.EnvAppDomains{TeamId: chi.URLParam(r, "teamId"), AppId: domain.AppId, EnvName: chi.URLParam(r, "envName")}.Render())
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,216 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/onmetal-dev/metal/cmd/app/middleware"
+	"github.com/onmetal-dev/metal/cmd/app/templates"
+	"github.com/onmetal-dev/metal/cmd/app/urls"
+	"github.com/onmetal-dev/metal/lib/background/deployment"
+	"github.com/onmetal-dev/metal/lib/cellprovider"
+	"github.com/onmetal-dev/metal/lib/form"
+	"github.com/onmetal-dev/metal/lib/logger"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+)
+
+// teamEnvApp fetches the team, env and app a jobs request is for, writing an error response and returning nil if any of them can't be found
+func (h *AppDetailsHandler) teamEnvApp(ctx context.Context, w http.ResponseWriter, teamId, envName, appId string) (*store.Team, []store.Team, *store.Env, *store.App) {
+	user := middleware.GetUser(ctx)
+	team, teams := validateAndFetchTeams(ctx, h.teamStore, w, teamId, user)
+	if team == nil {
+		return nil, nil, nil, nil
+	}
+	env, ok := lo.Find(team.Envs, func(e store.Env) bool { return e.Name == envName })
+	if !ok {
+		http.Error(w, "env not found", http.StatusNotFound)
+		return nil, nil, nil, nil
+	}
+	app, err := h.appStore.Get(ctx, appId)
+	if err != nil || app.TeamId != team.Id {
+		http.Error(w, "app not found", http.StatusNotFound)
+		return nil, nil, nil, nil
+	}
+	return team, teams, &env, &app
+}
+
+// cronJobCellProvider returns the cell the app's cron jobs run on along with its provider, or nil if the app isn't running in the env
+func (h *AppDetailsHandler) cronJobCellProvider(ctx context.Context, appId, envId string) (*store.Cell, cellprovider.CellProvider, error) {
+	cell, err := deployment.CronJobCell(ctx, h.deploymentStore, h.cellStore, appId, envId)
+	if err != nil || cell == nil {
+		return nil, nil, err
+	}
+	cellProvider := h.cellProviderForType(cell.Type)
+	if cellProvider == nil {
+		return nil, nil, fmt.Errorf("no cell provider found for cell type: %s", cell.Type)
+	}
+	return cell, cellProvider, nil
+}
+
+func (h *AppDetailsHandler) ServeHTTPJobs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	teamId := chi.URLParam(r, "teamId")
+	envName := chi.URLParam(r, "envName")
+	appId := chi.URLParam(r, "appId")
+	team, teams, env, app := h.teamEnvApp(ctx, w, teamId, envName, appId)
+	if team == nil {
+		return
+	}
+
+	cronJobs, err := h.cronJobStore.GetForAppEnv(ctx, app.Id, env.Id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	cell, cellProvider, cellErr := h.cronJobCellProvider(ctx, app.Id, env.Id)
+	jobs := lo.Map(cronJobs, func(c store.CronJob, _ int) templates.JobWithRuns {
+		job := templates.JobWithRuns{CronJob: c, RunsError: cellErr}
+		if cellErr == nil && cell != nil {
+			job.Runs, job.RunsError = cellProvider.CronJobRuns(ctx, cell.Id, c)
+		}
+		return job
+	})
+
+	if err := templates.DashboardLayout(templates.DashboardState{
+		User:       *middleware.GetUser(ctx),
+		Teams:      teams,
+		ActiveTeam: *team,
+		Envs:       team.Envs,
+		ActiveEnv:  env,
+	}, templates.AppDetailsLayout(*team, *env, *app, templates.AppMenuItemJobs,
+		templates.AppDetailsJobs(teamId, env.Name, app.Id, jobs))).Render(ctx, w); err != nil {
+		http.Error(w, fmt.Sprintf("error rendering template: %v", err), http.StatusInternalServerError)
+	}
+}
+
+func (h *AppDetailsHandler) ServeHTTPJobCreate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	teamId := chi.URLParam(r, "teamId")
+	envName := chi.URLParam(r, "envName")
+	appId := chi.URLParam(r, "appId")
+	team, _, env, app := h.teamEnvApp(ctx, w, teamId, envName, appId)
+	if team == nil {
+		return
+	}
+
+	var f templates.CreateJobFormData
+	inputErrs, err := form.Decode(&f, r)
+	if inputErrs.NotNil() || err != nil {
+		if err := templates.CreateJobForm(teamId, envName, appId, f, inputErrs, err).Render(ctx, w); err != nil {
+			http.Error(w, fmt.Sprintf("error rendering template: %v", err), http.StatusInternalServerError)
+		}
+		return
+	}
+	if _, err := h.cronJobStore.GetByName(ctx, app.Id, env.Id, f.Name); err == nil {
+		inputErrs.Set("Name", fmt.Errorf("a job named %s already exists", f.Name))
+		if err := templates.CreateJobForm(teamId, envName, appId, f, inputErrs, nil).Render(ctx, w); err != nil {
+			http.Error(w, fmt.Sprintf("error rendering template: %v", err), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	cronJob, err := h.cronJobStore.Create(ctx, store.CreateCronJobOptions{
+		TeamId:            teamId,
+		AppId:             app.Id,
+		EnvId:             env.Id,
+		Name:              f.Name,
+		Schedule:          strings.TrimSpace(f.Schedule),
+		Command:           strings.TrimSpace(f.Command),
+		Timezone:          f.Timezone,
+		ConcurrencyPolicy: store.CronJobConcurrencyPolicy(f.ConcurrencyPolicy),
+	})
+	if err != nil {
+		if err := templates.CreateJobForm(teamId, envName, appId, f, inputErrs, err).Render(ctx, w); err != nil {
+			http.Error(w, fmt.Sprintf("error rendering template: %v", err), http.StatusInternalServerError)
+		}
+		return
+	}
+	synced, err := deployment.SyncCronJobs(ctx, h.deploymentStore, h.cronJobStore, h.cellStore, h.cellProviderForType, app.Id, env.Id)
+	if err != nil {
+		logger.FromContext(ctx).Error("error syncing cron jobs", "error", err)
+		middleware.AddFlash(ctx, fmt.Sprintf("job %s created, but syncing it to the cell failed: %v", cronJob.Name, err))
+	} else if !synced {
+		middleware.AddFlash(ctx, fmt.Sprintf("job %s created. it will start running once the app is deployed", cronJob.Name))
+	} else {
+		middleware.AddFlash(ctx, fmt.Sprintf("job %s created", cronJob.Name))
+	}
+	w.Header().Set("HX-Redirect", urls.EnvAppJobs{TeamId: teamId, AppId: appId, EnvName: envName}.Render())
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *AppDetailsHandler) ServeHTTPJobDelete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	teamId := chi.URLParam(r, "teamId")
+	envName := chi.URLParam(r, "envName")
+	appId := chi.URLParam(r, "appId")
+	jobName := chi.URLParam(r, "jobName")
+	team, _, env, app := h.teamEnvApp(ctx, w, teamId, envName, appId)
+	if team == nil {
+		return
+	}
+
+	cronJob, err := h.cronJobStore.GetByName(ctx, app.Id, env.Id, jobName)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err := h.cronJobStore.Delete(ctx, cronJob.Id); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if _, err := deployment.SyncCronJobs(ctx, h.deploymentStore, h.cronJobStore, h.cellStore, h.cellProviderForType, app.Id, env.Id); err != nil {
+		logger.FromContext(ctx).Error("error syncing cron jobs", "error", err)
+		middleware.AddFlash(ctx, fmt.Sprintf("job %s deleted, but removing it from the cell failed: %v", cronJob.Name, err))
+	} else {
+		middleware.AddFlash(ctx, fmt.Sprintf("job %s deleted", cronJob.Name))
+	}
+	w.Header().Set("HX-Redirect", urls.EnvAppJobs{TeamId: teamId, AppId: appId, EnvName: envName}.Render())
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *AppDetailsHandler) ServeHTTPJobRunLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	teamId := chi.URLParam(r, "teamId")
+	envName := chi.URLParam(r, "envName")
+	appId := chi.URLParam(r, "appId")
+	jobName := chi.URLParam(r, "jobName")
+	runName := chi.URLParam(r, "runName")
+	team, teams, env, app := h.teamEnvApp(ctx, w, teamId, envName, appId)
+	if team == nil {
+		return
+	}
+
+	cronJob, err := h.cronJobStore.GetByName(ctx, app.Id, env.Id, jobName)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	cell, cellProvider, err := h.cronJobCellProvider(ctx, app.Id, env.Id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	} else if cell == nil {
+		http.Error(w, "app is not running in this env", http.StatusNotFound)
+		return
+	}
+	logs, err := cellProvider.CronJobRunLogs(ctx, cell.Id, cronJob, runName)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := templates.DashboardLayout(templates.DashboardState{
+		User:       *middleware.GetUser(ctx),
+		Teams:      teams,
+		ActiveTeam: *team,
+		Envs:       team.Envs,
+		ActiveEnv:  env,
+	}, templates.AppDetailsLayout(*team, *env, *app, templates.AppMenuItemJobs,
+		templates.AppDetailsJobRunLogs(teamId, env.Name, app.Id, jobName, runName, logs))).Render(ctx, w); err != nil {
+		http.Error(w, fmt.Sprintf("error rendering template: %v", err), http.StatusInternalServerError)
+	}
+}
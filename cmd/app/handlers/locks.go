@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/onmetal-dev/metal/lib/store"
+)
+
+// overridesLock reports whether the request may deploy to the team's envs even while they are locked: team admins may, by ticking
+// the override lock checkbox that app pages show while their env is locked
+func overridesLock(r *http.Request, team *store.Team, user *store.User) bool {
+	override, _ := strconv.ParseBool(r.FormValue("override_lock"))
+	return override && team.IsAdmin(user.Id)
+}
+
+// checkEnvUnlocked responds with who locked the env and why, or which freeze window it is in, and returns false, unless the request may deploy to it
+func checkEnvUnlocked(w http.ResponseWriter, r *http.Request, team *store.Team, user *store.User, env store.Env) bool {
+	if err := env.CheckUnlocked(time.Now()); err != nil && !overridesLock(r, team, user) {
+		http.Error(w, fmt.Sprintf("%s. A team admin can override the lock", err), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
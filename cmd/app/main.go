@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -26,6 +27,7 @@ import (
 	"github.com/onmetal-dev/metal/lib/background"
 	"github.com/onmetal-dev/metal/lib/background/celljanitor"
 	"github.com/onmetal-dev/metal/lib/background/deployment"
+	"github.com/onmetal-dev/metal/lib/background/previewenv"
 	"github.com/onmetal-dev/metal/lib/background/serverbillinghourly"
 	"github.com/onmetal-dev/metal/lib/background/serverfulfillment"
 	"github.com/onmetal-dev/metal/lib/cellprovider"
@@ -188,6 +190,8 @@ func main() {
 	})
 
 	apiTokenStore := dbstore.NewApiTokenStore(db)
+	cronJobStore := dbstore.NewCronJobStore(db)
+	domainStore := dbstore.NewDomainStore(db)
 
 	// api clients
 	hrobotClient := hrobot.NewClient(hrobot.WithToken(fmt.Sprintf("%s:%s", c.HetznerRobotUsername, c.HetznerRobotPassword)))
@@ -222,6 +226,7 @@ func main() {
 			cellprovider.WithDnsProvider(cfDnsProvider),
 			cellprovider.WithCellStore(cellStore),
 			cellprovider.WithServerStore(serverStore),
+			cellprovider.WithDomainStore(domainStore),
 			cellprovider.WithTmpDirRoot(c.TmpDirRoot),
 			cellprovider.WithTracerProvider(tracerProvider),
 		)
@@ -291,6 +296,7 @@ func main() {
 			deployment.WithDeploymentStore(deploymentStore),
 			deployment.WithCellProviderForType(cellProviderForType),
 			deployment.WithCellStore(cellStore),
+			deployment.WithCronJobStore(cronJobStore),
 		)
 	})
 	{
@@ -299,6 +305,23 @@ func main() {
 		defer consumer.Stop()
 	}
 
+	queueNamePreviewEnv := "previewenv"
+	producerPreviewEnv := background.NewQueueProducer[previewenv.Message](ctx, queueNamePreviewEnv, connString)
+	previewEnvHandler := mustCreate(slogger, func() (*previewenv.MessageHandler, error) {
+		return previewenv.NewMessageHandler(
+			previewenv.WithQueueProducer(producerPreviewEnv),
+			previewenv.WithDeploymentStore(deploymentStore),
+			previewenv.WithDomainStore(domainStore),
+			previewenv.WithCellStore(cellStore),
+			previewenv.WithCellProviderForType(cellProviderForType),
+		)
+	})
+	{
+		consumer := background.NewQueueConsumer[previewenv.Message](ctx, queueNamePreviewEnv, connString, 60*10 /* tearing down every app of the env takes a while */, previewEnvHandler.Handle)
+		go consumer.Start(ctx)
+		defer consumer.Stop()
+	}
+
 	queueNameCellJanitor := "celljanitor"
 	producerCellJanitor := background.NewQueueProducer[celljanitor.Message](ctx, queueNameCellJanitor, connString)
 	cellJanitorHandler := mustCreate(slogger, func() (*celljanitor.MessageHandler, error) {
@@ -391,13 +414,31 @@ func main() {
 			r.Get(urls.ServerCheckoutReturnUrl{}.Pattern(), handlers.NewGetServersCheckoutReturnHandler(teamStore, serverOfferingStore, stripeCheckoutSession, producerFulfillment).ServeHTTP)
 			r.Get(urls.NewApp{}.Pattern(), handlers.NewAppsNewHandler(userStore, teamStore, serverStore, cellStore).ServeHTTP)
 			r.Post(urls.NewApp{}.Pattern(), handlers.NewPostAppsNewHandler(userStore, teamStore, serverStore, cellStore, appStore, deploymentStore, producerDeployment).ServeHTTP)
-			r.Delete(urls.App{}.Pattern(), handlers.NewDeleteAppHandler(userStore, teamStore, serverStore, cellStore, appStore, deploymentStore, cellProviderForType).ServeHTTP)
-			appDetailsHandler := handlers.NewAppDetailsHandler(userStore, teamStore, serverStore, cellStore, deploymentStore, appStore, producerDeployment)
+			r.Delete(urls.App{}.Pattern(), handlers.NewDeleteAppHandler(userStore, teamStore, serverStore, cellStore, appStore, deploymentStore, domainStore, cellProviderForType).ServeHTTP)
+			appDetailsHandler := handlers.NewAppDetailsHandler(userStore, teamStore, serverStore, cellStore, deploymentStore, appStore, cronJobStore, domainStore, net.DefaultResolver, cellProviderForType, producerDeployment)
 			r.Get(urls.EnvApp{}.Pattern(), appDetailsHandler.ServeHTTP)
 			r.Get(urls.EnvAppDeployments{}.Pattern(), appDetailsHandler.ServeHTTPDeployments)
+			r.Post(urls.EnvAppDeploymentRollback{}.Pattern(), appDetailsHandler.ServeHTTPRollback)
+			r.Post(urls.EnvAppDeploymentPromote{}.Pattern(), appDetailsHandler.ServeHTTPPromote)
+			r.Post(urls.EnvAppDeploymentAbort{}.Pattern(), appDetailsHandler.ServeHTTPAbort)
+			r.Post(urls.EnvAppDeploymentCancel{}.Pattern(), appDetailsHandler.ServeHTTPCancel)
+			r.Post(urls.EnvAppDeploymentApprove{}.Pattern(), appDetailsHandler.ServeHTTPApprove)
+			r.Post(urls.EnvAppDeploymentReject{}.Pattern(), appDetailsHandler.ServeHTTPReject)
+			r.Post(urls.EnvAppScale{}.Pattern(), appDetailsHandler.ServeHTTPScale)
+			r.Post(urls.EnvAppRestart{}.Pattern(), appDetailsHandler.ServeHTTPRestart)
 			r.Get(urls.EnvAppVariables{}.Pattern(), appDetailsHandler.ServeHTTPVariables)
 			r.Post(urls.EnvAppVariablesUpdate{}.Pattern(), appDetailsHandler.ServeHTTPVariablesUpdate)
+			r.Get(urls.EnvAppJobs{}.Pattern(), appDetailsHandler.ServeHTTPJobs)
+			r.Post(urls.EnvAppJobCreate{}.Pattern(), appDetailsHandler.ServeHTTPJobCreate)
+			r.Post(urls.EnvAppJobDelete{}.Pattern(), appDetailsHandler.ServeHTTPJobDelete)
+			r.Get(urls.EnvAppJobRunLogs{}.Pattern(), appDetailsHandler.ServeHTTPJobRunLogs)
+			r.Get(urls.EnvAppDomains{}.Pattern(), appDetailsHandler.ServeHTTPDomains)
+			r.Post(urls.EnvAppDomainCreate{}.Pattern(), appDetailsHandler.ServeHTTPDomainCreate)
+			r.Post(urls.EnvAppDomainVerify{}.Pattern(), appDetailsHandler.ServeHTTPDomainVerify)
+			r.Post(urls.EnvAppDomainDelete{}.Pattern(), appDetailsHandler.ServeHTTPDomainDelete)
 			r.Get(urls.EnvAppSettings{}.Pattern(), appDetailsHandler.ServeHTTPSettings)
+			r.Post(urls.EnvAppHealthCheckUpdate{}.Pattern(), appDetailsHandler.ServeHTTPHealthCheckUpdate)
+			r.Post(urls.EnvAppReleaseCommandUpdate{}.Pattern(), appDetailsHandler.ServeHTTPReleaseCommandUpdate)
 			logsHandler := handlers.NewGetDeploymentLogsHandler(teamStore, deploymentStore, cellProviderForType)
 			r.Get(urls.DeploymentLogs{}.Pattern(), logsHandler.ServeHTTP)
 			r.Post(urls.DeploymentLogs{}.Pattern(), logsHandler.ServeHTTP)
@@ -418,9 +459,13 @@ func main() {
 					deploymentStore,
 					teamStore,
 					buildStore,
+					cronJobStore,
+					domainStore,
+					net.DefaultResolver,
 					cellStore,
 					cellProviderForType,
 					producerDeployment,
+					producerPreviewEnv,
 				),
 				[]oapi.StrictMiddlewareFunc{},
 			),
@@ -428,6 +473,7 @@ func main() {
 				BaseRouter: r,
 				Middlewares: []oapi.MiddlewareFunc{
 					m.ApiAuthMiddleware(apiTokenStore),
+					m.ApiOverrideLockMiddleware,
 				},
 			},
 		)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/gorilla/sessions"
@@ -246,3 +247,50 @@ func MustGetApiToken(ctx context.Context) store.ApiToken {
 func WithApiToken(ctx context.Context, token store.ApiToken) context.Context {
 	return context.WithValue(ctx, apiTokenContextKey, token)
 }
+
+// OverrideLockHeader asks the API to deploy to an env even if it is locked. Only admin tokens can override locks
+const OverrideLockHeader = "Metal-Override-Lock"
+
+type overrideLockKey string
+
+const overrideLockContextKey overrideLockKey = "override_lock"
+
+// ApiOverrideLockMiddleware notes whether the request asks to override env locks with the OverrideLockHeader
+func ApiOverrideLockMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		override, _ := strconv.ParseBool(r.Header.Get(OverrideLockHeader))
+		next.ServeHTTP(w, r.WithContext(WithOverrideLock(r.Context(), override)))
+	})
+}
+
+// OverrideLock reports whether the request asks to deploy to envs even if they are locked
+func OverrideLock(ctx context.Context) bool {
+	override, _ := ctx.Value(overrideLockContextKey).(bool)
+	return override
+}
+
+func WithOverrideLock(ctx context.Context, override bool) context.Context {
+	return context.WithValue(ctx, overrideLockContextKey, override)
+}
+
+// Actor names who is making the request, for the events it records: the email of the logged in user, or the API token
+func Actor(ctx context.Context) string {
+	if user := GetUser(ctx); user != nil {
+		return user.Email
+	}
+	if token, ok := ctx.Value(apiTokenContextKey).(store.ApiToken); ok {
+		return fmt.Sprintf("api token %s", token.Name)
+	}
+	return store.SystemActor
+}
+
+// ActorUserId returns the id of the user making the request: the logged in user, or the one who created the API token. Empty for metal itself
+func ActorUserId(ctx context.Context) string {
+	if user := GetUser(ctx); user != nil {
+		return user.Id
+	}
+	if token, ok := ctx.Value(apiTokenContextKey).(store.ApiToken); ok {
+		return token.CreatorId
+	}
+	return ""
+}
@@ -391,3 +391,356 @@ func (u EnvAppVariablesUpdate) Render() string {
 	}
 	return fmt.Sprintf("/dashboard/%s/envs/%s/apps/%s/variables/update", u.TeamId, u.EnvName, u.AppId)
 }
+
+type EnvAppDeploymentRollback struct {
+	TeamId       string
+	AppId        string
+	EnvName      string
+	DeploymentId uint
+}
+
+var _ Url = EnvAppDeploymentRollback{}
+
+func (u EnvAppDeploymentRollback) Pattern() string {
+	return "/dashboard/{teamId}/envs/{envName}/apps/{appId}/deployments/{deploymentId}/rollback"
+}
+
+func (u EnvAppDeploymentRollback) Render() string {
+	if u.TeamId == "" || u.AppId == "" || u.EnvName == "" || u.DeploymentId == 0 {
+		panic("teamId, appId, envName, and deploymentId are required")
+	}
+	return fmt.Sprintf("/dashboard/%s/envs/%s/apps/%s/deployments/%d/rollback", u.TeamId, u.EnvName, u.AppId, u.DeploymentId)
+}
+
+type EnvAppDeploymentPromote struct {
+	TeamId       string
+	AppId        string
+	EnvName      string
+	DeploymentId uint
+}
+
+var _ Url = EnvAppDeploymentPromote{}
+
+func (u EnvAppDeploymentPromote) Pattern() string {
+	return "/dashboard/{teamId}/envs/{envName}/apps/{appId}/deployments/{deploymentId}/promote"
+}
+
+func (u EnvAppDeploymentPromote) Render() string {
+	if u.TeamId == "" || u.AppId == "" || u.EnvName == "" || u.DeploymentId == 0 {
+		panic("teamId, appId, envName, and deploymentId are required")
+	}
+	return fmt.Sprintf("/dashboard/%s/envs/%s/apps/%s/deployments/%d/promote", u.TeamId, u.EnvName, u.AppId, u.DeploymentId)
+}
+
+type EnvAppDeploymentAbort struct {
+	TeamId       string
+	AppId        string
+	EnvName      string
+	DeploymentId uint
+}
+
+var _ Url = EnvAppDeploymentAbort{}
+
+func (u EnvAppDeploymentAbort) Pattern() string {
+	return "/dashboard/{teamId}/envs/{envName}/apps/{appId}/deployments/{deploymentId}/abort"
+}
+
+func (u EnvAppDeploymentAbort) Render() string {
+	if u.TeamId == "" || u.AppId == "" || u.EnvName == "" || u.DeploymentId == 0 {
+		panic("teamId, appId, envName, and deploymentId are required")
+	}
+	return fmt.Sprintf("/dashboard/%s/envs/%s/apps/%s/deployments/%d/abort", u.TeamId, u.EnvName, u.AppId, u.DeploymentId)
+}
+
+type EnvAppDeploymentCancel struct {
+	TeamId       string
+	AppId        string
+	EnvName      string
+	DeploymentId uint
+}
+
+var _ Url = EnvAppDeploymentCancel{}
+
+func (u EnvAppDeploymentCancel) Pattern() string {
+	return "/dashboard/{teamId}/envs/{envName}/apps/{appId}/deployments/{deploymentId}/cancel"
+}
+
+func (u EnvAppDeploymentCancel) Render() string {
+	if u.TeamId == "" || u.AppId == "" || u.EnvName == "" || u.DeploymentId == 0 {
+		panic("teamId, appId, envName, and deploymentId are required")
+	}
+	return fmt.Sprintf("/dashboard/%s/envs/%s/apps/%s/deployments/%d/cancel", u.TeamId, u.EnvName, u.AppId, u.DeploymentId)
+}
+
+type EnvAppDeploymentApprove struct {
+	TeamId       string
+	AppId        string
+	EnvName      string
+	DeploymentId uint
+}
+
+var _ Url = EnvAppDeploymentApprove{}
+
+func (u EnvAppDeploymentApprove) Pattern() string {
+	return "/dashboard/{teamId}/envs/{envName}/apps/{appId}/deployments/{deploymentId}/approve"
+}
+
+func (u EnvAppDeploymentApprove) Render() string {
+	if u.TeamId == "" || u.AppId == "" || u.EnvName == "" || u.DeploymentId == 0 {
+		panic("teamId, appId, envName, and deploymentId are required")
+	}
+	return fmt.Sprintf("/dashboard/%s/envs/%s/apps/%s/deployments/%d/approve", u.TeamId, u.EnvName, u.AppId, u.DeploymentId)
+}
+
+type EnvAppDeploymentReject struct {
+	TeamId       string
+	AppId        string
+	EnvName      string
+	DeploymentId uint
+}
+
+var _ Url = EnvAppDeploymentReject{}
+
+func (u EnvAppDeploymentReject) Pattern() string {
+	return "/dashboard/{teamId}/envs/{envName}/apps/{appId}/deployments/{deploymentId}/reject"
+}
+
+func (u EnvAppDeploymentReject) Render() string {
+	if u.TeamId == "" || u.AppId == "" || u.EnvName == "" || u.DeploymentId == 0 {
+		panic("teamId, appId, envName, and deploymentId are required")
+	}
+	return fmt.Sprintf("/dashboard/%s/envs/%s/apps/%s/deployments/%d/reject", u.TeamId, u.EnvName, u.AppId, u.DeploymentId)
+}
+
+type EnvAppScale struct {
+	TeamId  string
+	AppId   string
+	EnvName string
+}
+
+var _ Url = EnvAppScale{}
+
+func (u EnvAppScale) Pattern() string {
+	return "/dashboard/{teamId}/envs/{envName}/apps/{appId}/scale"
+}
+
+func (u EnvAppScale) Render() string {
+	if u.TeamId == "" || u.AppId == "" || u.EnvName == "" {
+		panic("teamId, appId, and envName are required")
+	}
+	return fmt.Sprintf("/dashboard/%s/envs/%s/apps/%s/scale", u.TeamId, u.EnvName, u.AppId)
+}
+
+type EnvAppRestart struct {
+	TeamId  string
+	AppId   string
+	EnvName string
+}
+
+var _ Url = EnvAppRestart{}
+
+func (u EnvAppRestart) Pattern() string {
+	return "/dashboard/{teamId}/envs/{envName}/apps/{appId}/restart"
+}
+
+func (u EnvAppRestart) Render() string {
+	if u.TeamId == "" || u.AppId == "" || u.EnvName == "" {
+		panic("teamId, appId, and envName are required")
+	}
+	return fmt.Sprintf("/dashboard/%s/envs/%s/apps/%s/restart", u.TeamId, u.EnvName, u.AppId)
+}
+
+type EnvAppHealthCheckUpdate struct {
+	TeamId  string
+	AppId   string
+	EnvName string
+}
+
+var _ Url = EnvAppHealthCheckUpdate{}
+
+func (u EnvAppHealthCheckUpdate) Pattern() string {
+	return "/dashboard/{teamId}/envs/{envName}/apps/{appId}/settings/health-check"
+}
+
+func (u EnvAppHealthCheckUpdate) Render() string {
+	if u.TeamId == "" || u.AppId == "" || u.EnvName == "" {
+		panic("teamId, appId, and envName are required")
+	}
+	return fmt.Sprintf("/dashboard/%s/envs/%s/apps/%s/settings/health-check", u.TeamId, u.EnvName, u.AppId)
+}
+
+type EnvAppReleaseCommandUpdate struct {
+	TeamId  string
+	AppId   string
+	EnvName string
+}
+
+var _ Url = EnvAppReleaseCommandUpdate{}
+
+func (u EnvAppReleaseCommandUpdate) Pattern() string {
+	return "/dashboard/{teamId}/envs/{envName}/apps/{appId}/settings/release-command"
+}
+
+func (u EnvAppReleaseCommandUpdate) Render() string {
+	if u.TeamId == "" || u.AppId == "" || u.EnvName == "" {
+		panic("teamId, appId, and envName are required")
+	}
+	return fmt.Sprintf("/dashboard/%s/envs/%s/apps/%s/settings/release-command", u.TeamId, u.EnvName, u.AppId)
+}
+
+type EnvAppJobs struct {
+	TeamId  string
+	AppId   string
+	EnvName string
+}
+
+var _ Url = EnvAppJobs{}
+
+func (u EnvAppJobs) Pattern() string {
+	return "/dashboard/{teamId}/envs/{envName}/apps/{appId}/jobs"
+}
+
+func (u EnvAppJobs) Render() string {
+	if u.TeamId == "" || u.AppId == "" || u.EnvName == "" {
+		panic("teamId, appId, and envName are required")
+	}
+	return fmt.Sprintf("/dashboard/%s/envs/%s/apps/%s/jobs", u.TeamId, u.EnvName, u.AppId)
+}
+
+type EnvAppJobCreate struct {
+	TeamId  string
+	AppId   string
+	EnvName string
+}
+
+var _ Url = EnvAppJobCreate{}
+
+func (u EnvAppJobCreate) Pattern() string {
+	return "/dashboard/{teamId}/envs/{envName}/apps/{appId}/jobs/create"
+}
+
+func (u EnvAppJobCreate) Render() string {
+	if u.TeamId == "" || u.AppId == "" || u.EnvName == "" {
+		panic("teamId, appId, and envName are required")
+	}
+	return fmt.Sprintf("/dashboard/%s/envs/%s/apps/%s/jobs/create", u.TeamId, u.EnvName, u.AppId)
+}
+
+type EnvAppJobDelete struct {
+	TeamId  string
+	AppId   string
+	EnvName string
+	JobName string
+}
+
+var _ Url = EnvAppJobDelete{}
+
+func (u EnvAppJobDelete) Pattern() string {
+	return "/dashboard/{teamId}/envs/{envName}/apps/{appId}/jobs/{jobName}/delete"
+}
+
+func (u EnvAppJobDelete) Render() string {
+	if u.TeamId == "" || u.AppId == "" || u.EnvName == "" || u.JobName == "" {
+		panic("teamId, appId, envName, and jobName are required")
+	}
+	return fmt.Sprintf("/dashboard/%s/envs/%s/apps/%s/jobs/%s/delete", u.TeamId, u.EnvName, u.AppId, u.JobName)
+}
+
+type EnvAppJobRunLogs struct {
+	TeamId  string
+	AppId   string
+	EnvName string
+	JobName string
+	RunName string
+}
+
+var _ Url = EnvAppJobRunLogs{}
+
+func (u EnvAppJobRunLogs) Pattern() string {
+	return "/dashboard/{teamId}/envs/{envName}/apps/{appId}/jobs/{jobName}/runs/{runName}/logs"
+}
+
+func (u EnvAppJobRunLogs) Render() string {
+	if u.TeamId == "" || u.AppId == "" || u.EnvName == "" || u.JobName == "" || u.RunName == "" {
+		panic("teamId, appId, envName, jobName, and runName are required")
+	}
+	return fmt.Sprintf("/dashboard/%s/envs/%s/apps/%s/jobs/%s/runs/%s/logs", u.TeamId, u.EnvName, u.AppId, u.JobName, u.RunName)
+}
+
+type EnvAppDomains struct {
+	TeamId  string
+	AppId   string
+	EnvName string
+}
+
+var _ Url = EnvAppDomains{}
+
+func (u EnvAppDomains) Pattern() string {
+	return "/dashboard/{teamId}/envs/{envName}/apps/{appId}/domains"
+}
+
+func (u EnvAppDomains) Render() string {
+	if u.TeamId == "" || u.AppId == "" || u.EnvName == "" {
+		panic("teamId, appId, and envName are required")
+	}
+	return fmt.Sprintf("/dashboard/%s/envs/%s/apps/%s/domains", u.TeamId, u.EnvName, u.AppId)
+}
+
+type EnvAppDomainCreate struct {
+	TeamId  string
+	AppId   string
+	EnvName string
+}
+
+var _ Url = EnvAppDomainCreate{}
+
+func (u EnvAppDomainCreate) Pattern() string {
+	return "/dashboard/{teamId}/envs/{envName}/apps/{appId}/domains/create"
+}
+
+func (u EnvAppDomainCreate) Render() string {
+	if u.TeamId == "" || u.AppId == "" || u.EnvName == "" {
+		panic("teamId, appId, and envName are required")
+	}
+	return fmt.Sprintf("/dashboard/%s/envs/%s/apps/%s/domains/create", u.TeamId, u.EnvName, u.AppId)
+}
+
+type EnvAppDomainVerify struct {
+	TeamId   string
+	AppId    string
+	EnvName  string
+	Hostname string
+}
+
+var _ Url = EnvAppDomainVerify{}
+
+func (u EnvAppDomainVerify) Pattern() string {
+	return "/dashboard/{teamId}/envs/{envName}/apps/{appId}/domains/{hostname}/verify"
+}
+
+func (u EnvAppDomainVerify) Render() string {
+	if u.TeamId == "" || u.AppId == "" || u.EnvName == "" || u.Hostname == "" {
+		panic("teamId, appId, envName, and hostname are required")
+	}
+	return fmt.Sprintf("/dashboard/%s/envs/%s/apps/%s/domains/%s/verify", u.TeamId, u.EnvName, u.AppId, u.Hostname)
+}
+
+type EnvAppDomainDelete struct {
+	TeamId   string
+	AppId    string
+	EnvName  string
+	Hostname string
+}
+
+var _ Url = EnvAppDomainDelete{}
+
+func (u EnvAppDomainDelete) Pattern() string {
+	return "/dashboard/{teamId}/envs/{envName}/apps/{appId}/domains/{hostname}/delete"
+}
+
+func (u EnvAppDomainDelete) Render() string {
+	if u.TeamId == "" || u.AppId == "" || u.EnvName == "" || u.Hostname == "" {
+		panic("teamId, appId, envName, and hostname are required")
+	}
+	return fmt.Sprintf("/dashboard/%s/envs/%s/apps/%s/domains/%s/delete", u.TeamId, u.EnvName, u.AppId, u.Hostname)
+}
@@ -0,0 +1,64 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+)
+
+// shouldAutoRollback reports whether a failed deployment gets rolled back automatically. Only deployments that roll out a new
+// version do, and never a rollback that was itself automatic, so that two broken versions can't keep replacing each other.
+// A canary that fails before it is promoted fully leaves the previous deployment serving, so it needs no rollback either.
+func shouldAutoRollback(failed store.Deployment) bool {
+	return failed.Env.AutoRollback &&
+		(failed.Type == store.DeploymentTypeDeploy || failed.Type == store.DeploymentTypeRollback) &&
+		failed.RollbackOf == 0 &&
+		!failed.InCanaryPhase()
+}
+
+// autoRollback redeploys the settings of the deployment that was running when failed was rolled out, if the env asks for it
+func (h MessageHandler) autoRollback(ctx context.Context, log *slog.Logger, failed store.Deployment) error {
+	if !shouldAutoRollback(failed) {
+		return nil
+	}
+	deployments, err := h.deploymentStore.GetForAppEnv(ctx, failed.AppId, failed.EnvId)
+	if err != nil {
+		return fmt.Errorf("error fetching deployments: %v", err)
+	}
+	target, ok := lo.Find(deployments, func(d store.Deployment) bool { return d.Id != failed.Id && d.Status == store.DeploymentStatusRunning })
+	if !ok {
+		log.Info("No running deployment to roll back to")
+		return nil
+	}
+
+	rollback, err := h.deploymentStore.Create(store.CreateDeploymentOptions{
+		TeamId:        failed.TeamId,
+		EnvId:         failed.EnvId,
+		AppId:         failed.AppId,
+		Type:          store.DeploymentTypeRollback,
+		AppSettingsId: target.AppSettingsId,
+		AppEnvVarsId:  target.AppEnvVarsId,
+		EnvVarSetId:   target.EnvVarSetId,
+		CellIds:       lo.Map(target.Cells, func(c store.Cell, _ int) string { return c.Id }),
+		Replicas:      target.Replicas,
+		RollbackOf:    failed.Id,
+		// rolling back a failed deployment restores what was running, which a lock shouldn't stand in the way of
+		OverrideLock: true,
+	})
+	if err != nil {
+		return fmt.Errorf("error creating rollback deployment: %v", err)
+	}
+	log.Info("Rolling back failed deployment", slog.Int("rollbackDeploymentID", int(rollback.Id)), slog.Int("targetDeploymentID", int(target.Id)))
+	statusReason := fmt.Sprintf("%s; rolling back to deployment %d with deployment %d", failed.StatusReason, target.Id, rollback.Id)
+	if err := h.deploymentStore.UpdateDeploymentStatus(failed.AppId, failed.EnvId, failed.Id, store.DeploymentStatusFailed, statusReason); err != nil {
+		log.Error("Error updating deployment status", slog.Any("error", err))
+	}
+	return h.q.Send(ctx, Message{
+		DeploymentId: rollback.Id,
+		AppId:        rollback.AppId,
+		EnvId:        rollback.EnvId,
+	})
+}
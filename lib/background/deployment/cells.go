@@ -0,0 +1,125 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+)
+
+// inProgressStatuses are the statuses a deployment has on a cell while the cell still has work to do. A deployment that is
+// in several of them across its cells rolls up to the first one, so that it only looks as far along as its slowest cell.
+var inProgressStatuses = []store.DeploymentStatus{
+	store.DeploymentStatusCanceling,
+	store.DeploymentStatusAborting,
+	store.DeploymentStatusPromoting,
+	store.DeploymentStatusPending,
+	store.DeploymentStatusReleasing,
+	store.DeploymentStatusDeploying,
+}
+
+// settledStatuses are the statuses a cell is done in, in the order they win when rolling up a deployment whose cells are all
+// done. Any failed cell fails the deployment, and it is only running once it runs on every cell.
+var settledStatuses = []store.DeploymentStatus{
+	store.DeploymentStatusFailed,
+	store.DeploymentStatusCanary,
+	store.DeploymentStatusCanceled,
+	store.DeploymentStatusStopped,
+	store.DeploymentStatusRunning,
+}
+
+// cellStatusToAdvance returns the status a cell is advanced from. Promoting, aborting and canceling are asked for on the whole
+// deployment, so they apply to each cell that hasn't gotten past them yet.
+func cellStatusToAdvance(deploymentStatus, cellStatus store.DeploymentStatus) store.DeploymentStatus {
+	switch deploymentStatus {
+	case store.DeploymentStatusPromoting:
+		if cellStatus == store.DeploymentStatusCanary {
+			return store.DeploymentStatusPromoting
+		}
+	case store.DeploymentStatusAborting:
+		if cellStatus != store.DeploymentStatusStopped {
+			return store.DeploymentStatusAborting
+		}
+	case store.DeploymentStatusCanceling:
+		if cellStatus != store.DeploymentStatusCanceled {
+			return store.DeploymentStatusCanceling
+		}
+	}
+	return cellStatus
+}
+
+// rollUpCellStatuses returns the status of a deployment on all of its cells together, and a reason that says how each cell is
+// doing. A deployment on a single cell simply has that cell's status and reason.
+func rollUpCellStatuses(cells []store.Cell, statuses []store.CellStatus) (store.DeploymentStatus, string) {
+	if len(statuses) == 1 {
+		return statuses[0].Status, statuses[0].StatusReason
+	}
+	var status store.DeploymentStatus
+	for _, s := range append(inProgressStatuses, settledStatuses...) {
+		if lo.ContainsBy(statuses, func(c store.CellStatus) bool { return c.Status == s }) {
+			status = s
+			break
+		}
+	}
+	names := lo.SliceToMap(cells, func(c store.Cell) (string, string) { return c.Id, lo.CoalesceOrEmpty(c.Name, c.Id) })
+	reasons := lo.Map(statuses, func(c store.CellStatus, _ int) string {
+		if c.StatusReason == "" {
+			return fmt.Sprintf("%s: %s", names[c.CellId], c.Status)
+		}
+		return fmt.Sprintf("%s: %s, %s", names[c.CellId], c.Status, c.StatusReason)
+	})
+	return status, strings.Join(reasons, "; ")
+}
+
+// advanceCell advances the deployment on one of its cells and returns its new status there. Cells that are done are left
+// alone. Errors fail the deployment on the cell rather than being returned, so that the other cells carry on.
+func (h MessageHandler) advanceCell(ctx context.Context, deployment store.Deployment, cellId string, running []store.Deployment) (store.CellStatus, []store.K8sEvent) {
+	current := deployment.CellStatus(cellId)
+	status := cellStatusToAdvance(deployment.Status, current.Status)
+	if !lo.Contains(inProgressStatuses, status) {
+		return current, nil
+	}
+	failed := func(reason string) (store.CellStatus, []store.K8sEvent) {
+		return store.CellStatus{CellId: cellId, Status: store.DeploymentStatusFailed, StatusReason: reason}, nil
+	}
+
+	cell, err := h.cellStore.Get(cellId)
+	if err != nil {
+		return failed(fmt.Sprintf("error fetching cell: %v", err))
+	}
+	cellProvider := h.cellProviderForType(cell.Type)
+	if cellProvider == nil {
+		return failed(fmt.Sprintf("no cell provider found for cell type: %s", cell.Type))
+	}
+
+	deployment.Status = status
+	withServiceEnvVars(&deployment, cellProvider, running)
+	result, err := cellProvider.AdvanceDeployment(ctx, cell.Id, &deployment)
+	if err != nil {
+		return failed(err.Error())
+	} else if result == nil {
+		return current, nil
+	}
+	return store.CellStatus{CellId: cellId, Status: result.Status, StatusReason: result.StatusReason}, result.K8sEvents
+}
+
+// destroyOnDroppedCells tears down superseded deployments on the cells that the deployment replacing them isn't on
+func (h MessageHandler) destroyOnDroppedCells(ctx context.Context, deployment store.Deployment, superseded []store.Deployment) error {
+	for _, old := range superseded {
+		for _, cell := range old.Cells {
+			if lo.ContainsBy(deployment.Cells, func(c store.Cell) bool { return c.Id == cell.Id }) {
+				continue
+			}
+			cellProvider := h.cellProviderForType(cell.Type)
+			if cellProvider == nil {
+				return fmt.Errorf("no cell provider found for cell type: %s", cell.Type)
+			}
+			if err := cellProvider.DestroyDeployments(ctx, cell.Id, []store.Deployment{old}); err != nil {
+				return fmt.Errorf("error destroying deployment %d on cell %s: %v", old.Id, cell.Id, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package deployment
+
+import (
+	"testing"
+
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRollUpCellStatuses(t *testing.T) {
+	cells := []store.Cell{{Common: store.Common{Id: "cell_1"}, Name: "fsn1"}, {Common: store.Common{Id: "cell_2"}, Name: "ash"}, {Common: store.Common{Id: "cell_3"}}}
+
+	testCases := []struct {
+		name           string
+		statuses       []store.CellStatus
+		expectedStatus store.DeploymentStatus
+		expectedReason string
+	}{
+		{
+			name:           "single cell",
+			statuses:       []store.CellStatus{{CellId: "cell_1", Status: store.DeploymentStatusDeploying, StatusReason: "1/2 replicas ready"}},
+			expectedStatus: store.DeploymentStatusDeploying,
+			expectedReason: "1/2 replicas ready",
+		},
+		{
+			name: "one cell failed while another is still deploying",
+			statuses: []store.CellStatus{
+				{CellId: "cell_1", Status: store.DeploymentStatusFailed, StatusReason: "image pull failed"},
+				{CellId: "cell_2", Status: store.DeploymentStatusDeploying},
+			},
+			expectedStatus: store.DeploymentStatusDeploying,
+			expectedReason: "fsn1: failed, image pull failed; ash: deploying",
+		},
+		{
+			name: "cells at different stages roll up to the slowest",
+			statuses: []store.CellStatus{
+				{CellId: "cell_1", Status: store.DeploymentStatusDeploying},
+				{CellId: "cell_2", Status: store.DeploymentStatusReleasing},
+				{CellId: "cell_3", Status: store.DeploymentStatusRunning},
+			},
+			expectedStatus: store.DeploymentStatusReleasing,
+			expectedReason: "fsn1: deploying; ash: releasing; cell_3: running",
+		},
+		{
+			name: "all settled with one failed",
+			statuses: []store.CellStatus{
+				{CellId: "cell_1", Status: store.DeploymentStatusRunning},
+				{CellId: "cell_2", Status: store.DeploymentStatusFailed, StatusReason: "progress deadline exceeded"},
+			},
+			expectedStatus: store.DeploymentStatusFailed,
+			expectedReason: "fsn1: running; ash: failed, progress deadline exceeded",
+		},
+		{
+			name: "all settled and running",
+			statuses: []store.CellStatus{
+				{CellId: "cell_1", Status: store.DeploymentStatusRunning},
+				{CellId: "cell_2", Status: store.DeploymentStatusRunning},
+			},
+			expectedStatus: store.DeploymentStatusRunning,
+			expectedReason: "fsn1: running; ash: running",
+		},
+		{
+			name: "canary on one cell is not running yet",
+			statuses: []store.CellStatus{
+				{CellId: "cell_1", Status: store.DeploymentStatusCanary},
+				{CellId: "cell_2", Status: store.DeploymentStatusRunning},
+			},
+			expectedStatus: store.DeploymentStatusCanary,
+			expectedReason: "fsn1: canary; ash: running",
+		},
+		{
+			name: "canceling wins over everything",
+			statuses: []store.CellStatus{
+				{CellId: "cell_1", Status: store.DeploymentStatusCanceled},
+				{CellId: "cell_2", Status: store.DeploymentStatusCanceling},
+			},
+			expectedStatus: store.DeploymentStatusCanceling,
+			expectedReason: "fsn1: canceled; ash: canceling",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			status, reason := rollUpCellStatuses(cells, tc.statuses)
+			assert.Equal(t, tc.expectedStatus, status)
+			assert.Equal(t, tc.expectedReason, reason)
+		})
+	}
+}
+
+func TestCellStatusToAdvance(t *testing.T) {
+	testCases := []struct {
+		deploymentStatus store.DeploymentStatus
+		cellStatus       store.DeploymentStatus
+		expected         store.DeploymentStatus
+	}{
+		{store.DeploymentStatusDeploying, store.DeploymentStatusRunning, store.DeploymentStatusRunning},
+		{store.DeploymentStatusPromoting, store.DeploymentStatusCanary, store.DeploymentStatusPromoting},
+		{store.DeploymentStatusPromoting, store.DeploymentStatusRunning, store.DeploymentStatusRunning},
+		{store.DeploymentStatusAborting, store.DeploymentStatusCanary, store.DeploymentStatusAborting},
+		{store.DeploymentStatusAborting, store.DeploymentStatusStopped, store.DeploymentStatusStopped},
+		{store.DeploymentStatusCanceling, store.DeploymentStatusDeploying, store.DeploymentStatusCanceling},
+		{store.DeploymentStatusCanceling, store.DeploymentStatusCanceled, store.DeploymentStatusCanceled},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.deploymentStatus)+" "+string(tc.cellStatus), func(t *testing.T) {
+			assert.Equal(t, tc.expected, cellStatusToAdvance(tc.deploymentStatus, tc.cellStatus))
+		})
+	}
+}
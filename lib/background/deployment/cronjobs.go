@@ -0,0 +1,73 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onmetal-dev/metal/lib/cellprovider"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+)
+
+// SyncCronJobs renders an app's cron jobs in an env onto the cells of the app's running deployment, using that deployment's image and env vars.
+// It returns false if nothing is running yet. In that case the cron jobs are synced once a deployment succeeds.
+func SyncCronJobs(ctx context.Context, deploymentStore store.DeploymentStore, cronJobStore store.CronJobStore, cellStore store.CellStore, cellProviderForType func(cellType store.CellType) cellprovider.CellProvider, appId, envId string) (bool, error) {
+	running, err := runningDeployment(ctx, deploymentStore, appId, envId)
+	if err != nil {
+		return false, err
+	} else if running == nil {
+		return false, nil
+	}
+
+	cronJobs, err := cronJobStore.GetForAppEnv(ctx, appId, envId)
+	if err != nil {
+		return false, fmt.Errorf("error fetching cron jobs: %v", err)
+	}
+	inEnv, err := runningInEnv(deploymentStore, envId)
+	if err != nil {
+		return false, err
+	}
+	for _, c := range running.Cells {
+		cell, err := cellStore.Get(c.Id)
+		if err != nil {
+			return false, fmt.Errorf("error fetching cell: %v", err)
+		}
+		cellProvider := cellProviderForType(cell.Type)
+		if cellProvider == nil {
+			return false, fmt.Errorf("no cell provider found for cell type: %s", cell.Type)
+		}
+		withServiceEnvVars(running, cellProvider, inEnv)
+		if err := cellProvider.SyncCronJobs(ctx, cell.Id, running, cronJobs); err != nil {
+			return false, fmt.Errorf("error syncing cron jobs: %v", err)
+		}
+	}
+	return true, nil
+}
+
+// CronJobCell returns the cell that an app's cron jobs in an env run on, or nil if the app isn't running in the env
+func CronJobCell(ctx context.Context, deploymentStore store.DeploymentStore, cellStore store.CellStore, appId, envId string) (*store.Cell, error) {
+	running, err := runningDeployment(ctx, deploymentStore, appId, envId)
+	if err != nil {
+		return nil, err
+	} else if running == nil || len(running.Cells) == 0 {
+		return nil, nil
+	}
+	cell, err := cellStore.Get(running.Cells[0].Id)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching cell: %v", err)
+	}
+	return &cell, nil
+}
+
+func runningDeployment(ctx context.Context, deploymentStore store.DeploymentStore, appId, envId string) (*store.Deployment, error) {
+	deployments, err := deploymentStore.GetForAppEnv(ctx, appId, envId)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching deployments: %v", err)
+	}
+	// deployments are sorted newest first
+	running, ok := lo.Find(deployments, func(d store.Deployment) bool { return d.Status == store.DeploymentStatusRunning })
+	if !ok {
+		return nil, nil
+	}
+	return &running, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/onmetal-dev/metal/lib/cellprovider"
 	"github.com/onmetal-dev/metal/lib/logger"
 	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
 )
 
 // Message contains the deployment ID to manage and monitor
@@ -29,6 +30,7 @@ type MessageHandler struct {
 	q                   *background.QueueProducer[Message]
 	deploymentStore     store.DeploymentStore
 	cellStore           store.CellStore
+	cronJobStore        store.CronJobStore
 	cellProviderForType func(cellType store.CellType) cellprovider.CellProvider
 }
 
@@ -74,6 +76,16 @@ func WithCellStore(cellStore store.CellStore) Option {
 	}
 }
 
+func WithCronJobStore(cronJobStore store.CronJobStore) Option {
+	return func(h *MessageHandler) error {
+		if cronJobStore == nil {
+			return errors.New("cron job store cannot be nil")
+		}
+		h.cronJobStore = cronJobStore
+		return nil
+	}
+}
+
 func NewMessageHandler(opts ...Option) (*MessageHandler, error) {
 	h := &MessageHandler{}
 	for _, opt := range opts {
@@ -91,6 +103,9 @@ func NewMessageHandler(opts ...Option) (*MessageHandler, error) {
 	if h.cellStore == nil {
 		errs = append(errs, "cell store is required")
 	}
+	if h.cronJobStore == nil {
+		errs = append(errs, "cron job store is required")
+	}
 	if h.cellProviderForType == nil {
 		errs = append(errs, "cell provider for type function is required")
 	}
@@ -116,68 +131,149 @@ func (h MessageHandler) Handle(ctx context.Context, m Message) error {
 		return fmt.Errorf("error fetching deployment: %v", err)
 	}
 
-	if deployment.Status == store.DeploymentStatusRunning || deployment.Status == store.DeploymentStatusFailed {
+	if deployment.Status == store.DeploymentStatusRunning || deployment.Status == store.DeploymentStatusFailed || deployment.Status == store.DeploymentStatusCanceled {
 		log.Info("Deployment already in final state, no action needed")
 		return nil
+	} else if deployment.Status == store.DeploymentStatusCanary {
+		log.Info("Canary is waiting to be promoted or aborted, no action needed")
+		return nil
+	} else if deployment.Status == store.DeploymentStatusPendingApproval || deployment.Status == store.DeploymentStatusRejected {
+		// approving a deployment queues it again
+		log.Info("Deployment is waiting for approval or was rejected, no action needed")
+		return nil
 	}
 
 	if len(deployment.Cells) == 0 {
 		return fmt.Errorf("no cells associated with deployment")
 	}
 
-	cell, err := h.cellStore.Get(deployment.Cells[0].Id)
+	running, err := runningInEnv(h.deploymentStore, m.EnvId)
 	if err != nil {
-		return fmt.Errorf("error fetching cell: %v", err)
+		return err
 	}
-
-	cellProvider := h.cellProviderForType(cell.Type)
-	if cellProvider == nil {
-		return fmt.Errorf("no cell provider found for cell type: %s", cell.Type)
+	// deployments that roll out a new version wait for the apps they depend on
+	if deployment.Status == store.DeploymentStatusPending && (deployment.Type == store.DeploymentTypeDeploy || deployment.Type == store.DeploymentTypeRollback) {
+		if reason := waitingForDependencies(deployment, running); reason != "" {
+			// dependencies that never come up fail the deployment like pods that never become ready do
+			status := store.DeploymentStatusPending
+			if time.Now().After(dependencyDeadline(deployment)) {
+				status, reason = store.DeploymentStatusFailed, fmt.Sprintf("gave up %s after %s", reason, deployment.Env.ProgressDeadline())
+			}
+			log.Info("Deployment waiting for dependencies", slog.String("status", string(status)), slog.String("reason", reason))
+			if reason != deployment.StatusReason {
+				if err := h.deploymentStore.RecordDeploymentStatus(store.RecordDeploymentStatusOptions{
+					AppId:        m.AppId,
+					EnvId:        m.EnvId,
+					DeploymentId: m.DeploymentId,
+					Status:       status,
+					Reason:       reason,
+					Actor:        store.SystemActor,
+					UnlessStatus: []store.DeploymentStatus{store.DeploymentStatusCanceling, store.DeploymentStatusCanceled},
+				}); errors.Is(err, store.ErrDeploymentStatusChanged) {
+					log.Info("Deployment was canceled, requeueing to roll it back")
+					return h.ReQueue(ctx, m)
+				} else if err != nil {
+					log.Error("Error updating deployment status", slog.Any("error", err))
+					return err
+				}
+			}
+			if status == store.DeploymentStatusFailed {
+				// nothing rolled out, so there is nothing to roll back
+				return nil
+			}
+			return h.ReQueue(ctx, m)
+		}
 	}
 
-	result, err := cellProvider.AdvanceDeployment(ctx, cell.Id, &deployment)
-	if err != nil {
-		log.Error("Error advancing deployment", slog.Any("error", err))
-		if updateErr := h.deploymentStore.UpdateDeploymentStatus(m.AppId, m.EnvId, m.DeploymentId, store.DeploymentStatusFailed, err.Error()); updateErr != nil {
-			log.Error("Error updating deployment status", slog.Any("error", updateErr))
+	// each cell is advanced on its own, and the deployment's status is rolled up from theirs
+	var cellStatuses []store.CellStatus
+	var k8sEvents []store.K8sEvent
+	for _, cell := range deployment.Cells {
+		cellStatus, events := h.advanceCell(ctx, deployment, cell.Id, running)
+		if cellStatus.Status == store.DeploymentStatusFailed && deployment.CellStatus(cell.Id).Status != store.DeploymentStatusFailed {
+			log.Error("Error advancing deployment", slog.String("cellID", cell.Id), slog.String("reason", cellStatus.StatusReason))
 		}
-		return err
+		cellStatuses = append(cellStatuses, cellStatus)
+		k8sEvents = append(k8sEvents, events...)
 	}
+	status, statusReason := rollUpCellStatuses(deployment.Cells, cellStatuses)
 
-	if err := h.deploymentStore.UpdateDeploymentStatus(m.AppId, m.EnvId, m.DeploymentId, result.Status, result.StatusReason); err != nil {
+	opts := store.RecordDeploymentStatusOptions{
+		AppId:        m.AppId,
+		EnvId:        m.EnvId,
+		DeploymentId: m.DeploymentId,
+		Status:       status,
+		Reason:       statusReason,
+		Actor:        store.SystemActor,
+		K8sEvents:    k8sEvents,
+		CellStatuses: cellStatuses,
+	}
+	// the deployment may be canceled while the cells are working on it, in which case the cancel takes over
+	if deployment.Status != store.DeploymentStatusCanceling {
+		opts.UnlessStatus = []store.DeploymentStatus{store.DeploymentStatusCanceling, store.DeploymentStatusCanceled}
+	}
+	if err := h.deploymentStore.RecordDeploymentStatus(opts); err != nil {
+		if errors.Is(err, store.ErrDeploymentStatusChanged) {
+			log.Info("Deployment was canceled, requeueing to roll it back")
+			return h.ReQueue(ctx, m)
+		}
 		log.Error("Error updating deployment status", slog.Any("error", err))
 		return err
 	}
 
-	if result.StatusReason != "" {
-		log.Info("Deployment status update", slog.String("status", string(result.Status)), slog.String("reason", result.StatusReason))
+	if statusReason != "" {
+		log.Info("Deployment status update", slog.String("status", string(status)), slog.String("reason", statusReason))
 	} else {
-		log.Info("Deployment status update", slog.String("status", string(result.Status)))
+		log.Info("Deployment status update", slog.String("status", string(status)))
 	}
 
-	if result.Status == store.DeploymentStatusDeploying {
+	if lo.Contains(inProgressStatuses, status) {
 		log.Info("Deployment still in progress, requeueing")
 		return h.ReQueue(ctx, m)
 	}
 
-	if result.Status == store.DeploymentStatusRunning {
+	if status == store.DeploymentStatusFailed {
+		deployment.StatusReason = statusReason
+		if err := h.autoRollback(ctx, log, deployment); err != nil {
+			log.Error("Error rolling back failed deployment", slog.Any("error", err))
+			return err
+		}
+		return nil
+	}
+
+	if status == store.DeploymentStatusRunning {
 		log.Info("Deployment completed successfully")
-		// mark all previously running deployments as completed
+		// mark all previously running deployments as completed, along with any canary this one replaced
 		deployments, err := h.deploymentStore.GetForAppEnv(ctx, m.AppId, m.EnvId)
 		if err != nil {
 			log.Error("Error fetching deployments", slog.Any("error", err))
 			return err
 		}
-		for _, deployment := range deployments {
-			if deployment.Id == m.DeploymentId {
+		var superseded []store.Deployment
+		for _, d := range deployments {
+			if d.Id == m.DeploymentId {
 				continue
 			}
-			if deployment.Status == store.DeploymentStatusRunning {
-				if err := h.deploymentStore.UpdateDeploymentStatus(m.AppId, m.EnvId, deployment.Id, store.DeploymentStatusStopped, fmt.Sprintf("superseded by deployment %d", m.DeploymentId)); err != nil {
+			if d.Status == store.DeploymentStatusRunning || d.Status == store.DeploymentStatusCanary {
+				if err := h.deploymentStore.UpdateDeploymentStatus(m.AppId, m.EnvId, d.Id, store.DeploymentStatusStopped, fmt.Sprintf("superseded by deployment %d", m.DeploymentId)); err != nil {
 					log.Error("Error updating deployment status", slog.Any("error", err))
 				}
+				superseded = append(superseded, d)
 			}
 		}
+
+		// nothing replaces the superseded deployments on cells the env no longer deploys to, so take them down there
+		if err := h.destroyOnDroppedCells(ctx, deployment, superseded); err != nil {
+			log.Error("Error destroying deployments on dropped cells", slog.Any("error", err))
+		}
+		if err := SyncDomains(ctx, h.deploymentStore, h.cellStore, h.cellProviderForType, deployment.TeamId, m.AppId, m.EnvId); err != nil {
+			log.Error("Error syncing domains", slog.Any("error", err))
+		}
+
+		// cron jobs run the image of the running deployment, so point them at the new one
+		if _, err := SyncCronJobs(ctx, h.deploymentStore, h.cronJobStore, h.cellStore, h.cellProviderForType, m.AppId, m.EnvId); err != nil {
+			log.Error("Error syncing cron jobs", slog.Any("error", err))
+		}
 	}
 
 	return nil
@@ -0,0 +1,130 @@
+package deployment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/onmetal-dev/metal/lib/cellprovider"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+)
+
+// ErrAppHasVolumes is returned by DestroyApp when the app has volumes and deleting them wasn't confirmed
+var ErrAppHasVolumes = errors.New("app has volumes. deleting it deletes their data too, which has to be confirmed")
+
+// DestroyApp tears down an app's deployments on every cell of its team and removes them from the store, along with its custom domains.
+// If the app has ever had volumes their data goes with it, which the caller has to confirm with deleteVolumes. Otherwise nothing is destroyed and ErrAppHasVolumes is returned.
+func DestroyApp(ctx context.Context, deploymentStore store.DeploymentStore, domainStore store.DomainStore, cellStore store.CellStore, cellProviderForType func(cellType store.CellType) cellprovider.CellProvider, app store.App, deleteVolumes bool) error {
+	deployments, err := deploymentStore.GetForApp(ctx, app.Id)
+	if err != nil {
+		return fmt.Errorf("error fetching deployments: %v", err)
+	}
+	hasVolumes := lo.ContainsBy(deployments, func(d store.Deployment) bool { return d.AppSettings.HasVolumes() })
+	if hasVolumes && !deleteVolumes {
+		return ErrAppHasVolumes
+	}
+
+	// the hostnames of the app's domains are free to be used elsewhere once it is gone
+	domains, err := domainStore.GetForTeam(ctx, app.TeamId)
+	if err != nil {
+		return fmt.Errorf("error fetching domains: %v", err)
+	}
+	for _, domain := range lo.Filter(domains, func(d store.Domain, _ int) bool { return d.AppId == app.Id }) {
+		if err := domainStore.Delete(ctx, domain.Id); err != nil {
+			return fmt.Errorf("error deleting domain: %v", err)
+		}
+	}
+
+	cells, err := cellStore.GetForTeam(ctx, app.TeamId)
+	if err != nil {
+		return fmt.Errorf("error fetching cells: %v", err)
+	}
+	for _, cell := range cells {
+		deploymentsForCell := lo.Filter(deployments, func(d store.Deployment, _ int) bool {
+			return lo.ContainsBy(d.Cells, func(c store.Cell) bool { return c.Id == cell.Id })
+		})
+		cellProvider := cellProviderForType(cell.Type)
+		if cellProvider == nil {
+			return fmt.Errorf("no cell provider found for cell type: %s", cell.Type)
+		}
+		if err := cellProvider.DestroyDeployments(ctx, cell.Id, deploymentsForCell); err != nil {
+			return fmt.Errorf("error destroying deployments: %v", err)
+		}
+		if err := cellProvider.SyncDomains(ctx, cell.Id, nil); err != nil {
+			return fmt.Errorf("error removing domains: %v", err)
+		}
+		if hasVolumes {
+			if err := cellProvider.DestroyVolumes(ctx, cell.Id, deploymentsForCell); err != nil {
+				return fmt.Errorf("error destroying volumes: %v", err)
+			}
+		}
+		// update deployment status for all deployments, then delete them so they don't appear in the dashboard
+		for _, d := range deploymentsForCell {
+			if err := deploymentStore.UpdateDeploymentStatus(app.Id, d.EnvId, d.Id, store.DeploymentStatusStopped, "app deleted"); err != nil {
+				return fmt.Errorf("error updating deployment status: %v", err)
+			}
+			if err := deploymentStore.DeleteDeployment(app.Id, d.EnvId, d.Id); err != nil {
+				return fmt.Errorf("error deleting deployment: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+// DestroyEnv tears down the deployments of every app in an env on the cells they run on, volumes included, and removes them from the
+// store along with the env's custom domains. It is how preview envs are torn down once they go unused.
+func DestroyEnv(ctx context.Context, deploymentStore store.DeploymentStore, domainStore store.DomainStore, cellStore store.CellStore, cellProviderForType func(cellType store.CellType) cellprovider.CellProvider, env store.Env) error {
+	deployments, err := deploymentStore.GetForEnv(env.Id)
+	if err != nil {
+		return fmt.Errorf("error fetching deployments: %v", err)
+	}
+	hasVolumes := lo.ContainsBy(deployments, func(d store.Deployment) bool { return d.AppSettings.HasVolumes() })
+
+	domains, err := domainStore.GetForTeam(ctx, env.TeamId)
+	if err != nil {
+		return fmt.Errorf("error fetching domains: %v", err)
+	}
+	for _, domain := range lo.Filter(domains, func(d store.Domain, _ int) bool { return d.EnvId == env.Id }) {
+		if err := domainStore.Delete(ctx, domain.Id); err != nil {
+			return fmt.Errorf("error deleting domain: %v", err)
+		}
+	}
+
+	cells, err := cellStore.GetForTeam(ctx, env.TeamId)
+	if err != nil {
+		return fmt.Errorf("error fetching cells: %v", err)
+	}
+	for _, cell := range cells {
+		deploymentsForCell := lo.Filter(deployments, func(d store.Deployment, _ int) bool {
+			return lo.ContainsBy(d.Cells, func(c store.Cell) bool { return c.Id == cell.Id })
+		})
+		if len(deploymentsForCell) == 0 {
+			continue
+		}
+		cellProvider := cellProviderForType(cell.Type)
+		if cellProvider == nil {
+			return fmt.Errorf("no cell provider found for cell type: %s", cell.Type)
+		}
+		if err := cellProvider.DestroyDeployments(ctx, cell.Id, deploymentsForCell); err != nil {
+			return fmt.Errorf("error destroying deployments: %v", err)
+		}
+		if err := cellProvider.SyncDomains(ctx, cell.Id, nil); err != nil {
+			return fmt.Errorf("error removing domains: %v", err)
+		}
+		if hasVolumes {
+			if err := cellProvider.DestroyVolumes(ctx, cell.Id, deploymentsForCell); err != nil {
+				return fmt.Errorf("error destroying volumes: %v", err)
+			}
+		}
+		for _, d := range deploymentsForCell {
+			if err := deploymentStore.UpdateDeploymentStatus(d.AppId, env.Id, d.Id, store.DeploymentStatusStopped, "env deleted"); err != nil {
+				return fmt.Errorf("error updating deployment status: %v", err)
+			}
+			if err := deploymentStore.DeleteDeployment(d.AppId, env.Id, d.Id); err != nil {
+				return fmt.Errorf("error deleting deployment: %v", err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/onmetal-dev/metal/lib/cellprovider"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+	"gorm.io/datatypes"
+)
+
+// MaskedValue stands in for the values of env vars in diffs, since those are often secrets
+const MaskedValue = "********"
+
+// Change is a difference between the settings of two deployments. From and To are empty if the field isn't set on that side.
+type Change struct {
+	Field string
+	From  string
+	To    string
+}
+
+// Plan is what deploying a planned deployment would change, compared to the latest deployment of its app and env
+type Plan struct {
+	Changes []Change
+	// Objects are what the cell would create, update and delete. Empty if latest isn't on a cell.
+	Objects []cellprovider.PlannedObject
+}
+
+// settingValue renders a setting for a Change, as JSON unless it is a plain string
+func settingValue(value any) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	out, err := json.Marshal(value)
+	if err != nil || string(out) == "null" || string(out) == "[]" {
+		return ""
+	}
+	return string(out)
+}
+
+// SettingsChanges compares the image, replicas, settings and env vars of two deployments. Env var values are masked, only whether they changed shows.
+func SettingsChanges(from, to *store.Deployment) []Change {
+	var changes []Change
+	compare := func(field string, fromValue, toValue any) {
+		f, t := settingValue(fromValue), settingValue(toValue)
+		if f != t {
+			changes = append(changes, Change{Field: field, From: f, To: t})
+		}
+	}
+	image := func(d *store.Deployment) string {
+		if artifact := d.AppSettings.Artifact.Data(); artifact.Image != nil {
+			return artifact.Image.Name()
+		}
+		return ""
+	}
+	compare("image", image(from), image(to))
+	compare("replicas", fmt.Sprintf("%d", from.Replicas), fmt.Sprintf("%d", to.Replicas))
+	fromSettings, toSettings := from.AppSettings, to.AppSettings
+	compare("ports", fromSettings.Ports.Data(), toSettings.Ports.Data())
+	compare("external_ports", fromSettings.ExternalPorts.Data(), toSettings.ExternalPorts.Data())
+	compare("resources", fromSettings.Resources.Data(), toSettings.Resources.Data())
+	compare("health_check", fromSettings.HealthCheck.Data(), toSettings.HealthCheck.Data())
+	compare("release_command", fromSettings.ReleaseCommand, toSettings.ReleaseCommand)
+	compare("processes", fromSettings.Processes.Data(), toSettings.Processes.Data())
+	compare("autoscaling", fromSettings.Autoscaling.Data(), toSettings.Autoscaling.Data())
+	compare("volumes", fromSettings.Volumes.Data(), toSettings.Volumes.Data())
+	compare("dependencies", fromSettings.Dependencies.Data(), toSettings.Dependencies.Data())
+
+	fromEnvVars := lo.SliceToMap(from.EnvVars(), func(e store.EnvVar) (string, string) { return e.Name, e.Value })
+	toEnvVars := lo.SliceToMap(to.EnvVars(), func(e store.EnvVar) (string, string) { return e.Name, e.Value })
+	names := lo.Uniq(append(lo.Keys(fromEnvVars), lo.Keys(toEnvVars)...))
+	sort.Strings(names)
+	for _, name := range names {
+		fromValue, inFrom := fromEnvVars[name]
+		toValue, inTo := toEnvVars[name]
+		if inFrom && inTo && fromValue == toValue {
+			continue
+		}
+		change := Change{Field: "env." + name}
+		if inFrom {
+			change.From = MaskedValue
+		}
+		if inTo {
+			change.To = MaskedValue
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// PlanDeployment compares planned, which is latest with some of its settings changed, to latest and asks the provider of
+// latest's first cell what rolling it out would do there. Nothing is stored or changed.
+func PlanDeployment(ctx context.Context, deploymentStore store.DeploymentStore, cellStore store.CellStore, cellProviderForType func(cellType store.CellType) cellprovider.CellProvider, latest *store.Deployment, planned store.Deployment) (*Plan, error) {
+	plan := &Plan{Changes: SettingsChanges(latest, &planned)}
+	if len(latest.Cells) == 0 {
+		return plan, nil
+	}
+	cell, err := cellStore.Get(latest.Cells[0].Id)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching cell: %v", err)
+	}
+	cellProvider := cellProviderForType(cell.Type)
+	if cellProvider == nil {
+		return nil, fmt.Errorf("no cell provider found for cell type: %s", cell.Type)
+	}
+	running, err := runningInEnv(deploymentStore, latest.EnvId)
+	if err != nil {
+		return nil, err
+	}
+	// the planned deployment would get the next id, and see the same sibling apps as a real one
+	planned.Id = latest.Id + 1
+	planned.Type = store.DeploymentTypeDeploy
+	planned.Status = store.DeploymentStatusPending
+	planned.CanarySteps = datatypes.NewJSONType[[]int](nil)
+	planned.CanaryWeight = 0
+	withServiceEnvVars(&planned, cellProvider, running)
+	objects, err := cellProvider.PlanDeployment(ctx, cell.Id, &planned)
+	if err != nil {
+		return nil, fmt.Errorf("error planning deployment: %v", err)
+	}
+	plan.Objects = objects
+	return plan, nil
+}
@@ -0,0 +1,58 @@
+package deployment
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/onmetal-dev/metal/lib/cellprovider"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+	"gorm.io/datatypes"
+)
+
+// runningInEnv returns the running deployment of each app in an env
+func runningInEnv(deploymentStore store.DeploymentStore, envId string) ([]store.Deployment, error) {
+	deployments, err := deploymentStore.GetForEnv(envId)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching deployments: %v", err)
+	}
+	return lo.Filter(deployments, func(d store.Deployment, _ int) bool { return d.Status == store.DeploymentStatusRunning }), nil
+}
+
+// withServiceEnvVars adds the env vars shared by the apps in the deployment's env and the ones that point at the other apps
+// running there to its env vars. Env vars the app sets itself win over shared ones, which win over the ones pointing at other apps.
+// Only the deployment passed in changes, nothing is stored.
+func withServiceEnvVars(deployment *store.Deployment, cellProvider cellprovider.CellProvider, running []store.Deployment) {
+	envVars := deployment.EnvVars()
+	for _, envVar := range cellProvider.ServiceEnvVars(deployment, running) {
+		if !lo.ContainsBy(envVars, func(e store.EnvVar) bool { return e.Name == envVar.Name }) {
+			envVars = append(envVars, envVar)
+		}
+	}
+	deployment.AppEnvVars.EnvVars = datatypes.NewJSONType(envVars)
+}
+
+// dependencyDeadline returns when a deployment gives up waiting for its dependencies, which is the env's progress deadline after it was
+// created, or approved if it had to be
+func dependencyDeadline(deployment store.Deployment) time.Time {
+	start := deployment.CreatedAt
+	if review := deployment.Review.Data(); review != nil && review.Approved {
+		start = review.ReviewedAt
+	}
+	return start.Add(deployment.Env.ProgressDeadline())
+}
+
+// waitingForDependencies returns why a deployment that is about to roll out has to wait for its dependencies, or "" if they are all running
+func waitingForDependencies(deployment store.Deployment, running []store.Deployment) string {
+	var waiting []string
+	for _, dependency := range deployment.AppSettings.Dependencies.Data() {
+		if !lo.ContainsBy(running, func(d store.Deployment) bool { return d.App.Name == dependency }) {
+			waiting = append(waiting, dependency)
+		}
+	}
+	if len(waiting) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("waiting for %s to be running", strings.Join(waiting, ", "))
+}
@@ -0,0 +1,87 @@
+package deployment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/onmetal-dev/metal/lib/store/mock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+)
+
+func TestWaitingForDependencies(t *testing.T) {
+	deployment := store.Deployment{AppSettings: store.AppSettings{Dependencies: datatypes.NewJSONType([]string{"db", "cache"})}}
+	testCases := []struct {
+		name     string
+		running  []string
+		expected string
+	}{
+		{"none running", nil, "waiting for db, cache to be running"},
+		{"some running", []string{"db"}, "waiting for cache to be running"},
+		{"all running", []string{"cache", "db", "web"}, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			running := make([]store.Deployment, len(tc.running))
+			for i, name := range tc.running {
+				running[i] = store.Deployment{App: store.App{Name: name}, Status: store.DeploymentStatusRunning}
+			}
+			assert.Equal(t, tc.expected, waitingForDependencies(deployment, running))
+		})
+	}
+}
+
+func TestDependencyDeadline(t *testing.T) {
+	createdAt := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	approvedAt := createdAt.Add(3 * time.Hour)
+	testCases := []struct {
+		name       string
+		deployment store.Deployment
+		expected   time.Time
+	}{
+		{"default progress deadline", store.Deployment{CreatedAt: createdAt}, createdAt.Add(10 * time.Minute)},
+		{"env's progress deadline", store.Deployment{CreatedAt: createdAt, Env: store.Env{ProgressDeadlineSeconds: 120}}, createdAt.Add(2 * time.Minute)},
+		{
+			"counted from the approval",
+			store.Deployment{CreatedAt: createdAt, Review: datatypes.NewJSONType(&store.DeploymentReview{Approved: true, ReviewedAt: approvedAt})},
+			approvedAt.Add(10 * time.Minute),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, dependencyDeadline(tc.deployment))
+		})
+	}
+}
+
+func TestHandleFailsDeploymentWhoseDependenciesNeverRun(t *testing.T) {
+	deploymentStore := &mock.DeploymentStoreMock{}
+	deployment := store.Deployment{
+		Id:          1,
+		AppId:       "app_1",
+		EnvId:       "env_1",
+		Type:        store.DeploymentTypeDeploy,
+		Status:      store.DeploymentStatusPending,
+		CreatedAt:   time.Now().Add(-11 * time.Minute),
+		Cells:       []store.Cell{{Common: store.Common{Id: "cell_1"}}},
+		AppSettings: store.AppSettings{Dependencies: datatypes.NewJSONType([]string{"db"})},
+	}
+	deploymentStore.On("Get", "app_1", "env_1", uint(1)).Return(deployment, nil)
+	deploymentStore.On("GetForEnv", "env_1").Return([]store.Deployment{}, nil)
+	deploymentStore.On("RecordDeploymentStatus", store.RecordDeploymentStatusOptions{
+		AppId:        "app_1",
+		EnvId:        "env_1",
+		DeploymentId: 1,
+		Status:       store.DeploymentStatusFailed,
+		Reason:       "gave up waiting for db to be running after 10m0s",
+		Actor:        store.SystemActor,
+		UnlessStatus: []store.DeploymentStatus{store.DeploymentStatusCanceling, store.DeploymentStatusCanceled},
+	}).Return(nil)
+
+	// the handler has no queue producer, so requeueing would panic
+	h := MessageHandler{deploymentStore: deploymentStore}
+	assert.NoError(t, h.Handle(context.Background(), Message{DeploymentId: 1, AppId: "app_1", EnvId: "env_1"}))
+	deploymentStore.AssertExpectations(t)
+}
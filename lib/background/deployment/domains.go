@@ -0,0 +1,118 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onmetal-dev/metal/lib/cellprovider"
+	"github.com/onmetal-dev/metal/lib/domainverify"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+)
+
+// SyncDomains renders a team's verified custom domains onto each of its cells, and points the http routes of the app's running deployment in the env at the ones for it
+func SyncDomains(ctx context.Context, deploymentStore store.DeploymentStore, cellStore store.CellStore, cellProviderForType func(cellType store.CellType) cellprovider.CellProvider, teamId, appId, envId string) error {
+	running, err := runningDeployment(ctx, deploymentStore, appId, envId)
+	if err != nil {
+		return err
+	}
+	cells, err := cellStore.GetForTeam(ctx, teamId)
+	if err != nil {
+		return fmt.Errorf("error fetching cells: %v", err)
+	}
+	for _, cell := range cells {
+		cellProvider := cellProviderForType(cell.Type)
+		if cellProvider == nil {
+			return fmt.Errorf("no cell provider found for cell type: %s", cell.Type)
+		}
+		var deployment *store.Deployment
+		if running != nil && lo.ContainsBy(running.Cells, func(c store.Cell) bool { return c.Id == cell.Id }) {
+			deployment = running
+		}
+		if err := cellProvider.SyncDomains(ctx, cell.Id, deployment); err != nil {
+			return fmt.Errorf("error syncing domains: %v", err)
+		}
+	}
+	return nil
+}
+
+// DomainCNAMETargets returns the hostnames a custom domain can be a CNAME to: the app's hostname in the env on each of the team's cells
+func DomainCNAMETargets(ctx context.Context, cellStore store.CellStore, cellProviderForType func(cellType store.CellType) cellprovider.CellProvider, teamId string, app store.App, env store.Env) ([]string, error) {
+	cells, err := cellStore.GetForTeam(ctx, teamId)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching cells: %v", err)
+	}
+	var targets []string
+	for _, cell := range cells {
+		cellProvider := cellProviderForType(cell.Type)
+		if cellProvider == nil {
+			return nil, fmt.Errorf("no cell provider found for cell type: %s", cell.Type)
+		}
+		targets = append(targets, cellProvider.AppHostname(cell.Id, app, env))
+	}
+	return targets, nil
+}
+
+// VerifyDomain checks the DNS records of a domain that isn't verified yet and records the result, returning the updated domain.
+// Once the domain is verified it is synced onto the team's cells. If that fails the domain stays verified and the error is returned, so syncing can be retried.
+func VerifyDomain(ctx context.Context, domainStore store.DomainStore, deploymentStore store.DeploymentStore, cellStore store.CellStore, cellProviderForType func(cellType store.CellType) cellprovider.CellProvider, resolver domainverify.Resolver, domain store.Domain) (store.Domain, error) {
+	if domain.Verified() {
+		return domain, nil
+	}
+	targets, err := DomainCNAMETargets(ctx, cellStore, cellProviderForType, domain.TeamId, domain.App, domain.Env)
+	if err != nil {
+		return domain, err
+	}
+	if verifyErr := domainverify.Verify(ctx, resolver, domain.Hostname, domain.VerificationToken, targets); verifyErr != nil {
+		if err := domainStore.UpdateVerification(ctx, domain.Id, store.DomainVerificationStatusFailed, verifyErr.Error()); err != nil {
+			return domain, fmt.Errorf("error updating domain: %v", err)
+		}
+		return domainStore.GetByHostname(ctx, domain.Hostname)
+	}
+
+	if err := domainStore.UpdateVerification(ctx, domain.Id, store.DomainVerificationStatusVerified, ""); err != nil {
+		return domain, fmt.Errorf("error updating domain: %v", err)
+	}
+	verified, err := domainStore.GetByHostname(ctx, domain.Hostname)
+	if err != nil {
+		return domain, err
+	}
+	return verified, SyncDomains(ctx, deploymentStore, cellStore, cellProviderForType, domain.TeamId, domain.AppId, domain.EnvId)
+}
+
+// DomainCertificates returns the state of the certificate of each domain, in the same order. Unverified domains don't get one.
+// All of a team's cells issue certificates for its verified domains, so the first cell is asked.
+func DomainCertificates(ctx context.Context, cellStore store.CellStore, cellProviderForType func(cellType store.CellType) cellprovider.CellProvider, teamId string, domains []store.Domain) ([]cellprovider.DomainCertificate, error) {
+	certificates := lo.Map(domains, func(d store.Domain, _ int) cellprovider.DomainCertificate {
+		return cellprovider.DomainCertificate{Hostname: d.Hostname, Status: cellprovider.DomainCertificateStatusPending, StatusReason: "waiting for the domain to be verified"}
+	})
+	verified := lo.Filter(domains, func(d store.Domain, _ int) bool { return d.Verified() })
+	if len(verified) == 0 {
+		return certificates, nil
+	}
+
+	cells, err := cellStore.GetForTeam(ctx, teamId)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching cells: %v", err)
+	}
+	var verifiedCertificates []cellprovider.DomainCertificate
+	if len(cells) == 0 {
+		verifiedCertificates = lo.Map(verified, func(d store.Domain, _ int) cellprovider.DomainCertificate {
+			return cellprovider.DomainCertificate{Hostname: d.Hostname, Status: cellprovider.DomainCertificateStatusPending, StatusReason: "the team has no cell to issue it on"}
+		})
+	} else {
+		cellProvider := cellProviderForType(cells[0].Type)
+		if cellProvider == nil {
+			return nil, fmt.Errorf("no cell provider found for cell type: %s", cells[0].Type)
+		}
+		if verifiedCertificates, err = cellProvider.DomainCertificates(ctx, cells[0].Id, verified); err != nil {
+			return nil, fmt.Errorf("error fetching certificates: %v", err)
+		}
+	}
+	for i, d := range domains {
+		if certificate, ok := lo.Find(verifiedCertificates, func(c cellprovider.DomainCertificate) bool { return c.Hostname == d.Hostname }); ok {
+			certificates[i] = certificate
+		}
+	}
+	return certificates, nil
+}
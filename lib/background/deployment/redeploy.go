@@ -0,0 +1,54 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onmetal-dev/metal/lib/background"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+)
+
+// RedeployOptions say who asks for a redeploy, for the deployment's first event and the env's lock
+type RedeployOptions struct {
+	Actor        string
+	RequestedBy  string
+	OverrideLock bool
+}
+
+// RedeployWithSettings mints new app settings from the settings of latest, changed by modify, and queues a deployment that uses them.
+// Everything else, e.g. the app's env vars, cells and replicas, is kept from latest.
+func RedeployWithSettings(ctx context.Context, appStore store.AppStore, deploymentStore store.DeploymentStore, q *background.QueueProducer[Message],
+	latest *store.Deployment, opts RedeployOptions, modify func(opts *store.CreateAppSettingsOptions)) (store.Deployment, error) {
+	settingsOpts := latest.AppSettings.CreateOptions()
+	modify(&settingsOpts)
+	appSettings, err := appStore.CreateAppSettings(settingsOpts)
+	if err != nil {
+		return store.Deployment{}, fmt.Errorf("failed to create app settings: %w", err)
+	}
+
+	d, err := deploymentStore.Create(store.CreateDeploymentOptions{
+		TeamId:        latest.TeamId,
+		EnvId:         latest.EnvId,
+		AppId:         latest.AppId,
+		Type:          store.DeploymentTypeDeploy,
+		Actor:         opts.Actor,
+		OverrideLock:  opts.OverrideLock,
+		RequestedBy:   opts.RequestedBy,
+		AppSettingsId: appSettings.Id,
+		AppEnvVarsId:  latest.AppEnvVarsId,
+		CellIds:       lo.Map(latest.Cells, func(c store.Cell, _ int) string { return c.Id }),
+		Replicas:      latest.Replicas,
+	})
+	if err != nil {
+		return store.Deployment{}, fmt.Errorf("failed to create deployment: %w", err)
+	}
+	if err := q.Send(ctx, Message{
+		DeploymentId: d.Id,
+		AppId:        d.AppId,
+		EnvId:        d.EnvId,
+	}); err != nil {
+		return store.Deployment{}, fmt.Errorf("failed to send deployment message to queue: %w", err)
+	}
+	return d, nil
+}
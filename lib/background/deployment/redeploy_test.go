@@ -0,0 +1,72 @@
+package deployment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/onmetal-dev/metal/lib/store/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+func TestRedeployWithSettings(t *testing.T) {
+	latest := &store.Deployment{
+		Id:            4,
+		TeamId:        "team_1",
+		EnvId:         "env_1",
+		AppId:         "app_1",
+		Replicas:      2,
+		AppSettingsId: "appsettings_1",
+		AppSettings: store.AppSettings{
+			TeamId:         "team_1",
+			AppId:          "app_1",
+			ReleaseCommand: "./migrate up",
+			Ports:          datatypes.NewJSONType(store.Ports{{Name: "http", Port: 8080, Proto: "http"}}),
+		},
+		AppEnvVarsId: "appenvvars_1",
+		Cells:        []store.Cell{{Common: store.Common{Id: "cell_1"}}, {Common: store.Common{Id: "cell_2"}}},
+	}
+	opts := RedeployOptions{Actor: "someone@example.com", RequestedBy: "user_1", OverrideLock: true}
+	withoutReleaseCommand := func(opts *store.CreateAppSettingsOptions) { opts.ReleaseCommand = "" }
+
+	t.Run("settings are copied and modified", func(t *testing.T) {
+		appStore := &mock.AppStoreMock{}
+		deploymentStore := &mock.DeploymentStoreMock{}
+		settingsOpts := latest.AppSettings.CreateOptions()
+		settingsOpts.ReleaseCommand = ""
+		appStore.On("CreateAppSettings", settingsOpts).Return(store.AppSettings{}, errors.New("db is down"))
+
+		_, err := RedeployWithSettings(context.Background(), appStore, deploymentStore, nil, latest, opts, withoutReleaseCommand)
+		require.Error(t, err)
+		assert.Equal(t, "failed to create app settings: db is down", err.Error())
+		appStore.AssertExpectations(t)
+		deploymentStore.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("the deployment keeps everything but the settings", func(t *testing.T) {
+		appStore := &mock.AppStoreMock{}
+		deploymentStore := &mock.DeploymentStoreMock{}
+		appStore.On("CreateAppSettings", latest.AppSettings.CreateOptions()).Return(store.AppSettings{Common: store.Common{Id: "appsettings_2"}}, nil)
+		deploymentStore.On("Create", store.CreateDeploymentOptions{
+			TeamId:        "team_1",
+			EnvId:         "env_1",
+			AppId:         "app_1",
+			Type:          store.DeploymentTypeDeploy,
+			Actor:         "someone@example.com",
+			OverrideLock:  true,
+			RequestedBy:   "user_1",
+			AppSettingsId: "appsettings_2",
+			AppEnvVarsId:  "appenvvars_1",
+			CellIds:       []string{"cell_1", "cell_2"},
+			Replicas:      2,
+		}).Return(store.Deployment{}, store.ErrEnvLocked)
+
+		_, err := RedeployWithSettings(context.Background(), appStore, deploymentStore, nil, latest, opts, func(*store.CreateAppSettingsOptions) {})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, store.ErrEnvLocked, "Expected the store's error to be wrapped")
+		deploymentStore.AssertExpectations(t)
+	})
+}
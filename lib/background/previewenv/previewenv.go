@@ -0,0 +1,160 @@
+package previewenv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/onmetal-dev/metal/lib/background"
+	"github.com/onmetal-dev/metal/lib/background/deployment"
+	"github.com/onmetal-dev/metal/lib/cellprovider"
+	"github.com/onmetal-dev/metal/lib/logger"
+	"github.com/onmetal-dev/metal/lib/store"
+)
+
+// Message contains the preview env to tear down once it goes unused
+type Message struct {
+	EnvId string
+}
+
+// MessageHandler checks in on a preview env when its idle TTL is up. If it has been deployed to since, it checks in again
+// when the TTL is up after the latest deployment. Otherwise it tears the env down.
+type MessageHandler struct {
+	q                   *background.QueueProducer[Message]
+	deploymentStore     store.DeploymentStore
+	domainStore         store.DomainStore
+	cellStore           store.CellStore
+	cellProviderForType func(cellType store.CellType) cellprovider.CellProvider
+}
+
+type Option func(*MessageHandler) error
+
+func WithQueueProducer(q *background.QueueProducer[Message]) Option {
+	return func(h *MessageHandler) error {
+		if q == nil {
+			return errors.New("queue producer cannot be nil")
+		}
+		h.q = q
+		return nil
+	}
+}
+
+func WithDeploymentStore(deploymentStore store.DeploymentStore) Option {
+	return func(h *MessageHandler) error {
+		if deploymentStore == nil {
+			return errors.New("deployment store cannot be nil")
+		}
+		h.deploymentStore = deploymentStore
+		return nil
+	}
+}
+
+func WithDomainStore(domainStore store.DomainStore) Option {
+	return func(h *MessageHandler) error {
+		if domainStore == nil {
+			return errors.New("domain store cannot be nil")
+		}
+		h.domainStore = domainStore
+		return nil
+	}
+}
+
+func WithCellStore(cellStore store.CellStore) Option {
+	return func(h *MessageHandler) error {
+		if cellStore == nil {
+			return errors.New("cell store cannot be nil")
+		}
+		h.cellStore = cellStore
+		return nil
+	}
+}
+
+func WithCellProviderForType(fn func(cellType store.CellType) cellprovider.CellProvider) Option {
+	return func(h *MessageHandler) error {
+		if fn == nil {
+			return errors.New("cell provider function cannot be nil")
+		}
+		h.cellProviderForType = fn
+		return nil
+	}
+}
+
+func NewMessageHandler(opts ...Option) (*MessageHandler, error) {
+	h := &MessageHandler{}
+	for _, opt := range opts {
+		if err := opt(h); err != nil {
+			return nil, err
+		}
+	}
+	var errs []string
+	if h.q == nil {
+		errs = append(errs, "queue producer is required")
+	}
+	if h.deploymentStore == nil {
+		errs = append(errs, "deployment store is required")
+	}
+	if h.domainStore == nil {
+		errs = append(errs, "domain store is required")
+	}
+	if h.cellStore == nil {
+		errs = append(errs, "cell store is required")
+	}
+	if h.cellProviderForType == nil {
+		errs = append(errs, "cell provider for type function is required")
+	}
+	if len(errs) > 0 {
+		return nil, errors.New(strings.Join(errs, ", "))
+	}
+	return h, nil
+}
+
+func (h MessageHandler) ReQueue(ctx context.Context, m Message, delay time.Duration) error {
+	return h.q.SendWithDelay(ctx, m, delay)
+}
+
+func (h MessageHandler) Handle(ctx context.Context, m Message) error {
+	log := logger.FromContext(ctx).With(
+		slog.String("envId", m.EnvId),
+	)
+
+	env, err := h.deploymentStore.GetEnv(m.EnvId)
+	if errors.Is(err, store.ErrEnvNotFound) {
+		log.Info("preview env was already deleted, no action needed")
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("error fetching env: %v", err)
+	}
+	if !env.IsPreview() {
+		return nil
+	}
+	log = log.With(slog.String("teamId", env.TeamId), slog.String("branch", env.PreviewBranch))
+
+	deployments, err := h.deploymentStore.GetForEnv(env.Id)
+	if err != nil {
+		return fmt.Errorf("error fetching deployments: %v", err)
+	}
+	lastDeployedAt := env.CreatedAt
+	for _, d := range deployments {
+		if d.CreatedAt.After(lastDeployedAt) {
+			lastDeployedAt = d.CreatedAt
+		}
+	}
+	if expiresAt := env.PreviewExpiresAt(lastDeployedAt); time.Now().Before(expiresAt) {
+		log.Info("preview env is still in use", slog.Time("expiresAt", expiresAt))
+		return h.ReQueue(ctx, m, time.Until(expiresAt))
+	}
+
+	log.Info("tearing down preview env", slog.Time("lastDeployedAt", lastDeployedAt))
+	if err := deployment.DestroyEnv(ctx, h.deploymentStore, h.domainStore, h.cellStore, h.cellProviderForType, env); err != nil {
+		log.Error("error tearing down preview env", slog.Any("error", err))
+		return err
+	}
+	if err := h.deploymentStore.DeleteEnv(env.Id); err != nil {
+		return fmt.Errorf("error deleting env: %v", err)
+	}
+	log.Info("preview env torn down")
+	return nil
+}
@@ -28,6 +28,8 @@ type ServerStats struct {
 type AdvanceDeploymentResult struct {
 	Status       store.DeploymentStatus
 	StatusReason string
+	// K8sEvents explain the status, e.g. the warnings about the pods of a deployment that failed
+	K8sEvents []store.K8sEvent
 }
 
 type ServerStatsResult struct {
@@ -70,6 +72,75 @@ func processDeploymentLogsOptions(opts ...DeploymentLogsOption) DeploymentLogsOp
 	return options
 }
 
+type CronJobRunStatus string
+
+const (
+	CronJobRunStatusRunning   CronJobRunStatus = "running"
+	CronJobRunStatusSucceeded CronJobRunStatus = "succeeded"
+	CronJobRunStatusFailed    CronJobRunStatus = "failed"
+)
+
+// CronJobRun is a single scheduled run of a cron job
+type CronJobRun struct {
+	Name         string
+	StartedAt    time.Time
+	CompletedAt  *time.Time
+	Status       CronJobRunStatus
+	StatusReason string
+}
+
+// ProcessReplicas is how many replicas of a process are running and how many it should have.
+// For processes that autoscale, Desired is what the HorizontalPodAutoscaler currently wants.
+type ProcessReplicas struct {
+	Process     string
+	Current     int
+	Desired     int
+	Autoscaling *store.Autoscaling
+}
+
+// Endpoint is where one of an app's external ports can be reached from outside the cell
+type Endpoint struct {
+	Name  string // of the external port
+	Proto string
+	// Address is a URL for http and https ports, and host:port for grpc and tcp ports. It is empty while the cell is still assigning one.
+	Address string
+}
+
+type PlannedObjectAction string
+
+const (
+	PlannedObjectActionCreate    PlannedObjectAction = "create"
+	PlannedObjectActionUpdate    PlannedObjectAction = "update"
+	PlannedObjectActionDelete    PlannedObjectAction = "delete"
+	PlannedObjectActionUnchanged PlannedObjectAction = "unchanged"
+)
+
+// PlannedObject is what deploying would do to one of the objects a cell runs an app with
+type PlannedObject struct {
+	Kind   string // e.g. Deployment or HTTPRoute
+	Name   string
+	Action PlannedObjectAction
+	// Diff is a unified diff of the object's YAML, from what the cell has to what it would have. Env var values are masked.
+	Diff string
+}
+
+type DomainCertificateStatus string
+
+const (
+	DomainCertificateStatusPending DomainCertificateStatus = "pending" // not requested, e.g. because the domain isn't verified yet
+	DomainCertificateStatusIssuing DomainCertificateStatus = "issuing"
+	DomainCertificateStatusIssued  DomainCertificateStatus = "issued"
+	DomainCertificateStatusFailed  DomainCertificateStatus = "failed"
+)
+
+// DomainCertificate is the state of the TLS certificate for a custom domain
+type DomainCertificate struct {
+	Hostname     string
+	Status       DomainCertificateStatus
+	StatusReason string
+	ExpiresAt    *time.Time
+}
+
 type BuildImageOptions struct {
 	// CellId is the id of the cell to build the image on.
 	CellId string `validate:"required"`
@@ -91,8 +162,29 @@ type CellProvider interface {
 	ServerStats(ctx context.Context, cellId string) ([]ServerStats, error)
 	ServerStatsStream(ctx context.Context, cellId string, interval time.Duration) <-chan ServerStatsResult
 	AdvanceDeployment(ctx context.Context, cellId string, deployment *store.Deployment) (*AdvanceDeploymentResult, error)
+	// PlanDeployment returns what rolling out the deployment would create, update and delete on the cell, without changing anything
+	PlanDeployment(ctx context.Context, cellId string, deployment *store.Deployment) ([]PlannedObject, error)
 	DestroyDeployments(ctx context.Context, cellId string, deployments []store.Deployment) error
+	// DestroyVolumes deletes the volumes, and with them the data, of the apps and envs the deployments are for
+	DestroyVolumes(ctx context.Context, cellId string, deployments []store.Deployment) error
 	DeploymentLogs(ctx context.Context, cellId string, deployment *store.Deployment, opts ...DeploymentLogsOption) ([]LogEntry, error)
 	DeploymentLogsStream(ctx context.Context, cellId string, deployment *store.Deployment, opts ...DeploymentLogsOption) <-chan DeploymentLogsResult
 	BuildImage(ctx context.Context, opts BuildImageOptions) (*store.ImageArtifact, error)
+	// SyncCronJobs makes the cell's cron jobs for the deployment's app and env match cronJobs, running them with the deployment's image and env vars
+	SyncCronJobs(ctx context.Context, cellId string, deployment *store.Deployment, cronJobs []store.CronJob) error
+	// CronJobRuns returns the recent runs of a cron job, newest first
+	CronJobRuns(ctx context.Context, cellId string, cronJob store.CronJob) ([]CronJobRun, error)
+	CronJobRunLogs(ctx context.Context, cellId string, cronJob store.CronJob, runName string, opts ...DeploymentLogsOption) ([]LogEntry, error)
+	// ProcessReplicas returns the current and desired replica counts of each of the deployment's processes
+	ProcessReplicas(ctx context.Context, cellId string, deployment *store.Deployment) ([]ProcessReplicas, error)
+	// Endpoints returns where each of the deployment's external ports can be reached
+	Endpoints(ctx context.Context, cellId string, deployment *store.Deployment) ([]Endpoint, error)
+	// ServiceEnvVars returns the env vars that tell the deployment's containers how to reach the other apps of its env, given their running deployments
+	ServiceEnvVars(deployment *store.Deployment, siblings []store.Deployment) []store.EnvVar
+	// AppHostname is the hostname an app gets in an env on the cell. Custom domains are verified with a CNAME to it.
+	AppHostname(cellId string, app store.App, env store.Env) string
+	// SyncDomains makes the cell serve and issue certificates for its team's verified custom domains, and points the http and grpc routes of the deployment at the ones for its app and env. deployment may be nil if the app isn't running.
+	SyncDomains(ctx context.Context, cellId string, deployment *store.Deployment) error
+	// DomainCertificates returns the state of the certificate for each of the verified domains, in the same order
+	DomainCertificates(ctx context.Context, cellId string, domains []store.Domain) ([]DomainCertificate, error)
 }
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -57,11 +58,13 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -81,6 +84,7 @@ type TalosClusterCellProvider struct {
 	dnsProvider    dnsprovider.DNSProvider
 	cellStore      store.CellStore
 	serverStore    store.ServerStore
+	domainStore    store.DomainStore
 	tmpDirRoot     string
 	tracerProvider *trace.TracerProvider
 }
@@ -107,6 +111,12 @@ func WithServerStore(serverStore store.ServerStore) TalosClusterCellProviderOpti
 	}
 }
 
+func WithDomainStore(domainStore store.DomainStore) TalosClusterCellProviderOption {
+	return func(p *TalosClusterCellProvider) {
+		p.domainStore = domainStore
+	}
+}
+
 func WithTmpDirRoot(tmpDirRoot string) TalosClusterCellProviderOption {
 	return func(p *TalosClusterCellProvider) {
 		p.tmpDirRoot = tmpDirRoot
@@ -134,6 +144,9 @@ func NewTalosClusterCellProvider(opts ...TalosClusterCellProviderOption) (*Talos
 	if provider.serverStore == nil {
 		errs = append(errs, fmt.Errorf("must provide a valid server store"))
 	}
+	if provider.domainStore == nil {
+		errs = append(errs, fmt.Errorf("must provide a valid domain store"))
+	}
 	if provider.tmpDirRoot == "" {
 		errs = append(errs, fmt.Errorf("must provide a valid tmpDirRoot"))
 	}
@@ -440,6 +453,12 @@ func (p *TalosClusterCellProvider) setupClients(ctx context.Context, cellId stri
 	if err := gatewayv1.Install(scheme); err != nil {
 		return nil, fmt.Errorf("error adding gateway v1 scheme: %v", err)
 	}
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("error adding apps v1 scheme: %v", err)
+	}
+	if err := autoscalingv2.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("error adding autoscaling v2 scheme: %v", err)
+	}
 	ctrlClient, err := ctrlclient.New(restConfig, ctrlclient.Options{Scheme: scheme})
 	if err != nil {
 		return nil, fmt.Errorf("error creating controller-runtime client: %v", err)
@@ -560,6 +579,9 @@ func (p *TalosClusterCellProvider) Janitor(ctx context.Context, cellId string) e
 	if err := ensureLetsEncryptClusterIssuer(ctx, setup.ctrlClient, issuer); err != nil {
 		return fmt.Errorf("error ensuring letsencrypt cluster issuer: %v", err)
 	}
+	if err := ensureDomainClusterIssuer(ctx, setup.ctrlClient); err != nil {
+		return fmt.Errorf("error ensuring custom domain cluster issuer: %v", err)
+	}
 
 	// external-dns for setting A records
 	if err := ensureNamespaceWithLabels(ctx, setup.k8sClient, "external-dns", podSecurityLabels); err != nil {
@@ -637,8 +659,15 @@ func (p *TalosClusterCellProvider) Janitor(ctx context.Context, cellId string) e
 		}
 	}
 
-	// set up the gateway (requires istio, cert-manager, external-dns, metallb)
-	if err := p.createOrUpdateGateway(ctx, setup.k8sClient, setup.ctrlClient, cellId); err != nil {
+	// set up the gateway (requires istio, cert-manager, external-dns, metallb), along with the listeners and certificates of custom domains
+	domains, err := p.verifiedDomainsForCell(ctx, cell)
+	if err != nil {
+		return err
+	}
+	if err := ensureDomainCertificates(ctx, setup.ctrlClient, domains); err != nil {
+		return fmt.Errorf("error ensuring custom domain certificates: %v", err)
+	}
+	if err := p.createOrUpdateGateway(ctx, setup.k8sClient, setup.ctrlClient, cellId, domains); err != nil {
 		return fmt.Errorf("error ensuring gateway: %v", err)
 	}
 
@@ -900,9 +929,23 @@ func archiveRepository(sourceDir, destZip string) error {
 func (p *TalosClusterCellProvider) AdvanceDeployment(ctx context.Context, cellId string, deployment *store.Deployment) (*AdvanceDeploymentResult, error) {
 	switch deployment.Status {
 	case store.DeploymentStatusPending:
+		switch deployment.Type {
+		case store.DeploymentTypeScale:
+			return p.handlePendingScaleDeployment(ctx, cellId, deployment)
+		case store.DeploymentTypeRestart:
+			return p.handlePendingRestartDeployment(ctx, cellId, deployment)
+		}
 		return p.handlePendingDeployment(ctx, cellId, deployment)
+	case store.DeploymentStatusReleasing:
+		return p.handleReleasingDeployment(ctx, cellId, deployment)
 	case store.DeploymentStatusDeploying:
 		return p.handleDeployingDeployment(ctx, cellId, deployment)
+	case store.DeploymentStatusPromoting:
+		return p.handlePromotingDeployment(ctx, cellId, deployment)
+	case store.DeploymentStatusAborting:
+		return p.handleAbortingDeployment(ctx, cellId, deployment)
+	case store.DeploymentStatusCanceling:
+		return p.handleCancelingDeployment(ctx, cellId, deployment)
 	}
 	return nil, nil
 }
@@ -914,64 +957,135 @@ func (p *TalosClusterCellProvider) DestroyDeployments(ctx context.Context, cellI
 	}
 
 	for _, deployment := range deployments {
-		k8sDeployment, err := clientset.AppsV1().Deployments(deployment.Env.Name).Get(ctx, deployment.App.Name, metav1.GetOptions{})
-		if err != nil {
-			if k8serrors.IsNotFound(err) {
-				continue // job's done
+		for _, process := range deployment.Processes() {
+			for _, name := range []string{processResourceName(&deployment, process), canaryResourceName(&deployment, process)} {
+				k8sDeployment, err := clientset.AppsV1().Deployments(deployment.Env.Name).Get(ctx, name, metav1.GetOptions{})
+				if err != nil {
+					if k8serrors.IsNotFound(err) {
+						continue // job's done
+					}
+					return fmt.Errorf("error getting deployment: %v", err)
+				}
+				if err := validateK8sDeploymentMatch(k8sDeployment, &deployment); err != nil {
+					if _, ok := err.(ErrDeploymentIdMismatch); ok {
+						continue // deployment in k8s is more recent, so we don't need to delete it
+					}
+					return err
+				}
+				if err := clientset.AppsV1().Deployments(deployment.Env.Name).Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
+					return fmt.Errorf("error deleting deployment: %v", err)
+				}
+				if err := clientset.AutoscalingV2().HorizontalPodAutoscalers(deployment.Env.Name).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+					return fmt.Errorf("error deleting hpa: %v", err)
+				}
 			}
-			return fmt.Errorf("error getting deployment: %v", err)
 		}
-		if err := validateK8sDeploymentMatch(k8sDeployment, &deployment); err != nil {
-			if _, ok := err.(ErrDeploymentIdMismatch); ok {
-				continue // deployment in k8s is more recent, so we don't need to delete it
+		// the services of tcp ports hold on to an address of the cell's pool
+		tcpServices, err := clientset.CoreV1().Services(deployment.Env.Name).List(ctx, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("onmetal.dev/app=%s,onmetal.dev/external-port", deployment.App.Name),
+		})
+		if err != nil {
+			return fmt.Errorf("error listing tcp services: %v", err)
+		}
+		for _, service := range tcpServices.Items {
+			if err := clientset.CoreV1().Services(deployment.Env.Name).Delete(ctx, service.Name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+				return fmt.Errorf("error deleting tcp service: %v", err)
 			}
-			return err
 		}
-		if err := clientset.AppsV1().Deployments(deployment.Env.Name).Delete(ctx, deployment.App.Name, metav1.DeleteOptions{}); err != nil {
-			return fmt.Errorf("error deleting deployment: %v", err)
+		// cron jobs run the app's image, so they go along with its deployments
+		if err := clientset.BatchV1().CronJobs(deployment.Env.Name).DeleteCollection(ctx, metav1.DeleteOptions{
+			PropagationPolicy: ptr.To(metav1.DeletePropagationBackground),
+		}, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("onmetal.dev/app=%s,onmetal.dev/cronjob", deployment.App.Name),
+		}); err != nil {
+			return fmt.Errorf("error deleting cron jobs: %v", err)
 		}
 	}
 	return nil
 }
 
+// processResourceName encodes our convention for naming the k8s deployment and service of a process.
+// The web process keeps the app's name so that apps that predate process types keep their existing k8s resources.
+func processResourceName(deployment *store.Deployment, process store.Process) string {
+	if process.Name == store.DefaultProcessName {
+		return deployment.App.Name
+	}
+	return fmt.Sprintf("%s-%s", deployment.App.Name, process.Name)
+}
+
+// processLabels are the labels for a process's k8s resources. "app" is the selector we have always used, the others let us find all of an app's processes.
+func processLabels(deployment *store.Deployment, process store.Process) map[string]string {
+	return map[string]string{
+		"app":                 processResourceName(deployment, process),
+		"onmetal.dev/app":     deployment.App.Name,
+		"onmetal.dev/process": process.Name,
+	}
+}
+
 // hostnameForDeployment encodes our convention for hostnames for deployments.
 func hostnameForDeployment(cellId string, deployment *store.Deployment) string {
-	return fmt.Sprintf("%s-%s.%s", deployment.App.Name, deployment.Env.Name, cellHostname(cellId))
+	return appHostname(cellId, deployment.App.Name, deployment.Env.Name)
 }
 
-// httpRoutesForDeployment returns the HTTPRoutes that should be created for a given deployment
-func httpRoutesForDeployment(cellId string, deployment *store.Deployment) ([]gatewayv1.HTTPRoute, error) {
-	httpRoutes := []gatewayv1.HTTPRoute{}
+func appHostname(cellId string, appName string, envName string) string {
+	return fmt.Sprintf("%s-%s.%s", appName, envName, cellHostname(cellId))
+}
 
-	for _, port := range deployment.AppSettings.ExternalPorts.Data() {
-		var containerPort *store.Port
-		for i, p := range deployment.AppSettings.Ports.Data() {
-			if p.Name == port.PortName {
-				containerPort = &deployment.AppSettings.Ports.Data()[i]
-				break
-			}
-		}
-		if containerPort == nil {
-			return nil, fmt.Errorf("external port references container port %s but it doesn't exist. %#v %#v", port.PortName, deployment.AppSettings.Ports.Data(), deployment.AppSettings.ExternalPorts.Data())
+func (p *TalosClusterCellProvider) AppHostname(cellId string, app store.App, env store.Env) string {
+	return appHostname(cellId, app.Name, env.Name)
+}
+
+// hostnamesForDeployment are the hostnames the routes of a deployment match: our hostname for it, and the verified custom domains of its app and env
+func hostnamesForDeployment(cellId string, deployment *store.Deployment, domains []store.Domain) []gatewayv1.Hostname {
+	hostnames := []gatewayv1.Hostname{gatewayv1.Hostname(hostnameForDeployment(cellId, deployment))}
+	for _, domain := range domains {
+		if domain.Verified() && domain.AppId == deployment.AppId && domain.EnvId == deployment.EnvId {
+			hostnames = append(hostnames, gatewayv1.Hostname(domain.Hostname))
 		}
+	}
+	return hostnames
+}
+
+// processForExternalPort finds the process that owns the container port an external port references
+func processForExternalPort(deployment *store.Deployment, port store.ExternalPort) (store.Process, error) {
+	process, ok := lo.Find(deployment.Processes(), func(p store.Process) bool {
+		return lo.ContainsBy(p.Ports, func(p store.Port) bool { return p.Name == port.PortName })
+	})
+	if !ok {
+		return store.Process{}, fmt.Errorf("external port references container port %s but it doesn't exist. %#v %#v", port.PortName, deployment.AppSettings.AllPorts(), deployment.AppSettings.ExternalPorts.Data())
+	}
+	return process, nil
+}
+
+// httpRoutesForDeployment returns the HTTPRoutes that should be created for the http and https external ports of a deployment.
+// newProcesses are the processes a canary adds, see backendRefsForPort
+func httpRoutesForDeployment(cellId string, deployment *store.Deployment, domains []store.Domain, newProcesses []string) ([]gatewayv1.HTTPRoute, error) {
+	httpRoutes := []gatewayv1.HTTPRoute{}
+	hostnames := hostnamesForDeployment(cellId, deployment, domains)
+	for _, port := range deployment.AppSettings.ExternalPorts.Data() {
 		var gatewayPort *gatewayv1.PortNumber
 		switch port.Proto {
 		case "http":
 			gatewayPort = ptr.To(gatewayv1.PortNumber(80))
 		case "https":
 			gatewayPort = ptr.To(gatewayv1.PortNumber(443))
+		case "tcp", "grpc":
+			continue // see talosports.go
 		default:
 			return nil, fmt.Errorf("unsupported protocol in external port %s: %s", port.Name, port.Proto)
 		}
+		// route to the service of whichever process owns the container port
+		process, err := processForExternalPort(deployment, port)
+		if err != nil {
+			return nil, err
+		}
 		httpRoutes = append(httpRoutes, gatewayv1.HTTPRoute{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      fmt.Sprintf("%s-%s", deployment.App.Name, port.PortName),
 				Namespace: deployment.Env.Name,
 			},
 			Spec: gatewayv1.HTTPRouteSpec{
-				Hostnames: []gatewayv1.Hostname{
-					gatewayv1.Hostname(hostnameForDeployment(cellId, deployment)),
-				},
+				Hostnames: hostnames,
 				CommonRouteSpec: gatewayv1.CommonRouteSpec{
 					ParentRefs: []gatewayv1.ParentReference{
 						{
@@ -985,19 +1099,9 @@ func httpRoutesForDeployment(cellId string, deployment *store.Deployment) ([]gat
 				},
 				Rules: []gatewayv1.HTTPRouteRule{
 					{
-						BackendRefs: []gatewayv1.HTTPBackendRef{
-							{
-								BackendRef: gatewayv1.BackendRef{
-									BackendObjectReference: gatewayv1.BackendObjectReference{
-										Group: lo.ToPtr(gatewayv1.Group("")),
-										Kind:  lo.ToPtr(gatewayv1.Kind("Service")),
-										Name:  gatewayv1.ObjectName(deployment.App.Name),
-										Port:  ptr.To(gatewayv1.PortNumber(port.Port)),
-									},
-									Weight: ptr.To(int32(1)),
-								},
-							},
-						},
+						BackendRefs: lo.Map(backendRefsForPort(deployment, process, port, newProcesses), func(b gatewayv1.BackendRef, _ int) gatewayv1.HTTPBackendRef {
+							return gatewayv1.HTTPBackendRef{BackendRef: b}
+						}),
 						Matches: []gatewayv1.HTTPRouteMatch{
 							{
 								Path: &gatewayv1.HTTPPathMatch{
@@ -1014,9 +1118,44 @@ func httpRoutesForDeployment(cellId string, deployment *store.Deployment) ([]gat
 	return httpRoutes, nil
 }
 
-// ensureHttpRoutesForDeployment ensures that the HTTPRoutes for a given deployment are created
-func ensureHttpRoutesForDeployment(ctx context.Context, ctrlClient ctrlclient.Client, cellId string, deployment *store.Deployment) error {
-	httpRoutes, err := httpRoutesForDeployment(cellId, deployment)
+// backendRefsForPort sends all of an external port's traffic to the service of the process that owns it.
+// While a deployment is a canary, the traffic is split between that service and the canary's by the canary's weight.
+// Processes the canary adds (newProcesses, see canaryNewProcesses) have no service of their own yet, so the canary's gets all of their traffic.
+func backendRefsForPort(deployment *store.Deployment, process store.Process, port store.ExternalPort, newProcesses []string) []gatewayv1.BackendRef {
+	backendRef := func(serviceName string, weight int) gatewayv1.BackendRef {
+		return gatewayv1.BackendRef{
+			BackendObjectReference: gatewayv1.BackendObjectReference{
+				Group: lo.ToPtr(gatewayv1.Group("")),
+				Kind:  lo.ToPtr(gatewayv1.Kind("Service")),
+				Name:  gatewayv1.ObjectName(serviceName),
+				Port:  ptr.To(gatewayv1.PortNumber(port.Port)),
+			},
+			Weight: ptr.To(int32(weight)),
+		}
+	}
+	if !deployment.InCanaryPhase() {
+		return []gatewayv1.BackendRef{backendRef(processResourceName(deployment, process), 1)}
+	}
+	if lo.Contains(newProcesses, process.Name) {
+		return []gatewayv1.BackendRef{backendRef(canaryResourceName(deployment, process), 1)}
+	}
+	return []gatewayv1.BackendRef{
+		backendRef(processResourceName(deployment, process), 100-deployment.CanaryWeight),
+		backendRef(canaryResourceName(deployment, process), deployment.CanaryWeight),
+	}
+}
+
+// ensureRoutesForDeployment ensures that the HTTPRoutes, GRPCRoutes and tcp services for a given deployment's external ports are created
+func (p *TalosClusterCellProvider) ensureRoutesForDeployment(ctx context.Context, ctrlClient ctrlclient.Client, cellId string, deployment *store.Deployment) error {
+	domains, err := p.domainStore.GetForAppEnv(ctx, deployment.AppId, deployment.EnvId)
+	if err != nil {
+		return fmt.Errorf("error fetching domains: %v", err)
+	}
+	newProcesses, err := canaryNewProcesses(ctx, ctrlClient, deployment)
+	if err != nil {
+		return err
+	}
+	httpRoutes, err := httpRoutesForDeployment(cellId, deployment, domains, newProcesses)
 	if err != nil {
 		return fmt.Errorf("error getting http routes for deployment: %v", err)
 	}
@@ -1025,24 +1164,27 @@ func ensureHttpRoutesForDeployment(ctx context.Context, ctrlClient ctrlclient.Cl
 			return fmt.Errorf("error creating or updating http route: %v", err)
 		}
 	}
-	return nil
+	grpcRoutes, err := grpcRoutesForDeployment(cellId, deployment, domains, newProcesses)
+	if err != nil {
+		return fmt.Errorf("error getting grpc routes for deployment: %v", err)
+	}
+	for _, grpcRoute := range grpcRoutes {
+		if err := createOrUpdateResource(ctx, ctrlClient, &grpcRoute); err != nil {
+			return fmt.Errorf("error creating or updating grpc route: %v", err)
+		}
+	}
+	return ensureTCPServicesForDeployment(ctx, ctrlClient, deployment)
 }
 
-// servicePortsForDeployment converts a deployment's container ports into k8s ServicePorts
-func servicePortsForDeployment(deployment *store.Deployment) ([]corev1.ServicePort, error) {
+// servicePortsForProcess converts a process's container ports into k8s ServicePorts
+func servicePortsForProcess(process store.Process) ([]corev1.ServicePort, error) {
 	servicePorts := []corev1.ServicePort{}
-	var protocol corev1.Protocol
-	switch deployment.AppSettings.Ports.Data()[0].Proto {
-	case "http":
-		protocol = corev1.ProtocolTCP
-	case "https":
-		protocol = corev1.ProtocolTCP
-	default:
-		return nil, fmt.Errorf("unsupported protocol in container port %s: %s", deployment.AppSettings.Ports.Data()[0].Name, deployment.AppSettings.Ports.Data()[0].Proto)
-	}
-	for _, port := range deployment.AppSettings.Ports.Data() {
-		fmt.Println("DEBUG SERVICE PORT", port.Name, port.Port)
-		servicePorts = append(servicePorts, corev1.ServicePort{
+	for _, port := range process.Ports {
+		protocol, err := getContainerPortProto(port)
+		if err != nil {
+			return nil, fmt.Errorf("unsupported protocol in container port %s: %s", port.Name, port.Proto)
+		}
+		servicePort := corev1.ServicePort{
 			Name:     port.Name,
 			Port:     int32(port.Port),
 			Protocol: protocol,
@@ -1050,26 +1192,45 @@ func servicePortsForDeployment(deployment *store.Deployment) ([]corev1.ServicePo
 				Type:   intstr.String,
 				StrVal: port.Name,
 			},
-		})
+		}
+		// tells the gateway to speak HTTP/2 without TLS to the container
+		if port.Proto == "grpc" {
+			servicePort.AppProtocol = ptr.To("kubernetes.io/h2c")
+		}
+		servicePorts = append(servicePorts, servicePort)
 	}
 	return servicePorts, nil
 }
 
-// ensureServiceForDeployment ensures that a Kubernetes Service is created or updated for the given deployment
-func ensureServiceForDeployment(ctx context.Context, ctrlClient ctrlclient.Client, deployment *store.Deployment) error {
-	serviceName := deployment.App.Name
+// ensureServiceForProcess ensures that a Kubernetes Service is created or updated for the given process of a deployment
+func ensureServiceForProcess(ctx context.Context, ctrlClient ctrlclient.Client, deployment *store.Deployment, process store.Process) error {
+	return ensureService(ctx, ctrlClient, deployment, process, processResourceName(deployment, process), processLabels(deployment, process))
+}
+
+// ensureService creates or updates a Service named serviceName that selects the pods labeled app=serviceName
+func ensureService(ctx context.Context, ctrlClient ctrlclient.Client, deployment *store.Deployment, process store.Process, serviceName string, labels map[string]string) error {
+	service, err := serviceForProcess(deployment, process, serviceName, labels)
+	if err != nil {
+		return err
+	}
+	if err := createOrUpdateResource(ctx, ctrlClient, service); err != nil {
+		return fmt.Errorf("error creating or updating service: %v", err)
+	}
+	return nil
+}
+
+// serviceForProcess builds the Service named serviceName that exposes a process's ports within the cell
+func serviceForProcess(deployment *store.Deployment, process store.Process, serviceName string, labels map[string]string) (*corev1.Service, error) {
 	namespace := deployment.Env.Name
-	servicePorts, err := servicePortsForDeployment(deployment)
+	servicePorts, err := servicePortsForProcess(process)
 	if err != nil {
-		return fmt.Errorf("error getting service ports for deployment: %v", err)
+		return nil, fmt.Errorf("error getting service ports for process %s: %v", process.Name, err)
 	}
-	service := &corev1.Service{
+	return &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      serviceName,
 			Namespace: namespace,
-			Labels: map[string]string{
-				"app": serviceName,
-			},
+			Labels:    labels,
 			Annotations: map[string]string{
 				"onmetal.dev/app-id":  deployment.App.Id,
 				"onmetal.dev/team-id": deployment.TeamId,
@@ -1082,11 +1243,7 @@ func ensureServiceForDeployment(ctx context.Context, ctrlClient ctrlclient.Clien
 			Ports: servicePorts,
 			Type:  corev1.ServiceTypeClusterIP,
 		},
-	}
-	if err := createOrUpdateResource(ctx, ctrlClient, service); err != nil {
-		return fmt.Errorf("error creating or updating service: %v", err)
-	}
-	return nil
+	}, nil
 }
 
 func (p *TalosClusterCellProvider) handlePendingDeployment(ctx context.Context, cellId string, deployment *store.Deployment) (*AdvanceDeploymentResult, error) {
@@ -1104,33 +1261,147 @@ func (p *TalosClusterCellProvider) handlePendingDeployment(ctx context.Context,
 	if err := copyImagePullSecretToNamespace(ctx, ctrlClient, registryNamespace, deployment.Env.Name); err != nil {
 		return nil, fmt.Errorf("error copying image pull secret to namespace: %v", err)
 	}
+	if err := ensureVolumes(ctx, k8sClient, deployment); err != nil {
+		return nil, fmt.Errorf("error ensuring volumes: %v", err)
+	}
+
+	// at this point we should create or update the services. Processes without ports (e.g. workers) don't get one.
+	// A canary gets services of its own and leaves the ones of the deployment it is being compared to alone.
+	for _, process := range deployment.Processes() {
+		if len(process.Ports) == 0 {
+			continue
+		}
+		ensure := ensureServiceForProcess
+		if deployment.InCanaryPhase() {
+			ensure = ensureCanaryServiceForProcess
+		}
+		if err := ensure(ctx, ctrlClient, deployment, process); err != nil {
+			return nil, fmt.Errorf("error ensuring service for deployment: %v", err)
+		}
+	}
 
-	// at this point we should create or update the service
-	if err := ensureServiceForDeployment(ctx, ctrlClient, deployment); err != nil {
-		return nil, fmt.Errorf("error ensuring service for deployment: %v", err)
+	// ensure the routes of the external ports
+	if err := p.ensureRoutesForDeployment(ctx, ctrlClient, cellId, deployment); err != nil {
+		return nil, fmt.Errorf("error ensuring routes for deployment: %v", err)
 	}
 
-	// ensure the http routes
-	if err := ensureHttpRoutesForDeployment(ctx, ctrlClient, cellId, deployment); err != nil {
-		return nil, fmt.Errorf("error ensuring http routes for deployment: %v", err)
+	// the release command has to succeed before we roll out, see handleReleasingDeployment
+	if hasReleasePhase(deployment) {
+		log.Info("creating release job")
+		if err := ensureReleaseJob(ctx, k8sClient, deployment); err != nil {
+			return nil, fmt.Errorf("error ensuring release job: %v", err)
+		}
+		return &AdvanceDeploymentResult{
+			Status: store.DeploymentStatusReleasing,
+		}, nil
+	}
+
+	return rolloutDeployment(ctx, k8sClient, deployment)
+}
+
+// rolloutDeployment creates or updates a k8s deployment for each of the app's processes.
+// Canaries get k8s deployments of their own, see rolloutCanary.
+func rolloutDeployment(ctx context.Context, k8sClient *kubernetes.Clientset, deployment *store.Deployment) (*AdvanceDeploymentResult, error) {
+	log := logger.FromContext(ctx)
+	if deployment.InCanaryPhase() {
+		return rolloutCanary(ctx, k8sClient, deployment)
 	}
+	if healthCheck := deployment.AppSettings.HealthCheck.Data(); healthCheck != nil {
+		if !lo.ContainsBy(deployment.AppSettings.AllPorts(), func(p store.Port) bool { return p.Name == healthCheck.PortName }) {
+			return nil, fmt.Errorf("health check references container port %s but it doesn't exist", healthCheck.PortName)
+		}
+	}
+
+	processes := deployment.Processes()
+	for _, process := range processes {
+		k8sDeployment, err := k8sDeploymentForProcess(deployment, process)
+		if err != nil {
+			return nil, fmt.Errorf("error building deployment for process %s: %v", process.Name, err)
+		}
 
-	limits, requests, err := getResourceLimits(deployment.AppSettings.Resources.Data())
+		// Check if the deployment already exists
+		existing, err := k8sClient.AppsV1().Deployments(deployment.Env.Name).Get(ctx, k8sDeployment.Name, metav1.GetOptions{})
+		if err != nil {
+			if !k8serrors.IsNotFound(err) {
+				return nil, fmt.Errorf("error checking existing deployment: %v", err)
+			}
+			// Deployment doesn't exist, create it
+			log.Info("creating deployment", slog.String("process", process.Name))
+			_, err = k8sClient.AppsV1().Deployments(deployment.Env.Name).Create(ctx, k8sDeployment, metav1.CreateOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("error creating deployment: %v", err)
+			}
+		} else {
+			// Deployment exists, update it. If the process autoscales its HPA owns the replica count.
+			keepAutoscaledReplicas(k8sDeployment, existing, process)
+			log.Info("updating deployment", slog.String("process", process.Name), slog.String("image", k8sDeployment.Spec.Template.Spec.Containers[0].Image))
+			_, err = k8sClient.AppsV1().Deployments(deployment.Env.Name).Update(ctx, k8sDeployment, metav1.UpdateOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("error updating deployment: %v", err)
+			}
+		}
+	}
+
+	if err := ensureAutoscaling(ctx, k8sClient, deployment, processes); err != nil {
+		return nil, fmt.Errorf("error ensuring autoscaling: %v", err)
+	}
+	// the routes no longer point at a canary, if there was one, so it can go
+	if err := deleteCanary(ctx, k8sClient, deployment); err != nil {
+		return nil, fmt.Errorf("error deleting canary: %v", err)
+	}
+	if err := deleteRemovedProcesses(ctx, k8sClient, deployment, processes); err != nil {
+		return nil, fmt.Errorf("error deleting removed processes: %v", err)
+	}
+
+	return &AdvanceDeploymentResult{
+		Status: store.DeploymentStatusDeploying,
+	}, nil
+}
+
+// k8sDeploymentForProcess builds the k8s deployment that runs one of the app's processes
+func k8sDeploymentForProcess(deployment *store.Deployment, process store.Process) (*appsv1.Deployment, error) {
+	limits, requests, err := getResourceLimits(process.Resources)
 	if err != nil {
 		return nil, fmt.Errorf("error getting resource limits: %v", err)
 	}
 
-	ports, err := getContainerPorts(deployment.AppSettings)
+	ports, err := getContainerPorts(process.Ports)
 	if err != nil {
 		return nil, fmt.Errorf("error getting container ports: %v", err)
 	}
 
-	k8sDeployment := &appsv1.Deployment{
+	readinessProbe, livenessProbe := getProbes(deployment.AppSettings.HealthCheck.Data(), process.Ports)
+
+	var command []string
+	if process.Command != "" {
+		command = []string{"/bin/sh", "-c", process.Command}
+	}
+
+	// an autoscaled process starts out at its minimum, after which its HPA takes over
+	replicas := process.Replicas
+	if process.Autoscaling != nil {
+		replicas = process.Autoscaling.MinReplicas
+	}
+
+	// ReadWriteOnce volumes can't be mounted by the old and the new pod at the same time, so processes with volumes are replaced rather than rolled
+	podVolumes, volumeMounts := podVolumesForProcess(deployment, process)
+	strategy := appsv1.DeploymentStrategy{Type: appsv1.RollingUpdateDeploymentStrategyType}
+	if len(podVolumes) > 0 {
+		strategy = appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType}
+	}
+
+	// without a deadline set on the env, k8s gives a rollout 10 minutes to make progress
+	var progressDeadlineSeconds *int32
+	if deployment.Env.ProgressDeadlineSeconds > 0 {
+		progressDeadlineSeconds = ptr.To(int32(deployment.Env.ProgressDeadlineSeconds))
+	}
+
+	name := processResourceName(deployment, process)
+	labels := processLabels(deployment, process)
+	return &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: deployment.App.Name,
-			Labels: map[string]string{
-				"app": deployment.App.Name,
-			},
+			Name:   name,
+			Labels: labels,
 			Annotations: map[string]string{
 				"kubernetes.io/change-cause": fmt.Sprintf("deploy %s id %d", deployment.App.Name, deployment.Id),
 				"onmetal.dev/app-id":         deployment.App.Id,
@@ -1139,21 +1410,23 @@ func (p *TalosClusterCellProvider) handlePendingDeployment(ctx context.Context,
 			},
 		},
 		Spec: appsv1.DeploymentSpec{
-			Replicas: ptr.To(int32(deployment.Replicas)),
+			Replicas:                ptr.To(int32(replicas)),
+			Strategy:                strategy,
+			ProgressDeadlineSeconds: progressDeadlineSeconds,
+			// the selector is immutable, so it only uses the label every version of our deployments has had
 			Selector: &metav1.LabelSelector{
 				MatchLabels: map[string]string{
-					"app": deployment.App.Name,
+					"app": name,
 				},
 			},
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: map[string]string{
-						"app": deployment.App.Name,
-					},
+					Labels: labels,
 					Annotations: map[string]string{
 						"onmetal.dev/app-id":        deployment.App.Id,
 						"onmetal.dev/team-id":       deployment.TeamId,
 						"onmetal.dev/deployment-id": fmt.Sprintf("%d", deployment.Id),
+						"onmetal.dev/process":       process.Name,
 					},
 				},
 				Spec: corev1.PodSpec{
@@ -1162,41 +1435,148 @@ func (p *TalosClusterCellProvider) handlePendingDeployment(ctx context.Context,
 							Name: dockerconfigjsonSecretName,
 						},
 					},
+					Volumes: podVolumes,
 					Containers: []corev1.Container{
 						{
 							Resources: corev1.ResourceRequirements{
 								Limits:   limits,
 								Requests: requests,
 							},
-							Name:  deployment.App.Name,
-							Image: deployment.AppSettings.Artifact.Data().Image.Name(),
-							Ports: ports,
-							Env:   convertEnvVars(deployment.AppEnvVars.EnvVars.Data()),
+							Name:           name,
+							Image:          deployment.AppSettings.Artifact.Data().Image.Name(),
+							Command:        command,
+							Ports:          ports,
+							Env:            convertEnvVars(deployment.AppEnvVars.EnvVars.Data()),
+							ReadinessProbe: readinessProbe,
+							LivenessProbe:  livenessProbe,
+							VolumeMounts:   volumeMounts,
 						},
 					},
 				},
 			},
 		},
+	}, nil
+}
+
+// deleteRemovedProcesses deletes the k8s deployments and services of processes the app no longer has
+func deleteRemovedProcesses(ctx context.Context, k8sClient *kubernetes.Clientset, deployment *store.Deployment, processes store.Processes) error {
+	log := logger.FromContext(ctx)
+	listOptions := metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("onmetal.dev/app=%s", deployment.App.Name),
+	}
+	isRemoved := func(labels map[string]string) bool {
+		return !lo.ContainsBy(processes, func(p store.Process) bool { return p.Name == labels["onmetal.dev/process"] })
 	}
 
-	// Check if the deployment already exists
-	_, err = k8sClient.AppsV1().Deployments(deployment.Env.Name).Get(ctx, deployment.App.Name, metav1.GetOptions{})
+	k8sDeployments, err := k8sClient.AppsV1().Deployments(deployment.Env.Name).List(ctx, listOptions)
 	if err != nil {
-		if !k8serrors.IsNotFound(err) {
-			return nil, fmt.Errorf("error checking existing deployment: %v", err)
+		return fmt.Errorf("error listing deployments: %v", err)
+	}
+	for _, k8sDeployment := range k8sDeployments.Items {
+		if !isRemoved(k8sDeployment.Labels) {
+			continue
+		}
+		log.Info("deleting deployment of removed process", slog.String("process", k8sDeployment.Labels["onmetal.dev/process"]))
+		if err := k8sClient.AppsV1().Deployments(deployment.Env.Name).Delete(ctx, k8sDeployment.Name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("error deleting deployment: %v", err)
+		}
+	}
+
+	// processes that lost their ports don't need a service anymore either
+	services, err := k8sClient.CoreV1().Services(deployment.Env.Name).List(ctx, listOptions)
+	if err != nil {
+		return fmt.Errorf("error listing services: %v", err)
+	}
+	for _, service := range services.Items {
+		process, ok := lo.Find(processes, func(p store.Process) bool { return p.Name == service.Labels["onmetal.dev/process"] })
+		if ok && len(process.Ports) > 0 {
+			continue
+		}
+		if err := k8sClient.CoreV1().Services(deployment.Env.Name).Delete(ctx, service.Name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("error deleting service: %v", err)
+		}
+	}
+	return nil
+}
+
+// handlePendingScaleDeployment only patches the replica count (and our bookkeeping annotations) of the existing k8s deployments.
+// Leaving the pod templates untouched means k8s scales the current replica sets instead of rolling out new ones.
+func (p *TalosClusterCellProvider) handlePendingScaleDeployment(ctx context.Context, cellId string, deployment *store.Deployment) (*AdvanceDeploymentResult, error) {
+	return p.patchExistingDeployments(ctx, cellId, deployment, func(process store.Process, current *appsv1.Deployment) map[string]interface{} {
+		annotations := previousAnnotations(current, deployment.Type)
+		annotations["kubernetes.io/change-cause"] = fmt.Sprintf("scale %s id %d to %d replicas", deployment.App.Name, deployment.Id, process.Replicas)
+		annotations["onmetal.dev/deployment-id"] = fmt.Sprintf("%d", deployment.Id)
+		patch := map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": annotations,
+			},
+		}
+		// the replica count of an autoscaled process is up to its HPA
+		if process.Autoscaling == nil {
+			patch["spec"] = map[string]interface{}{
+				"replicas": process.Replicas,
+			}
+		}
+		return patch
+	})
+}
+
+// handlePendingRestartDeployment bumps an annotation on the pod templates, which is what `kubectl rollout restart` does.
+// This rolls all pods without changing the image or env vars.
+func (p *TalosClusterCellProvider) handlePendingRestartDeployment(ctx context.Context, cellId string, deployment *store.Deployment) (*AdvanceDeploymentResult, error) {
+	return p.patchExistingDeployments(ctx, cellId, deployment, func(process store.Process, current *appsv1.Deployment) map[string]interface{} {
+		annotations := previousAnnotations(current, deployment.Type)
+		annotations["kubernetes.io/change-cause"] = fmt.Sprintf("restart %s id %d", deployment.App.Name, deployment.Id)
+		annotations["onmetal.dev/deployment-id"] = fmt.Sprintf("%d", deployment.Id)
+		return map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": annotations,
+			},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"annotations": map[string]string{
+							"onmetal.dev/deployment-id": fmt.Sprintf("%d", deployment.Id),
+							"onmetal.dev/restarted-at":  deployment.CreatedAt.UTC().Format(time.RFC3339),
+						},
+					},
+				},
+			},
 		}
-		// Deployment doesn't exist, create it
-		log.Info("creating deployment")
-		_, err = k8sClient.AppsV1().Deployments(deployment.Env.Name).Create(ctx, k8sDeployment, metav1.CreateOptions{})
+	})
+}
+
+// patchExistingDeployments applies a merge patch to the k8s deployment of each of the app's processes.
+// If any process has no k8s deployment yet there is nothing to patch, so we fall back to a full deployment.
+func (p *TalosClusterCellProvider) patchExistingDeployments(ctx context.Context, cellId string, deployment *store.Deployment, patchForProcess func(process store.Process, current *appsv1.Deployment) map[string]interface{}) (*AdvanceDeploymentResult, error) {
+	log := logger.FromContext(ctx)
+	clientset, err := p.initializeK8sClientForCell(cellId)
+	if err != nil {
+		return nil, err
+	}
+
+	processes := deployment.Processes()
+	current := make([]*appsv1.Deployment, len(processes))
+	for i, process := range processes {
+		k8sDeployment, err := clientset.AppsV1().Deployments(deployment.Env.Name).Get(ctx, processResourceName(deployment, process), metav1.GetOptions{})
 		if err != nil {
-			return nil, fmt.Errorf("error creating deployment: %v", err)
+			if !k8serrors.IsNotFound(err) {
+				return nil, fmt.Errorf("error getting deployment: %v", err)
+			}
+			log.Info("no existing deployment to patch, creating it", slog.String("type", string(deployment.Type)), slog.String("process", process.Name))
+			return p.handlePendingDeployment(ctx, cellId, deployment)
 		}
-	} else {
-		// Deployment exists, update it
-		log.Info("updating deployment", slog.String("image", k8sDeployment.Spec.Template.Spec.Containers[0].Image))
-		_, err = k8sClient.AppsV1().Deployments(deployment.Env.Name).Update(ctx, k8sDeployment, metav1.UpdateOptions{})
+		current[i] = k8sDeployment
+	}
+
+	for i, process := range processes {
+		patchBytes, err := json.Marshal(patchForProcess(process, current[i]))
 		if err != nil {
-			return nil, fmt.Errorf("error updating deployment: %v", err)
+			return nil, fmt.Errorf("error marshaling patch: %v", err)
+		}
+		log.Info("patching deployment", slog.String("type", string(deployment.Type)), slog.String("process", process.Name), slog.Int("replicas", process.Replicas))
+		if _, err := clientset.AppsV1().Deployments(deployment.Env.Name).Patch(ctx, processResourceName(deployment, process), types.MergePatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+			return nil, fmt.Errorf("error patching deployment: %v", err)
 		}
 	}
 
@@ -1205,14 +1585,67 @@ func (p *TalosClusterCellProvider) handlePendingDeployment(ctx context.Context,
 	}, nil
 }
 
+// handleDeployingDeployment aggregates the status of every process: the deployment is running once all of them are, and fails as soon as any of them does
 func (p *TalosClusterCellProvider) handleDeployingDeployment(ctx context.Context, cellId string, deployment *store.Deployment) (*AdvanceDeploymentResult, error) {
 	clientset, err := p.initializeK8sClientForCell(cellId)
 	if err != nil {
 		return nil, err
 	}
 
+	processes := deployment.Processes()
+	running := 0
+	for _, process := range processes {
+		name := processResourceName(deployment, process)
+		if deployment.InCanaryPhase() {
+			name = canaryResourceName(deployment, process)
+		}
+		result, err := p.processDeploymentStatus(ctx, clientset, deployment, name)
+		if err != nil {
+			return nil, fmt.Errorf("error getting status of process %s: %v", process.Name, err)
+		}
+		switch result.Status {
+		case store.DeploymentStatusStopped:
+			return result, nil
+		case store.DeploymentStatusFailed:
+			if len(processes) > 1 {
+				result.StatusReason = fmt.Sprintf("process %s: %s", process.Name, result.StatusReason)
+			}
+			// the events only explain the failure, so not getting them doesn't change the outcome
+			if result.K8sEvents, err = warningEvents(ctx, clientset, deployment, name); err != nil {
+				logger.FromContext(ctx).Warn("error getting events of failed process", slog.String("process", process.Name), slog.Any("error", err))
+			}
+			if deployment.InCanaryPhase() {
+				// a failed canary is rolled back right away so that it stops receiving traffic
+				if err := p.teardownCanary(ctx, cellId, deployment); err != nil {
+					return nil, fmt.Errorf("error tearing down failed canary: %v", err)
+				}
+				result.StatusReason = fmt.Sprintf("canary failed and was rolled back: %s", result.StatusReason)
+			}
+			return result, nil
+		case store.DeploymentStatusRunning:
+			running++
+		}
+	}
+	if running == len(processes) {
+		if deployment.InCanaryPhase() {
+			return &AdvanceDeploymentResult{
+				Status:       store.DeploymentStatusCanary,
+				StatusReason: fmt.Sprintf("serving %d%% of traffic", deployment.CanaryWeight),
+			}, nil
+		}
+		return &AdvanceDeploymentResult{
+			Status: store.DeploymentStatusRunning,
+		}, nil
+	}
+	return &AdvanceDeploymentResult{
+		Status: store.DeploymentStatusDeploying,
+	}, nil
+}
+
+// processDeploymentStatus checks on the k8s deployment of a single process, named name
+func (p *TalosClusterCellProvider) processDeploymentStatus(ctx context.Context, clientset *kubernetes.Clientset, deployment *store.Deployment, name string) (*AdvanceDeploymentResult, error) {
 	// get the deployment
-	k8sDeployment, err := clientset.AppsV1().Deployments(deployment.Env.Name).Get(ctx, deployment.App.Name, metav1.GetOptions{})
+	k8sDeployment, err := clientset.AppsV1().Deployments(deployment.Env.Name).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("error getting deployment: %v", err)
 	}
@@ -1319,26 +1752,16 @@ func (p *TalosClusterCellProvider) DeploymentLogs(ctx context.Context, cellId st
 	}
 
 	ns := deployment.Env.Name
-	k8sDeployment, err := clientset.AppsV1().Deployments(ns).Get(ctx, deployment.App.Name, metav1.GetOptions{})
+	pods, err := podsForDeployment(ctx, clientset, deployment, false)
 	if err != nil {
-		return nil, fmt.Errorf("error getting deployment: %v", err)
-	}
-	if err := validateK8sDeploymentMatch(k8sDeployment, deployment); err != nil {
 		return nil, err
 	}
 
-	pods, err := clientset.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{
-		LabelSelector: metav1.FormatLabelSelector(k8sDeployment.Spec.Selector),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("error listing pods: %v", err)
-	}
-
 	var allLogs []LogEntry
 	var mu sync.Mutex
 	var wg sync.WaitGroup
 
-	for _, pod := range pods.Items {
+	for _, pod := range pods {
 		wg.Add(1)
 		go func(pod corev1.Pod) {
 			defer wg.Done()
@@ -1433,31 +1856,24 @@ func (p *TalosClusterCellProvider) DeploymentLogsStream(ctx context.Context, cel
 			return
 		}
 
-		ns := deployment.Env.Name
-		k8sDeployment, err := clientset.AppsV1().Deployments(ns).Get(ctx, deployment.App.Name, metav1.GetOptions{})
-		if err != nil {
-			returnError = fmt.Errorf("error getting deployment: %v", err)
-			return
-		}
-		if err := validateK8sDeploymentMatch(k8sDeployment, deployment); err != nil {
-			// if ErrDeploymentIdMismatch, we're fine since we want all logs across new/old deployments
-			if _, ok := err.(ErrDeploymentIdMismatch); !ok {
+		// the release command runs before the rollout, so its logs come first
+		if hasReleasePhase(deployment) {
+			if err := streamReleaseJobLogs(ctx, clientset, deployment, options, logs); err != nil {
 				returnError = err
 				return
 			}
 		}
 
-		pods, err := clientset.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{
-			LabelSelector: metav1.FormatLabelSelector(k8sDeployment.Spec.Selector),
-		})
+		// we want all logs across new/old deployments, so superseded deployments are fine here
+		pods, err := podsForDeployment(ctx, clientset, deployment, true)
 		if err != nil {
-			returnError = fmt.Errorf("error listing pods: %v", err)
+			returnError = err
 			return
 		}
 
 		var wg sync.WaitGroup
 		var errLock sync.Mutex
-		for _, pod := range pods.Items {
+		for _, pod := range pods {
 			wg.Add(1)
 			go func(pod corev1.Pod) {
 				defer wg.Done()
@@ -1472,48 +1888,10 @@ func (p *TalosClusterCellProvider) DeploymentLogsStream(ctx context.Context, cel
 					podLogOptions.SinceTime = &metav1.Time{Time: time.Now().Add(-1 * time.Hour)}
 				}
 
-				req := clientset.CoreV1().Pods(ns).GetLogs(pod.Name, podLogOptions)
-
-				podLogs, err := req.Stream(ctx)
-				if err != nil {
+				if err := streamPodLogs(ctx, clientset, pod, podLogOptions, logs); err != nil {
 					errLock.Lock()
-					returnError = fmt.Errorf("error fetching logs for pod %s: %v", pod.Name, err)
+					returnError = err
 					errLock.Unlock()
-					return
-				}
-				defer podLogs.Close()
-
-				r := bufio.NewReader(podLogs)
-				for {
-					bytes, err := r.ReadBytes('\n')
-					if len(bytes) > 0 {
-						logLine := string(bytes)
-						parts := strings.SplitN(logLine, " ", 2)
-						if len(parts) < 2 {
-							continue
-						}
-						timestamp, err := time.Parse(time.RFC3339Nano, parts[0])
-						if err != nil {
-							timestamp = time.Now()
-						}
-
-						logs <- DeploymentLogsResult{
-							Annotations: pod.Annotations,
-							Logs: []LogEntry{{
-								Timestamp: timestamp,
-								Message:   parts[1],
-							}},
-						}
-					}
-					if err != nil {
-						if err != io.EOF {
-							errLock.Lock()
-							returnError = fmt.Errorf("error reading logs for pod %s: %v", pod.Name, err)
-							errLock.Unlock()
-							return
-						}
-						break
-					}
 				}
 			}(pod)
 		}
@@ -1523,6 +1901,76 @@ func (p *TalosClusterCellProvider) DeploymentLogsStream(ctx context.Context, cel
 	return logs
 }
 
+// podsForDeployment lists the pods of every process of a deployment.
+// If allowSuperseded is true, pods of k8s deployments that have since moved on to a newer deployment are included.
+func podsForDeployment(ctx context.Context, clientset *kubernetes.Clientset, deployment *store.Deployment, allowSuperseded bool) ([]corev1.Pod, error) {
+	var pods []corev1.Pod
+	for _, process := range deployment.Processes() {
+		name := processResourceName(deployment, process)
+		if deployment.InCanaryPhase() {
+			name = canaryResourceName(deployment, process)
+		}
+		k8sDeployment, err := clientset.AppsV1().Deployments(deployment.Env.Name).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting deployment: %v", err)
+		}
+		if err := validateK8sDeploymentMatch(k8sDeployment, deployment); err != nil {
+			if _, ok := err.(ErrDeploymentIdMismatch); !ok || !allowSuperseded {
+				return nil, err
+			}
+		}
+
+		processPods, err := clientset.CoreV1().Pods(deployment.Env.Name).List(ctx, metav1.ListOptions{
+			LabelSelector: metav1.FormatLabelSelector(k8sDeployment.Spec.Selector),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error listing pods: %v", err)
+		}
+		pods = append(pods, processPods.Items...)
+	}
+	return pods, nil
+}
+
+// streamPodLogs follows the logs of a pod, sending each line on logs until the stream ends
+func streamPodLogs(ctx context.Context, clientset *kubernetes.Clientset, pod corev1.Pod, podLogOptions *corev1.PodLogOptions, logs chan<- DeploymentLogsResult) error {
+	req := clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, podLogOptions)
+	podLogs, err := req.Stream(ctx)
+	if err != nil {
+		return fmt.Errorf("error fetching logs for pod %s: %v", pod.Name, err)
+	}
+	defer podLogs.Close()
+
+	r := bufio.NewReader(podLogs)
+	for {
+		bytes, err := r.ReadBytes('\n')
+		if len(bytes) > 0 {
+			logLine := string(bytes)
+			parts := strings.SplitN(logLine, " ", 2)
+			if len(parts) < 2 {
+				continue
+			}
+			timestamp, err := time.Parse(time.RFC3339Nano, parts[0])
+			if err != nil {
+				timestamp = time.Now()
+			}
+
+			logs <- DeploymentLogsResult{
+				Annotations: pod.Annotations,
+				Logs: []LogEntry{{
+					Timestamp: timestamp,
+					Message:   parts[1],
+				}},
+			}
+		}
+		if err != nil {
+			if err != io.EOF {
+				return fmt.Errorf("error reading logs for pod %s: %v", pod.Name, err)
+			}
+			return nil
+		}
+	}
+}
+
 func (p *TalosClusterCellProvider) initializeK8sClientForCell(cellId string) (*kubernetes.Clientset, error) {
 	cell, err := p.cellStore.Get(cellId)
 	if err != nil {
@@ -1673,9 +2121,8 @@ func getResourceLimits(resources store.Resources) (corev1.ResourceList, corev1.R
 	return limits, requests, nil
 }
 
-// getContainerPorts converts AppSettings ports to Kubernetes container ports
-func getContainerPorts(appSettings store.AppSettings) ([]corev1.ContainerPort, error) {
-	ports := appSettings.Ports.Data()
+// getContainerPorts converts a process's ports to Kubernetes container ports
+func getContainerPorts(ports store.Ports) ([]corev1.ContainerPort, error) {
 	containerPorts := make([]corev1.ContainerPort, len(ports))
 	for i, port := range ports {
 		proto, err := getContainerPortProto(port)
@@ -1691,9 +2138,56 @@ func getContainerPorts(appSettings store.AppSettings) ([]corev1.ContainerPort, e
 	return containerPorts, nil
 }
 
+// the k8s defaults for the health check fields that are left at zero. We set them ourselves so the probes we render match what k8s stores.
+const (
+	defaultProbePeriodSeconds    = 10
+	defaultProbeFailureThreshold = 3
+)
+
+// getProbes converts an app's health check into readiness and liveness probes for a process.
+// Both are nil if no health check is configured or if the health check's port belongs to another process.
+func getProbes(healthCheck *store.HealthCheck, ports store.Ports) (*corev1.Probe, *corev1.Probe) {
+	if healthCheck == nil {
+		return nil, nil
+	}
+	port, ok := lo.Find(ports, func(p store.Port) bool { return p.Name == healthCheck.PortName })
+	if !ok {
+		return nil, nil
+	}
+	periodSeconds := healthCheck.PeriodSeconds
+	if periodSeconds == 0 {
+		periodSeconds = defaultProbePeriodSeconds
+	}
+	failureThreshold := healthCheck.FailureThreshold
+	if failureThreshold == 0 {
+		failureThreshold = defaultProbeFailureThreshold
+	}
+	newProbe := func() *corev1.Probe {
+		var handler corev1.ProbeHandler
+		switch {
+		case healthCheck.Command != "":
+			handler.Exec = &corev1.ExecAction{Command: []string{"/bin/sh", "-c", healthCheck.Command}}
+		case port.Proto == "tcp":
+			handler.TCPSocket = &corev1.TCPSocketAction{Port: intstr.FromString(port.Name)}
+		default:
+			handler.HTTPGet = &corev1.HTTPGetAction{
+				Path: healthCheck.Path,
+				Port: intstr.FromString(port.Name),
+			}
+		}
+		return &corev1.Probe{
+			ProbeHandler:        handler,
+			InitialDelaySeconds: int32(healthCheck.InitialDelaySeconds),
+			PeriodSeconds:       int32(periodSeconds),
+			FailureThreshold:    int32(failureThreshold),
+		}
+	}
+	return newProbe(), newProbe()
+}
+
 func getContainerPortProto(port store.Port) (corev1.Protocol, error) {
 	switch port.Proto {
-	case "http":
+	case "http", "tcp", "grpc":
 		return corev1.ProtocolTCP, nil
 	}
 	return "", fmt.Errorf("invalid port protocol: %s", port.Proto)
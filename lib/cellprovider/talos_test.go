@@ -0,0 +1,87 @@
+package cellprovider
+
+import (
+	"testing"
+
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestGetProbes(t *testing.T) {
+	ports := store.Ports{{Name: "http", Port: 8080, Proto: "http"}, {Name: "postgres", Port: 5432, Proto: "tcp"}}
+	testCases := []struct {
+		name          string
+		healthCheck   *store.HealthCheck
+		expectedProbe *corev1.Probe
+	}{
+		{
+			name:          "no health check",
+			healthCheck:   nil,
+			expectedProbe: nil,
+		},
+		{
+			name:          "port of another process",
+			healthCheck:   &store.HealthCheck{Path: "/healthz", PortName: "admin"},
+			expectedProbe: nil,
+		},
+		{
+			name:        "http",
+			healthCheck: &store.HealthCheck{Path: "/healthz", PortName: "http", InitialDelaySeconds: 20, PeriodSeconds: 5, FailureThreshold: 6},
+			expectedProbe: &corev1.Probe{
+				ProbeHandler:        corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromString("http")}},
+				InitialDelaySeconds: 20,
+				PeriodSeconds:       5,
+				FailureThreshold:    6,
+			},
+		},
+		{
+			name:        "tcp",
+			healthCheck: &store.HealthCheck{PortName: "postgres", InitialDelaySeconds: 5, PeriodSeconds: 30, FailureThreshold: 1},
+			expectedProbe: &corev1.Probe{
+				ProbeHandler:        corev1.ProbeHandler{TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromString("postgres")}},
+				InitialDelaySeconds: 5,
+				PeriodSeconds:       30,
+				FailureThreshold:    1,
+			},
+		},
+		{
+			name:        "exec",
+			healthCheck: &store.HealthCheck{PortName: "postgres", Command: "pg_isready -U app", PeriodSeconds: 15, FailureThreshold: 2},
+			expectedProbe: &corev1.Probe{
+				ProbeHandler:     corev1.ProbeHandler{Exec: &corev1.ExecAction{Command: []string{"/bin/sh", "-c", "pg_isready -U app"}}},
+				PeriodSeconds:    15,
+				FailureThreshold: 2,
+			},
+		},
+		{
+			name:        "exec takes precedence over the path of an http port",
+			healthCheck: &store.HealthCheck{Path: "/healthz", PortName: "http", Command: "curl -f localhost:8080/healthz"},
+			expectedProbe: &corev1.Probe{
+				ProbeHandler:     corev1.ProbeHandler{Exec: &corev1.ExecAction{Command: []string{"/bin/sh", "-c", "curl -f localhost:8080/healthz"}}},
+				PeriodSeconds:    defaultProbePeriodSeconds,
+				FailureThreshold: defaultProbeFailureThreshold,
+			},
+		},
+		{
+			name:        "default thresholds",
+			healthCheck: &store.HealthCheck{Path: "/healthz", PortName: "http"},
+			expectedProbe: &corev1.Probe{
+				ProbeHandler:     corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromString("http")}},
+				PeriodSeconds:    10,
+				FailureThreshold: 3,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			readinessProbe, livenessProbe := getProbes(tc.healthCheck, ports)
+			assert.Equal(t, tc.expectedProbe, readinessProbe)
+			assert.Equal(t, tc.expectedProbe, livenessProbe)
+			if readinessProbe != nil {
+				assert.NotSame(t, readinessProbe, livenessProbe, "Expected the probes not to share memory")
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package cellprovider
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/onmetal-dev/metal/lib/logger"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+)
+
+// Processes with autoscaling get a HorizontalPodAutoscaler named after their k8s deployment. metrics-server, which the Janitor installs, feeds it.
+// Once a process autoscales, the HPA owns spec.replicas of its k8s deployment, so we only set it when creating the deployment and leave it alone afterwards.
+
+// hpaForProcess builds the HorizontalPodAutoscaler of a process that autoscales
+func hpaForProcess(deployment *store.Deployment, process store.Process) *autoscalingv2.HorizontalPodAutoscaler {
+	autoscaling := process.Autoscaling
+	name := processResourceName(deployment, process)
+	var metrics []autoscalingv2.MetricSpec
+	for _, target := range []struct {
+		resource corev1.ResourceName
+		percent  int
+	}{
+		{corev1.ResourceCPU, autoscaling.TargetCPUUtilizationPercent},
+		{corev1.ResourceMemory, autoscaling.TargetMemoryUtilizationPercent},
+	} {
+		if target.percent == 0 {
+			continue
+		}
+		metrics = append(metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: target.resource,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: ptr.To(int32(target.percent)),
+				},
+			},
+		})
+	}
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: processLabels(deployment, process),
+			Annotations: map[string]string{
+				"onmetal.dev/deployment-id": fmt.Sprintf("%d", deployment.Id),
+			},
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       name,
+			},
+			MinReplicas: ptr.To(int32(autoscaling.MinReplicas)),
+			MaxReplicas: int32(autoscaling.MaxReplicas),
+			Metrics:     metrics,
+		},
+	}
+}
+
+// ensureAutoscaling creates or updates the HPA of each process that autoscales, and deletes the HPAs of processes that no longer do (or no longer exist)
+func ensureAutoscaling(ctx context.Context, k8sClient *kubernetes.Clientset, deployment *store.Deployment, processes store.Processes) error {
+	log := logger.FromContext(ctx)
+	hpas := k8sClient.AutoscalingV2().HorizontalPodAutoscalers(deployment.Env.Name)
+	for _, process := range processes {
+		if process.Autoscaling == nil {
+			continue
+		}
+		hpa := hpaForProcess(deployment, process)
+		existing, err := hpas.Get(ctx, hpa.Name, metav1.GetOptions{})
+		if err != nil {
+			if !k8serrors.IsNotFound(err) {
+				return fmt.Errorf("error checking existing hpa: %v", err)
+			}
+			log.Info("creating hpa", slog.String("process", process.Name), slog.Int("min", process.Autoscaling.MinReplicas), slog.Int("max", process.Autoscaling.MaxReplicas))
+			if _, err := hpas.Create(ctx, hpa, metav1.CreateOptions{}); err != nil {
+				return fmt.Errorf("error creating hpa: %v", err)
+			}
+			continue
+		}
+		hpa.ResourceVersion = existing.ResourceVersion
+		if _, err := hpas.Update(ctx, hpa, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("error updating hpa: %v", err)
+		}
+	}
+
+	existing, err := hpas.List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("onmetal.dev/app=%s", deployment.App.Name),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing hpas: %v", err)
+	}
+	for _, hpa := range existing.Items {
+		if lo.ContainsBy(processes, func(p store.Process) bool {
+			return p.Autoscaling != nil && p.Name == hpa.Labels["onmetal.dev/process"]
+		}) {
+			continue
+		}
+		log.Info("deleting hpa", slog.String("process", hpa.Labels["onmetal.dev/process"]))
+		if err := hpas.Delete(ctx, hpa.Name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("error deleting hpa: %v", err)
+		}
+	}
+	return nil
+}
+
+// keepAutoscaledReplicas carries the replica count the HPA chose over to an updated k8s deployment, so that a rollout doesn't reset it
+func keepAutoscaledReplicas(k8sDeployment, existing *appsv1.Deployment, process store.Process) {
+	if process.Autoscaling == nil || existing.Spec.Replicas == nil {
+		return
+	}
+	k8sDeployment.Spec.Replicas = ptr.To(min(max(*existing.Spec.Replicas, int32(process.Autoscaling.MinReplicas)), int32(process.Autoscaling.MaxReplicas)))
+}
+
+func (p *TalosClusterCellProvider) ProcessReplicas(ctx context.Context, cellId string, deployment *store.Deployment) ([]ProcessReplicas, error) {
+	clientset, err := p.initializeK8sClientForCell(cellId)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []ProcessReplicas
+	for _, process := range deployment.Processes() {
+		name := processResourceName(deployment, process)
+		replicas := ProcessReplicas{Process: process.Name, Autoscaling: process.Autoscaling}
+		k8sDeployment, err := clientset.AppsV1().Deployments(deployment.Env.Name).Get(ctx, name, metav1.GetOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return nil, fmt.Errorf("error getting deployment: %v", err)
+		} else if err == nil {
+			replicas.Current = int(k8sDeployment.Status.ReadyReplicas)
+			replicas.Desired = int(ptr.Deref(k8sDeployment.Spec.Replicas, 0))
+		}
+		if process.Autoscaling != nil {
+			hpa, err := clientset.AutoscalingV2().HorizontalPodAutoscalers(deployment.Env.Name).Get(ctx, name, metav1.GetOptions{})
+			if err != nil && !k8serrors.IsNotFound(err) {
+				return nil, fmt.Errorf("error getting hpa: %v", err)
+			} else if err == nil && hpa.Status.DesiredReplicas > 0 {
+				replicas.Desired = int(hpa.Status.DesiredReplicas)
+			}
+		}
+		result = append(result, replicas)
+	}
+	return result, nil
+}
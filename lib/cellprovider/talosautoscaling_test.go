@@ -0,0 +1,88 @@
+package cellprovider
+
+import (
+	"testing"
+
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestHPAForProcess(t *testing.T) {
+	deployment := &store.Deployment{Id: 7, App: store.App{Name: "shop"}}
+	testCases := []struct {
+		name            string
+		process         store.Process
+		expectedName    string
+		expectedMetrics map[corev1.ResourceName]int32
+	}{
+		{
+			name:            "cpu only",
+			process:         store.Process{Name: "web", Autoscaling: &store.Autoscaling{MinReplicas: 2, MaxReplicas: 10, TargetCPUUtilizationPercent: 70}},
+			expectedName:    "shop",
+			expectedMetrics: map[corev1.ResourceName]int32{corev1.ResourceCPU: 70},
+		},
+		{
+			name:            "memory only",
+			process:         store.Process{Name: "worker", Autoscaling: &store.Autoscaling{MinReplicas: 1, MaxReplicas: 3, TargetMemoryUtilizationPercent: 80}},
+			expectedName:    "shop-worker",
+			expectedMetrics: map[corev1.ResourceName]int32{corev1.ResourceMemory: 80},
+		},
+		{
+			name:            "cpu and memory",
+			process:         store.Process{Name: "web", Autoscaling: &store.Autoscaling{MinReplicas: 1, MaxReplicas: 5, TargetCPUUtilizationPercent: 60, TargetMemoryUtilizationPercent: 75}},
+			expectedName:    "shop",
+			expectedMetrics: map[corev1.ResourceName]int32{corev1.ResourceCPU: 60, corev1.ResourceMemory: 75},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hpa := hpaForProcess(deployment, tc.process)
+			assert.Equal(t, tc.expectedName, hpa.Name)
+			assert.Equal(t, processLabels(deployment, tc.process), hpa.Labels)
+			assert.Equal(t, "7", hpa.Annotations["onmetal.dev/deployment-id"])
+			assert.Equal(t, autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: tc.expectedName}, hpa.Spec.ScaleTargetRef)
+			require.NotNil(t, hpa.Spec.MinReplicas)
+			assert.Equal(t, int32(tc.process.Autoscaling.MinReplicas), *hpa.Spec.MinReplicas)
+			assert.Equal(t, int32(tc.process.Autoscaling.MaxReplicas), hpa.Spec.MaxReplicas)
+
+			metrics := map[corev1.ResourceName]int32{}
+			for _, metric := range hpa.Spec.Metrics {
+				assert.Equal(t, autoscalingv2.ResourceMetricSourceType, metric.Type)
+				require.NotNil(t, metric.Resource)
+				assert.Equal(t, autoscalingv2.UtilizationMetricType, metric.Resource.Target.Type)
+				require.NotNil(t, metric.Resource.Target.AverageUtilization)
+				metrics[metric.Resource.Name] = *metric.Resource.Target.AverageUtilization
+			}
+			assert.Equal(t, tc.expectedMetrics, metrics)
+		})
+	}
+}
+
+func TestKeepAutoscaledReplicas(t *testing.T) {
+	autoscaling := &store.Autoscaling{MinReplicas: 2, MaxReplicas: 6, TargetCPUUtilizationPercent: 70}
+	testCases := []struct {
+		name             string
+		autoscaling      *store.Autoscaling
+		existing         *int32
+		expectedReplicas int32
+	}{
+		{"not autoscaled", nil, ptr.To(int32(4)), 1},
+		{"no replicas on the existing deployment", autoscaling, nil, 1},
+		{"keeps what the hpa chose", autoscaling, ptr.To(int32(4)), 4},
+		{"raised to the new minimum", autoscaling, ptr.To(int32(1)), 2},
+		{"lowered to the new maximum", autoscaling, ptr.To(int32(9)), 6},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k8sDeployment := &appsv1.Deployment{Spec: appsv1.DeploymentSpec{Replicas: ptr.To(int32(1))}}
+			existing := &appsv1.Deployment{Spec: appsv1.DeploymentSpec{Replicas: tc.existing}}
+			keepAutoscaledReplicas(k8sDeployment, existing, store.Process{Name: "web", Replicas: 1, Autoscaling: tc.autoscaling})
+			assert.Equal(t, tc.expectedReplicas, *k8sDeployment.Spec.Replicas)
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package cellprovider
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/onmetal-dev/metal/lib/logger"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+	"gorm.io/datatypes"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// A canary runs next to the deployment it is being compared to, in k8s deployments and services of its own.
+// The HTTPRoutes and GRPCRoutes split traffic between the two by the canary's weight (see backendRefsForPort). tcp ports keep going to the regular k8s deployments only.
+// Promoting it moves it to its next step, and from the last step it is rolled out over the regular k8s deployments and torn down.
+// Aborting it, or it failing, sends all traffic back to the regular k8s deployments and tears it down.
+
+// canaryResourceName encodes our convention for naming the k8s deployment and service of a process's canary
+func canaryResourceName(deployment *store.Deployment, process store.Process) string {
+	return processResourceName(deployment, process) + "-canary"
+}
+
+// canaryLabels are the labels for the k8s resources of a process's canary. "onmetal.dev/canary" lets us find everything that belongs to an app's canary.
+func canaryLabels(deployment *store.Deployment, process store.Process) map[string]string {
+	labels := processLabels(deployment, process)
+	labels["app"] = canaryResourceName(deployment, process)
+	labels["onmetal.dev/canary"] = "true"
+	return labels
+}
+
+// canaryReplicas sizes a process's canary by its share of traffic, so that each pod gets about as much traffic as the regular ones.
+// Autoscaled processes are sized by their minimum since canaries don't get an HPA.
+func canaryReplicas(process store.Process, weight int) int {
+	replicas := process.Replicas
+	if process.Autoscaling != nil {
+		replicas = process.Autoscaling.MinReplicas
+	}
+	if replicas == 0 {
+		return 0
+	}
+	return max(1, int(math.Ceil(float64(replicas*weight)/100)))
+}
+
+// canaryNewProcesses returns the processes with ports that a canary adds, i.e. the ones that don't have a regular service in the cell yet.
+// There is nothing to compare them to, so backendRefsForPort sends all of their traffic to the canary.
+func canaryNewProcesses(ctx context.Context, ctrlClient ctrlclient.Client, deployment *store.Deployment) ([]string, error) {
+	if !deployment.InCanaryPhase() {
+		return nil, nil
+	}
+	var services corev1.ServiceList
+	if err := ctrlClient.List(ctx, &services, ctrlclient.InNamespace(deployment.Env.Name), ctrlclient.MatchingLabels{"onmetal.dev/app": deployment.App.Name}); err != nil {
+		return nil, fmt.Errorf("error listing services: %v", err)
+	}
+	return newProcesses(deployment, lo.Map(services.Items, func(s corev1.Service, _ int) string { return s.Name })), nil
+}
+
+// newProcesses returns the names of the deployment's processes with ports whose regular service isn't among services
+func newProcesses(deployment *store.Deployment, services []string) []string {
+	var names []string
+	for _, process := range deployment.Processes() {
+		if len(process.Ports) > 0 && !lo.Contains(services, processResourceName(deployment, process)) {
+			names = append(names, process.Name)
+		}
+	}
+	return names
+}
+
+// ensureCanaryServiceForProcess ensures that the service of a process's canary is created or updated
+func ensureCanaryServiceForProcess(ctx context.Context, ctrlClient ctrlclient.Client, deployment *store.Deployment, process store.Process) error {
+	return ensureService(ctx, ctrlClient, deployment, process, canaryResourceName(deployment, process), canaryLabels(deployment, process))
+}
+
+// k8sCanaryDeploymentForProcess builds the k8s deployment that runs the canary of one of the app's processes
+func k8sCanaryDeploymentForProcess(deployment *store.Deployment, process store.Process) (*appsv1.Deployment, error) {
+	k8sDeployment, err := k8sDeploymentForProcess(deployment, process)
+	if err != nil {
+		return nil, err
+	}
+	name := canaryResourceName(deployment, process)
+	labels := canaryLabels(deployment, process)
+	k8sDeployment.Name = name
+	k8sDeployment.Labels = labels
+	k8sDeployment.Annotations["kubernetes.io/change-cause"] = fmt.Sprintf("canary %s id %d at %d%%", deployment.App.Name, deployment.Id, deployment.CanaryWeight)
+	k8sDeployment.Spec.Replicas = ptr.To(int32(canaryReplicas(process, deployment.CanaryWeight)))
+	k8sDeployment.Spec.Selector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			"app": name,
+		},
+	}
+	k8sDeployment.Spec.Template.Labels = labels
+	k8sDeployment.Spec.Template.Spec.Containers[0].Name = name
+	return k8sDeployment, nil
+}
+
+// rolloutCanary creates or updates the canary's k8s deployment for each of the app's processes
+func rolloutCanary(ctx context.Context, k8sClient *kubernetes.Clientset, deployment *store.Deployment) (*AdvanceDeploymentResult, error) {
+	log := logger.FromContext(ctx)
+	for _, process := range deployment.Processes() {
+		k8sDeployment, err := k8sCanaryDeploymentForProcess(deployment, process)
+		if err != nil {
+			return nil, fmt.Errorf("error building canary deployment for process %s: %v", process.Name, err)
+		}
+		_, err = k8sClient.AppsV1().Deployments(deployment.Env.Name).Get(ctx, k8sDeployment.Name, metav1.GetOptions{})
+		if err != nil {
+			if !k8serrors.IsNotFound(err) {
+				return nil, fmt.Errorf("error checking existing canary deployment: %v", err)
+			}
+			log.Info("creating canary deployment", slog.String("process", process.Name), slog.Int("weight", deployment.CanaryWeight))
+			if _, err := k8sClient.AppsV1().Deployments(deployment.Env.Name).Create(ctx, k8sDeployment, metav1.CreateOptions{}); err != nil {
+				return nil, fmt.Errorf("error creating canary deployment: %v", err)
+			}
+			continue
+		}
+		log.Info("updating canary deployment", slog.String("process", process.Name), slog.Int("weight", deployment.CanaryWeight))
+		if _, err := k8sClient.AppsV1().Deployments(deployment.Env.Name).Update(ctx, k8sDeployment, metav1.UpdateOptions{}); err != nil {
+			return nil, fmt.Errorf("error updating canary deployment: %v", err)
+		}
+	}
+	return &AdvanceDeploymentResult{
+		Status: store.DeploymentStatusDeploying,
+	}, nil
+}
+
+// deleteCanary deletes the k8s deployments and services of the app's canary, if it has one
+func deleteCanary(ctx context.Context, k8sClient *kubernetes.Clientset, deployment *store.Deployment) error {
+	listOptions := metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("onmetal.dev/app=%s,onmetal.dev/canary=true", deployment.App.Name),
+	}
+	k8sDeployments, err := k8sClient.AppsV1().Deployments(deployment.Env.Name).List(ctx, listOptions)
+	if err != nil {
+		return fmt.Errorf("error listing canary deployments: %v", err)
+	}
+	for _, k8sDeployment := range k8sDeployments.Items {
+		if err := k8sClient.AppsV1().Deployments(deployment.Env.Name).Delete(ctx, k8sDeployment.Name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("error deleting canary deployment: %v", err)
+		}
+	}
+	services, err := k8sClient.CoreV1().Services(deployment.Env.Name).List(ctx, listOptions)
+	if err != nil {
+		return fmt.Errorf("error listing canary services: %v", err)
+	}
+	for _, service := range services.Items {
+		if err := k8sClient.CoreV1().Services(deployment.Env.Name).Delete(ctx, service.Name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("error deleting canary service: %v", err)
+		}
+	}
+	return nil
+}
+
+// teardownCanary sends all traffic back to the regular k8s deployments and then deletes the canary
+func (p *TalosClusterCellProvider) teardownCanary(ctx context.Context, cellId string, deployment *store.Deployment) error {
+	clients, err := p.setupClients(ctx, cellId)
+	if err != nil {
+		return err
+	}
+	withoutCanary := *deployment
+	withoutCanary.CanarySteps = datatypes.NewJSONType[[]int](nil)
+	if err := p.ensureRoutesForDeployment(ctx, clients.ctrlClient, cellId, &withoutCanary); err != nil {
+		return fmt.Errorf("error routing traffic away from canary: %v", err)
+	}
+	return deleteCanary(ctx, clients.k8sClient, deployment)
+}
+
+// handlePromotingDeployment moves a canary to its current weight (which the promotion just raised).
+// At 100% the canary is rolled out over the regular k8s deployments, after which it is torn down.
+func (p *TalosClusterCellProvider) handlePromotingDeployment(ctx context.Context, cellId string, deployment *store.Deployment) (*AdvanceDeploymentResult, error) {
+	log := logger.FromContext(ctx)
+	clients, err := p.setupClients(ctx, cellId)
+	if err != nil {
+		return nil, err
+	}
+
+	if deployment.InCanaryPhase() {
+		log.Info("shifting traffic to canary", slog.Int("weight", deployment.CanaryWeight))
+		// resize the canary before it gets more traffic
+		result, err := rolloutCanary(ctx, clients.k8sClient, deployment)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.ensureRoutesForDeployment(ctx, clients.ctrlClient, cellId, deployment); err != nil {
+			return nil, fmt.Errorf("error ensuring routes for deployment: %v", err)
+		}
+		return result, nil
+	}
+
+	log.Info("promoting canary fully")
+	for _, process := range deployment.Processes() {
+		if len(process.Ports) == 0 {
+			continue
+		}
+		if err := ensureServiceForProcess(ctx, clients.ctrlClient, deployment, process); err != nil {
+			return nil, fmt.Errorf("error ensuring service for deployment: %v", err)
+		}
+	}
+	// route everything to the regular services first. They still have the old pods until the rollout below replaces them.
+	if err := p.ensureRoutesForDeployment(ctx, clients.ctrlClient, cellId, deployment); err != nil {
+		return nil, fmt.Errorf("error ensuring routes for deployment: %v", err)
+	}
+	return rolloutDeployment(ctx, clients.k8sClient, deployment)
+}
+
+// handleAbortingDeployment rolls back a canary
+func (p *TalosClusterCellProvider) handleAbortingDeployment(ctx context.Context, cellId string, deployment *store.Deployment) (*AdvanceDeploymentResult, error) {
+	if err := p.teardownCanary(ctx, cellId, deployment); err != nil {
+		return nil, fmt.Errorf("error tearing down canary: %v", err)
+	}
+	return &AdvanceDeploymentResult{
+		Status:       store.DeploymentStatusStopped,
+		StatusReason: fmt.Sprintf("canary aborted at %d%% of traffic", deployment.CanaryWeight),
+	}, nil
+}
@@ -0,0 +1,89 @@
+package cellprovider
+
+import (
+	"testing"
+
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestCanaryReplicas(t *testing.T) {
+	testCases := []struct {
+		name     string
+		process  store.Process
+		weight   int
+		expected int
+	}{
+		{"first step", store.Process{Replicas: 10}, 10, 1},
+		{"second step", store.Process{Replicas: 10}, 50, 5},
+		{"rounds up", store.Process{Replicas: 3}, 50, 2},
+		{"at least one replica", store.Process{Replicas: 2}, 1, 1},
+		{"process scaled to zero", store.Process{Replicas: 0}, 50, 0},
+		{"autoscaled process is sized by its minimum", store.Process{Replicas: 20, Autoscaling: &store.Autoscaling{MinReplicas: 4, MaxReplicas: 20}}, 25, 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, canaryReplicas(tc.process, tc.weight))
+		})
+	}
+}
+
+func TestBackendRefsForPort(t *testing.T) {
+	web := store.Process{Name: "web", Ports: store.Ports{{Name: "http", Port: 8080}}}
+	api := store.Process{Name: "api", Ports: store.Ports{{Name: "api", Port: 9090}}}
+	port := store.ExternalPort{Name: "public", PortName: "http", Port: 8080, Proto: "http"}
+	deployment := func(canarySteps []int, weight int) *store.Deployment {
+		return &store.Deployment{
+			App:          store.App{Name: "shop"},
+			AppSettings:  store.AppSettings{Processes: datatypes.NewJSONType(store.Processes{web, api})},
+			CanarySteps:  datatypes.NewJSONType(canarySteps),
+			CanaryWeight: weight,
+		}
+	}
+	type backend struct {
+		name   string
+		weight int32
+	}
+
+	testCases := []struct {
+		name         string
+		deployment   *store.Deployment
+		process      store.Process
+		newProcesses []string
+		expected     []backend
+	}{
+		{"regular deployment", deployment(nil, 0), web, nil, []backend{{"shop", 1}}},
+		{"canary on its first step", deployment([]int{10, 50}, 10), web, nil, []backend{{"shop", 90}, {"shop-canary", 10}}},
+		{"canary on its second step", deployment([]int{10, 50}, 50), web, nil, []backend{{"shop", 50}, {"shop-canary", 50}}},
+		{"canary promoted fully", deployment([]int{10, 50}, 100), web, nil, []backend{{"shop", 1}}},
+		{"process the canary adds", deployment([]int{10, 50}, 10), api, []string{"api"}, []backend{{"shop-api-canary", 1}}},
+		{"process the canary adds once promoted fully", deployment([]int{10, 50}, 100), api, []string{"api"}, []backend{{"shop-api", 1}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			refs := backendRefsForPort(tc.deployment, tc.process, port, tc.newProcesses)
+			backends := make([]backend, len(refs))
+			for i, ref := range refs {
+				assert.Equal(t, gatewayv1.PortNumber(port.Port), *ref.Port)
+				backends[i] = backend{string(ref.Name), *ref.Weight}
+			}
+			assert.Equal(t, tc.expected, backends)
+		})
+	}
+}
+
+func TestNewProcesses(t *testing.T) {
+	d := &store.Deployment{
+		App: store.App{Name: "shop"},
+		AppSettings: store.AppSettings{Processes: datatypes.NewJSONType(store.Processes{
+			{Name: "web", Ports: store.Ports{{Name: "http", Port: 8080}}},
+			{Name: "api", Ports: store.Ports{{Name: "api", Port: 9090}}},
+			{Name: "worker"},
+		})},
+	}
+	// the canary's own services don't count, and processes without ports never get a service
+	assert.Equal(t, []string{"api"}, newProcesses(d, []string{"shop", "shop-canary", "shop-api-canary"}))
+	assert.Empty(t, newProcesses(d, []string{"shop", "shop-api"}))
+}
@@ -0,0 +1,222 @@
+package cellprovider
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/onmetal-dev/metal/lib/logger"
+	"github.com/onmetal-dev/metal/lib/store"
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+)
+
+// Canceling a deployment puts the cell's k8s deployments back the way they were before it started, like kubectl rollout undo.
+// Its release job is stopped if it is still running and a canary is torn down. Processes the deployment added have no
+// previous revision to go back to, so their k8s deployments are deleted. Services, routes and HPAs keep the canceled
+// deployment's settings until the next deployment, since they point at the same k8s deployments either way.
+
+// revisionAnnotation is where k8s keeps the revision of a k8s deployment that a replica set belongs to
+const revisionAnnotation = "deployment.kubernetes.io/revision"
+
+// Scale and restart deployments patch the existing k8s deployments instead of rolling out a new revision, so there is no replica set
+// to go back to. They record what they changed in these annotations instead, for canceling them to change it back.
+const (
+	previousDeploymentIdAnnotation         = "onmetal.dev/previous-deployment-id"
+	previousChangeCauseAnnotation          = "onmetal.dev/previous-change-cause"
+	previousReplicasAnnotation             = "onmetal.dev/previous-replicas"
+	previousTemplateDeploymentIdAnnotation = "onmetal.dev/previous-template-deployment-id"
+	previousRestartedAtAnnotation          = "onmetal.dev/previous-restarted-at"
+)
+
+// previousAnnotations records what a scale or restart deployment is about to change on a k8s deployment
+func previousAnnotations(current *appsv1.Deployment, deploymentType store.DeploymentType) map[string]string {
+	annotations := map[string]string{
+		previousDeploymentIdAnnotation: current.Annotations["onmetal.dev/deployment-id"],
+		previousChangeCauseAnnotation:  current.Annotations["kubernetes.io/change-cause"],
+	}
+	switch deploymentType {
+	case store.DeploymentTypeScale:
+		annotations[previousReplicasAnnotation] = strconv.Itoa(int(ptr.Deref(current.Spec.Replicas, 1)))
+	case store.DeploymentTypeRestart:
+		annotations[previousTemplateDeploymentIdAnnotation] = current.Spec.Template.Annotations["onmetal.dev/deployment-id"]
+		annotations[previousRestartedAtAnnotation] = current.Spec.Template.Annotations["onmetal.dev/restarted-at"]
+	}
+	return annotations
+}
+
+// handleCancelingDeployment rolls back whatever the deployment already changed on the cell
+func (p *TalosClusterCellProvider) handleCancelingDeployment(ctx context.Context, cellId string, deployment *store.Deployment) (*AdvanceDeploymentResult, error) {
+	clients, err := p.setupClients(ctx, cellId)
+	if err != nil {
+		return nil, err
+	}
+	k8sClient := clients.k8sClient
+
+	if hasReleasePhase(deployment) {
+		if err := k8sClient.BatchV1().Jobs(deployment.Env.Name).Delete(ctx, releaseJobName(deployment), metav1.DeleteOptions{
+			PropagationPolicy: ptr.To(metav1.DeletePropagationBackground),
+		}); err != nil && !k8serrors.IsNotFound(err) {
+			return nil, fmt.Errorf("error deleting release job: %v", err)
+		}
+	}
+
+	if deployment.IsCanary() {
+		if err := p.teardownCanary(ctx, cellId, deployment); err != nil {
+			return nil, fmt.Errorf("error tearing down canary: %v", err)
+		}
+		if deployment.InCanaryPhase() {
+			// the regular k8s deployments were never touched
+			return &AdvanceDeploymentResult{Status: store.DeploymentStatusCanceled, StatusReason: "canceled, the canary was torn down"}, nil
+		}
+	}
+
+	for _, process := range deployment.Processes() {
+		if err := rollbackProcess(ctx, k8sClient, deployment, process); err != nil {
+			return nil, fmt.Errorf("error rolling back process %s: %v", process.Name, err)
+		}
+	}
+	return &AdvanceDeploymentResult{Status: store.DeploymentStatusCanceled, StatusReason: "canceled, rolled back to the previous deployment"}, nil
+}
+
+// rollbackProcess puts the k8s deployment of a process back the way it was before the deployment, if the deployment changed it
+func rollbackProcess(ctx context.Context, k8sClient *kubernetes.Clientset, deployment *store.Deployment, process store.Process) error {
+	log := logger.FromContext(ctx)
+	name := processResourceName(deployment, process)
+	deployments := k8sClient.AppsV1().Deployments(deployment.Env.Name)
+	k8sDeployment, err := deployments.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error getting deployment: %v", err)
+	}
+	deploymentId := fmt.Sprintf("%d", deployment.Id)
+	if k8sDeployment.Annotations["onmetal.dev/deployment-id"] != deploymentId {
+		// never rolled out, or already superseded by a later deployment
+		return nil
+	}
+
+	switch deployment.Type {
+	case store.DeploymentTypeScale:
+		if !undoScale(k8sDeployment, process) {
+			log.Info("scale didn't record the replica count to go back to", slog.String("name", name))
+			return nil
+		}
+	case store.DeploymentTypeRestart:
+		if !undoRestart(k8sDeployment) {
+			log.Info("restart didn't record the pod template to go back to", slog.String("name", name))
+			return nil
+		}
+	default:
+		previous, err := previousReplicaSet(ctx, k8sClient, deployment, k8sDeployment)
+		if err != nil {
+			return err
+		}
+		if previous == nil {
+			log.Info("deleting deployment of process without a previous revision", slog.String("name", name))
+			if err := deployments.Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+				return fmt.Errorf("error deleting deployment: %v", err)
+			}
+			return nil
+		}
+		log.Info("rolling back deployment", slog.String("name", name), slog.String("revision", previous.Annotations[revisionAnnotation]))
+		rollbackToReplicaSet(k8sDeployment, previous, process)
+	}
+	if _, err := deployments.Update(ctx, k8sDeployment, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("error updating deployment: %v", err)
+	}
+	return nil
+}
+
+// previousReplicaSet returns the replica set of the revision a deploy or rollback replaced, or nil if it created the k8s deployment
+func previousReplicaSet(ctx context.Context, k8sClient *kubernetes.Clientset, deployment *store.Deployment, k8sDeployment *appsv1.Deployment) (*appsv1.ReplicaSet, error) {
+	replicaSets, err := k8sClient.AppsV1().ReplicaSets(deployment.Env.Name).List(ctx, metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(k8sDeployment.Spec.Selector),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing replica sets: %v", err)
+	}
+	deploymentId := fmt.Sprintf("%d", deployment.Id)
+	var previous *appsv1.ReplicaSet
+	previousRevision := 0
+	for i, rs := range replicaSets.Items {
+		if rs.Annotations["onmetal.dev/deployment-id"] == deploymentId {
+			continue
+		}
+		if revision, err := strconv.Atoi(rs.Annotations[revisionAnnotation]); err == nil && revision > previousRevision {
+			previous, previousRevision = &replicaSets.Items[i], revision
+		}
+	}
+	return previous, nil
+}
+
+// rollbackToReplicaSet points a k8s deployment back at the pod template of a previous replica set
+func rollbackToReplicaSet(k8sDeployment *appsv1.Deployment, previous *appsv1.ReplicaSet, process store.Process) {
+	template := previous.Spec.Template.DeepCopy()
+	delete(template.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+	k8sDeployment.Spec.Template = *template
+	// the replica set remembers how many replicas its deployment had, which a scale deployment may have changed.
+	// An autoscaled process keeps the count the HPA chose.
+	if desired, err := strconv.Atoi(previous.Annotations["deployment.kubernetes.io/desired-replicas"]); err == nil && process.Autoscaling == nil {
+		k8sDeployment.Spec.Replicas = ptr.To(int32(desired))
+	}
+	for _, annotation := range []string{"onmetal.dev/deployment-id", "kubernetes.io/change-cause"} {
+		if value, ok := previous.Annotations[annotation]; ok {
+			k8sDeployment.Annotations[annotation] = value
+		}
+	}
+}
+
+// undoScale puts back the replica count a scale deployment changed. The pod template is left alone, so no pods are replaced.
+// It reports false if the scale didn't record the count it replaced
+func undoScale(k8sDeployment *appsv1.Deployment, process store.Process) bool {
+	replicas, err := strconv.Atoi(k8sDeployment.Annotations[previousReplicasAnnotation])
+	if err != nil {
+		return false
+	}
+	// the replica count of an autoscaled process is up to its HPA
+	if process.Autoscaling == nil {
+		k8sDeployment.Spec.Replicas = ptr.To(int32(replicas))
+	}
+	restorePreviousAnnotations(k8sDeployment)
+	return true
+}
+
+// undoRestart puts back the pod template annotations a restart deployment bumped, which sends k8s back to the replica set from before
+// the restart. It reports false if the restart didn't record the annotations it replaced
+func undoRestart(k8sDeployment *appsv1.Deployment) bool {
+	restartedAt, ok := k8sDeployment.Annotations[previousRestartedAtAnnotation]
+	if !ok {
+		return false
+	}
+	if k8sDeployment.Spec.Template.Annotations == nil {
+		k8sDeployment.Spec.Template.Annotations = map[string]string{}
+	}
+	for annotation, value := range map[string]string{
+		"onmetal.dev/restarted-at":  restartedAt,
+		"onmetal.dev/deployment-id": k8sDeployment.Annotations[previousTemplateDeploymentIdAnnotation],
+	} {
+		if value == "" {
+			delete(k8sDeployment.Spec.Template.Annotations, annotation)
+		} else {
+			k8sDeployment.Spec.Template.Annotations[annotation] = value
+		}
+	}
+	restorePreviousAnnotations(k8sDeployment)
+	return true
+}
+
+// restorePreviousAnnotations puts back the bookkeeping annotations recorded by previousAnnotations and removes the record
+func restorePreviousAnnotations(k8sDeployment *appsv1.Deployment) {
+	k8sDeployment.Annotations["onmetal.dev/deployment-id"] = k8sDeployment.Annotations[previousDeploymentIdAnnotation]
+	k8sDeployment.Annotations["kubernetes.io/change-cause"] = k8sDeployment.Annotations[previousChangeCauseAnnotation]
+	for _, annotation := range []string{previousDeploymentIdAnnotation, previousChangeCauseAnnotation, previousReplicasAnnotation,
+		previousTemplateDeploymentIdAnnotation, previousRestartedAtAnnotation} {
+		delete(k8sDeployment.Annotations, annotation)
+	}
+}
@@ -0,0 +1,120 @@
+package cellprovider
+
+import (
+	"testing"
+
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// k8sDeploymentFor returns a k8s deployment as deployment 1 left it: 2 replicas, restarted at 9am
+func k8sDeploymentFor() *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			"onmetal.dev/deployment-id":  "1",
+			"kubernetes.io/change-cause": "deploy app id 1",
+		}},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(int32(2)),
+			Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				"onmetal.dev/deployment-id": "1",
+				"onmetal.dev/restarted-at":  "2026-10-01T09:00:00Z",
+			}}},
+		},
+	}
+}
+
+// applyAnnotations does what the merge patch of a scale or restart does to the annotations of a k8s deployment
+func applyAnnotations(k8sDeployment *appsv1.Deployment, annotations map[string]string) {
+	for k, v := range annotations {
+		k8sDeployment.Annotations[k] = v
+	}
+}
+
+func TestUndoScale(t *testing.T) {
+	testCases := []struct {
+		name             string
+		process          store.Process
+		expectedReplicas int32
+	}{
+		{"replicas go back", store.Process{Name: "web", Replicas: 5}, 2},
+		{"autoscaled process keeps the replicas its HPA chose", store.Process{Name: "web", Replicas: 5, Autoscaling: &store.Autoscaling{MinReplicas: 1, MaxReplicas: 10}}, 5},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k8sDeployment := k8sDeploymentFor()
+			applyAnnotations(k8sDeployment, previousAnnotations(k8sDeployment, store.DeploymentTypeScale))
+			k8sDeployment.Annotations["onmetal.dev/deployment-id"] = "2"
+			k8sDeployment.Annotations["kubernetes.io/change-cause"] = "scale app id 2 to 5 replicas"
+			k8sDeployment.Spec.Replicas = ptr.To(int32(5))
+			template := k8sDeployment.Spec.Template.DeepCopy()
+
+			require.True(t, undoScale(k8sDeployment, tc.process))
+			assert.Equal(t, tc.expectedReplicas, *k8sDeployment.Spec.Replicas)
+			assert.Equal(t, map[string]string{
+				"onmetal.dev/deployment-id":  "1",
+				"kubernetes.io/change-cause": "deploy app id 1",
+			}, k8sDeployment.Annotations)
+			// no new replica set, and no going back to an older image
+			assert.Equal(t, *template, k8sDeployment.Spec.Template)
+		})
+	}
+
+	t.Run("nothing recorded", func(t *testing.T) {
+		assert.False(t, undoScale(k8sDeploymentFor(), store.Process{Name: "web"}))
+	})
+}
+
+func TestUndoRestart(t *testing.T) {
+	t.Run("restarted before", func(t *testing.T) {
+		k8sDeployment := k8sDeploymentFor()
+		applyAnnotations(k8sDeployment, previousAnnotations(k8sDeployment, store.DeploymentTypeRestart))
+		k8sDeployment.Annotations["onmetal.dev/deployment-id"] = "2"
+		k8sDeployment.Spec.Template.Annotations["onmetal.dev/deployment-id"] = "2"
+		k8sDeployment.Spec.Template.Annotations["onmetal.dev/restarted-at"] = "2026-10-02T10:00:00Z"
+
+		require.True(t, undoRestart(k8sDeployment))
+		assert.Equal(t, k8sDeploymentFor(), k8sDeployment)
+	})
+
+	t.Run("never restarted", func(t *testing.T) {
+		k8sDeployment := k8sDeploymentFor()
+		delete(k8sDeployment.Spec.Template.Annotations, "onmetal.dev/restarted-at")
+		applyAnnotations(k8sDeployment, previousAnnotations(k8sDeployment, store.DeploymentTypeRestart))
+		k8sDeployment.Spec.Template.Annotations["onmetal.dev/restarted-at"] = "2026-10-02T10:00:00Z"
+
+		require.True(t, undoRestart(k8sDeployment))
+		assert.NotContains(t, k8sDeployment.Spec.Template.Annotations, "onmetal.dev/restarted-at")
+		assert.Equal(t, "1", k8sDeployment.Spec.Template.Annotations["onmetal.dev/deployment-id"])
+	})
+
+	t.Run("nothing recorded", func(t *testing.T) {
+		assert.False(t, undoRestart(k8sDeploymentFor()))
+	})
+}
+
+func TestRollbackToReplicaSet(t *testing.T) {
+	k8sDeployment := k8sDeploymentFor()
+	k8sDeployment.Annotations["onmetal.dev/deployment-id"] = "2"
+	previous := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			"onmetal.dev/deployment-id":                 "1",
+			"kubernetes.io/change-cause":                "deploy app id 1",
+			"deployment.kubernetes.io/desired-replicas": "3",
+		}},
+		Spec: appsv1.ReplicaSetSpec{Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+			"app":                                  "web",
+			appsv1.DefaultDeploymentUniqueLabelKey: "abc123",
+		}}}},
+	}
+
+	rollbackToReplicaSet(k8sDeployment, previous, store.Process{Name: "web"})
+	assert.Equal(t, int32(3), *k8sDeployment.Spec.Replicas)
+	assert.Equal(t, map[string]string{"app": "web"}, k8sDeployment.Spec.Template.Labels)
+	assert.Equal(t, "1", k8sDeployment.Annotations["onmetal.dev/deployment-id"])
+}
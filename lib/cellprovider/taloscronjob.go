@@ -0,0 +1,306 @@
+package cellprovider
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/onmetal-dev/metal/lib/logger"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// cronJobHistoryLimit is how many successful and failed runs k8s keeps around, which is also how much history we can show
+	cronJobHistoryLimit = 10
+	// cronJobNameMaxLength is shorter than the usual 63 because k8s appends a suffix to the names of the jobs it creates
+	cronJobNameMaxLength = 52
+)
+
+// cronJobName encodes our convention for naming k8s cron jobs
+func cronJobName(cronJob store.CronJob) string {
+	suffix := "-" + cronJob.Name
+	appName := cronJob.App.Name
+	if len(appName)+len(suffix) > cronJobNameMaxLength {
+		appName = appName[:max(0, cronJobNameMaxLength-len(suffix))]
+	}
+	return strings.TrimPrefix(appName+suffix, "-")
+}
+
+// cronJobLabels deliberately leave out the "app" label, otherwise the app's service would route traffic to cron job pods
+func cronJobLabels(cronJob store.CronJob) map[string]string {
+	return map[string]string{
+		"onmetal.dev/app":     cronJob.App.Name,
+		"onmetal.dev/cronjob": cronJob.Name,
+	}
+}
+
+func concurrencyPolicy(policy store.CronJobConcurrencyPolicy) batchv1.ConcurrencyPolicy {
+	switch policy {
+	case store.CronJobConcurrencyPolicyForbid:
+		return batchv1.ForbidConcurrent
+	case store.CronJobConcurrencyPolicyReplace:
+		return batchv1.ReplaceConcurrent
+	}
+	return batchv1.AllowConcurrent
+}
+
+func k8sCronJob(deployment *store.Deployment, cronJob store.CronJob) (*batchv1.CronJob, error) {
+	limits, requests, err := getResourceLimits(deployment.AppSettings.Resources.Data())
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource limits: %v", err)
+	}
+	labels := cronJobLabels(cronJob)
+	annotations := map[string]string{
+		"onmetal.dev/app-id":        deployment.App.Id,
+		"onmetal.dev/team-id":       deployment.TeamId,
+		"onmetal.dev/deployment-id": fmt.Sprintf("%d", deployment.Id),
+		"onmetal.dev/cronjob-id":    cronJob.Id,
+	}
+	var timeZone *string
+	if cronJob.Timezone != "" {
+		timeZone = ptr.To(cronJob.Timezone)
+	}
+	return &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        cronJobName(cronJob),
+			Namespace:   deployment.Env.Name,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule:                   cronJob.Schedule,
+			TimeZone:                   timeZone,
+			ConcurrencyPolicy:          concurrencyPolicy(cronJob.ConcurrencyPolicy),
+			SuccessfulJobsHistoryLimit: ptr.To(int32(cronJobHistoryLimit)),
+			FailedJobsHistoryLimit:     ptr.To(int32(cronJobHistoryLimit)),
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      labels,
+					Annotations: annotations,
+				},
+				Spec: batchv1.JobSpec{
+					BackoffLimit: ptr.To(int32(0)),
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels:      labels,
+							Annotations: annotations,
+						},
+						Spec: corev1.PodSpec{
+							RestartPolicy: corev1.RestartPolicyNever,
+							ImagePullSecrets: []corev1.LocalObjectReference{
+								{
+									Name: dockerconfigjsonSecretName,
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Name:    "cronjob",
+									Image:   deployment.AppSettings.Artifact.Data().Image.Name(),
+									Command: []string{"/bin/sh", "-c", cronJob.Command},
+									Env:     convertEnvVars(deployment.AppEnvVars.EnvVars.Data()),
+									Resources: corev1.ResourceRequirements{
+										Limits:   limits,
+										Requests: requests,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (p *TalosClusterCellProvider) SyncCronJobs(ctx context.Context, cellId string, deployment *store.Deployment, cronJobs []store.CronJob) error {
+	log := logger.FromContext(ctx)
+	clients, err := p.setupClients(ctx, cellId)
+	if err != nil {
+		return err
+	}
+	if err := ensureNamespaceExists(ctx, clients.k8sClient, deployment.Env.Name); err != nil {
+		return fmt.Errorf("error ensuring namespace exists: %v", err)
+	}
+	if err := copyImagePullSecretToNamespace(ctx, clients.ctrlClient, registryNamespace, deployment.Env.Name); err != nil {
+		return fmt.Errorf("error copying image pull secret to namespace: %v", err)
+	}
+
+	for _, cronJob := range cronJobs {
+		k8sCronJob, err := k8sCronJob(deployment, cronJob)
+		if err != nil {
+			return fmt.Errorf("error building cron job %s: %v", cronJob.Name, err)
+		}
+		cronJobClient := clients.k8sClient.BatchV1().CronJobs(deployment.Env.Name)
+		if _, err := cronJobClient.Get(ctx, k8sCronJob.Name, metav1.GetOptions{}); err != nil {
+			if !k8serrors.IsNotFound(err) {
+				return fmt.Errorf("error checking existing cron job: %v", err)
+			}
+			log.Info("creating cron job", slog.String("name", k8sCronJob.Name))
+			if _, err := cronJobClient.Create(ctx, k8sCronJob, metav1.CreateOptions{}); err != nil {
+				return fmt.Errorf("error creating cron job %s: %v", cronJob.Name, err)
+			}
+		} else {
+			log.Info("updating cron job", slog.String("name", k8sCronJob.Name), slog.String("image", deployment.AppSettings.Artifact.Data().Image.Name()))
+			if _, err := cronJobClient.Update(ctx, k8sCronJob, metav1.UpdateOptions{}); err != nil {
+				return fmt.Errorf("error updating cron job %s: %v", cronJob.Name, err)
+			}
+		}
+	}
+
+	// delete the cron jobs that no longer exist
+	existing, err := clients.k8sClient.BatchV1().CronJobs(deployment.Env.Name).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("onmetal.dev/app=%s,onmetal.dev/cronjob", deployment.App.Name),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing cron jobs: %v", err)
+	}
+	for _, k8sCronJob := range existing.Items {
+		if lo.ContainsBy(cronJobs, func(c store.CronJob) bool { return c.Name == k8sCronJob.Labels["onmetal.dev/cronjob"] }) {
+			continue
+		}
+		log.Info("deleting cron job", slog.String("name", k8sCronJob.Name))
+		if err := clients.k8sClient.BatchV1().CronJobs(deployment.Env.Name).Delete(ctx, k8sCronJob.Name, metav1.DeleteOptions{
+			PropagationPolicy: ptr.To(metav1.DeletePropagationBackground),
+		}); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("error deleting cron job: %v", err)
+		}
+	}
+	return nil
+}
+
+func (p *TalosClusterCellProvider) CronJobRuns(ctx context.Context, cellId string, cronJob store.CronJob) ([]CronJobRun, error) {
+	clientset, err := p.initializeK8sClientForCell(cellId)
+	if err != nil {
+		return nil, err
+	}
+	jobs, err := clientset.BatchV1().Jobs(cronJob.Env.Name).List(ctx, metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(&metav1.LabelSelector{MatchLabels: cronJobLabels(cronJob)}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing cron job runs: %v", err)
+	}
+
+	runs := make([]CronJobRun, 0, len(jobs.Items))
+	for _, job := range jobs.Items {
+		run := CronJobRun{
+			Name:      job.Name,
+			StartedAt: job.CreationTimestamp.Time,
+			Status:    CronJobRunStatusRunning,
+		}
+		if job.Status.StartTime != nil {
+			run.StartedAt = job.Status.StartTime.Time
+		}
+		for _, condition := range job.Status.Conditions {
+			if condition.Status != corev1.ConditionTrue {
+				continue
+			}
+			switch condition.Type {
+			case batchv1.JobComplete:
+				run.Status = CronJobRunStatusSucceeded
+				run.CompletedAt = ptr.To(condition.LastTransitionTime.Time)
+			case batchv1.JobFailed:
+				run.Status = CronJobRunStatusFailed
+				run.CompletedAt = ptr.To(condition.LastTransitionTime.Time)
+				run.StatusReason = condition.Message
+			}
+		}
+		if job.Status.CompletionTime != nil {
+			run.CompletedAt = ptr.To(job.Status.CompletionTime.Time)
+		}
+		runs = append(runs, run)
+	}
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i].StartedAt.After(runs[j].StartedAt)
+	})
+	return runs, nil
+}
+
+func (p *TalosClusterCellProvider) CronJobRunLogs(ctx context.Context, cellId string, cronJob store.CronJob, runName string, opts ...DeploymentLogsOption) ([]LogEntry, error) {
+	options := processDeploymentLogsOptions(opts...)
+	clientset, err := p.initializeK8sClientForCell(cellId)
+	if err != nil {
+		return nil, err
+	}
+	job, err := clientset.BatchV1().Jobs(cronJob.Env.Name).Get(ctx, runName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting cron job run: %v", err)
+	}
+	// make sure the run belongs to this cron job
+	if job.Labels["onmetal.dev/app"] != cronJob.App.Name || job.Labels["onmetal.dev/cronjob"] != cronJob.Name {
+		return nil, fmt.Errorf("run %s does not belong to cron job %s", runName, cronJob.Name)
+	}
+	pods, err := clientset.CoreV1().Pods(cronJob.Env.Name).List(ctx, metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(job.Spec.Selector),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing cron job run pods: %v", err)
+	}
+
+	var logs []LogEntry
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1.PodPending {
+			continue
+		}
+		podLogOptions := &corev1.PodLogOptions{
+			Timestamps: true,
+		}
+		if options.Since != nil {
+			podLogOptions.SinceTime = &metav1.Time{Time: time.Now().Add(-*options.Since)}
+		}
+		podLogs, err := readPodLogs(ctx, clientset, pod, podLogOptions)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, podLogs...)
+	}
+	sort.Slice(logs, func(i, j int) bool {
+		return logs[i].Timestamp.Before(logs[j].Timestamp)
+	})
+	return logs, nil
+}
+
+// readPodLogs reads the logs of a pod without following them
+func readPodLogs(ctx context.Context, clientset *kubernetes.Clientset, pod corev1.Pod, podLogOptions *corev1.PodLogOptions) ([]LogEntry, error) {
+	podLogs, err := clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, podLogOptions).Stream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching logs for pod %s: %v", pod.Name, err)
+	}
+	defer podLogs.Close()
+
+	var logs []LogEntry
+	r := bufio.NewReader(podLogs)
+	for {
+		bytes, err := r.ReadBytes('\n')
+		if len(bytes) > 0 {
+			parts := strings.SplitN(string(bytes), " ", 2)
+			if len(parts) == 2 {
+				timestamp, err := time.Parse(time.RFC3339Nano, parts[0])
+				if err != nil {
+					timestamp = time.Now()
+				}
+				logs = append(logs, LogEntry{
+					Timestamp: timestamp,
+					Message:   parts[1],
+				})
+			}
+		}
+		if err != nil {
+			if err != io.EOF {
+				return nil, fmt.Errorf("error reading logs for pod %s: %v", pod.Name, err)
+			}
+			return logs, nil
+		}
+	}
+}
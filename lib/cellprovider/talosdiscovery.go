@@ -0,0 +1,55 @@
+package cellprovider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+)
+
+// Apps in an env share its namespace, so they reach each other through the services of their processes (see ensureService).
+// Each app's containers get <APP>_HOST, <APP>_PORT and <APP>_URL for every other app running in the env, pointing at the service of its web process,
+// or of its first process with ports if it has no web process with ports. The port is that process's first port, and the URL's scheme is its protocol, e.g. http://.
+// The env vars are set when a deployment rolls out, so apps that start running in the env later show up with the next deployment.
+
+// serviceEnvVarPrefix turns an app name into the prefix of the env vars that point at it, e.g. my-api becomes MY_API
+func serviceEnvVarPrefix(appName string) string {
+	return strings.ToUpper(strings.ReplaceAll(appName, "-", "_"))
+}
+
+// discoverablePort returns the process and port that other apps reach a deployment at, and false if none of its processes have ports
+func discoverablePort(deployment *store.Deployment) (store.Process, store.Port, bool) {
+	withPorts := lo.Filter(deployment.Processes(), func(p store.Process, _ int) bool { return len(p.Ports) > 0 })
+	if len(withPorts) == 0 {
+		return store.Process{}, store.Port{}, false
+	}
+	process, ok := lo.Find(withPorts, func(p store.Process) bool { return p.Name == store.DefaultProcessName })
+	if !ok {
+		process = withPorts[0]
+	}
+	return process, process.Ports[0], true
+}
+
+func (p *TalosClusterCellProvider) ServiceEnvVars(deployment *store.Deployment, siblings []store.Deployment) []store.EnvVar {
+	// sorted so that the env vars, and with them the k8s deployments, don't change when nothing else did
+	siblings = lo.Filter(siblings, func(d store.Deployment, _ int) bool { return d.AppId != deployment.AppId })
+	sort.Slice(siblings, func(i, j int) bool { return siblings[i].App.Name < siblings[j].App.Name })
+
+	var envVars []store.EnvVar
+	for _, sibling := range siblings {
+		process, port, ok := discoverablePort(&sibling)
+		if !ok {
+			continue
+		}
+		host := fmt.Sprintf("%s.%s.svc.cluster.local", processResourceName(&sibling, process), sibling.Env.Name)
+		prefix := serviceEnvVarPrefix(sibling.App.Name)
+		envVars = append(envVars,
+			store.EnvVar{Name: prefix + "_HOST", Value: host},
+			store.EnvVar{Name: prefix + "_PORT", Value: fmt.Sprintf("%d", port.Port)},
+			store.EnvVar{Name: prefix + "_URL", Value: fmt.Sprintf("%s://%s:%d", port.Proto, host, port.Port)},
+		)
+	}
+	return envVars
+}
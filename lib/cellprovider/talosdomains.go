@@ -0,0 +1,270 @@
+package cellprovider
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/onmetal-dev/metal/lib/logger"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// The gateway's wildcard listeners only match the cell's own hostname, so each custom domain gets an http and an https listener of its own, with a certificate for just that domain.
+// We don't control the DNS of custom domains, so their certificates are issued with HTTP-01 challenges that cert-manager solves through the gateway (this needs cert-manager's gateway API support).
+// A team's verified domains are rendered onto all of its cells. Unverified domains are never rendered.
+
+const domainIssuerName = "letsencrypt-production-http01"
+
+// domainResourceName encodes our convention for naming the certificate, secret and listeners of a custom domain
+func domainResourceName(hostname string) string {
+	return fmt.Sprintf("domain-%s", strings.ReplaceAll(hostname, ".", "-"))
+}
+
+func ensureDomainClusterIssuer(ctx context.Context, ctrlClient ctrlclient.Client) error {
+	return ensureClusterIssuer(ctx, ctrlClient, cmv1.ClusterIssuer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      domainIssuerName,
+			Namespace: "cert-manager",
+		},
+		Spec: cmv1.IssuerSpec{
+			IssuerConfig: cmv1.IssuerConfig{
+				ACME: &cmacme.ACMEIssuer{
+					Email:  "certs@onmetal.dev",
+					Server: "https://acme-v02.api.letsencrypt.org/directory",
+					PrivateKey: cmmeta.SecretKeySelector{
+						LocalObjectReference: cmmeta.LocalObjectReference{
+							Name: "letsencrypt-production-http01-private-key",
+						},
+					},
+					Solvers: []cmacme.ACMEChallengeSolver{
+						{
+							HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+								GatewayHTTPRoute: &cmacme.ACMEChallengeSolverHTTP01GatewayHTTPRoute{
+									ParentRefs: []gatewayv1.ParentReference{
+										{
+											Name:      gatewayName,
+											Namespace: lo.ToPtr(gatewayv1.Namespace(gatewayNamespace)),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+// verifiedDomainsForCell returns the verified custom domains of the team that owns the cell
+func (p *TalosClusterCellProvider) verifiedDomainsForCell(ctx context.Context, cell store.Cell) ([]store.Domain, error) {
+	domains, err := p.domainStore.GetForTeam(ctx, cell.TeamId)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching domains: %v", err)
+	}
+	return lo.Filter(domains, func(d store.Domain, _ int) bool { return d.Verified() }), nil
+}
+
+// domainListeners returns the gateway listeners that serve custom domains
+func domainListeners(domains []store.Domain) []gatewayv1.Listener {
+	var listeners []gatewayv1.Listener
+	allowedRoutes := &gatewayv1.AllowedRoutes{
+		Namespaces: &gatewayv1.RouteNamespaces{
+			From: lo.ToPtr(gatewayv1.FromNamespaces("All")),
+		},
+	}
+	for _, domain := range domains {
+		name := domainResourceName(domain.Hostname)
+		listeners = append(listeners,
+			gatewayv1.Listener{
+				Name:          gatewayv1.SectionName(fmt.Sprintf("http-%s", name)),
+				Protocol:      gatewayv1.HTTPProtocolType,
+				Port:          gatewayv1.PortNumber(80),
+				Hostname:      lo.ToPtr(gatewayv1.Hostname(domain.Hostname)),
+				AllowedRoutes: allowedRoutes,
+			},
+			gatewayv1.Listener{
+				Name:     gatewayv1.SectionName(fmt.Sprintf("https-%s", name)),
+				Protocol: gatewayv1.HTTPSProtocolType,
+				Port:     gatewayv1.PortNumber(443),
+				Hostname: lo.ToPtr(gatewayv1.Hostname(domain.Hostname)),
+				TLS: &gatewayv1.GatewayTLSConfig{
+					Mode: lo.ToPtr(gatewayv1.TLSModeTerminate),
+					CertificateRefs: []gatewayv1.SecretObjectReference{
+						{
+							Kind:      lo.ToPtr(gatewayv1.Kind("Secret")),
+							Name:      gatewayv1.ObjectName(name),
+							Namespace: lo.ToPtr(gatewayv1.Namespace(gatewayNamespace)),
+						},
+					},
+				},
+				AllowedRoutes: allowedRoutes,
+			},
+		)
+	}
+	return listeners
+}
+
+// ensureDomainCertificates requests a certificate for each domain, and deletes the certificates (and secrets) of domains that are gone
+func ensureDomainCertificates(ctx context.Context, ctrlClient ctrlclient.Client, domains []store.Domain) error {
+	log := logger.FromContext(ctx)
+	for _, domain := range domains {
+		name := domainResourceName(domain.Hostname)
+		certificate := &cmv1.Certificate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: gatewayNamespace,
+				Labels: map[string]string{
+					"onmetal.dev/custom-domain": "true",
+				},
+				Annotations: map[string]string{
+					"onmetal.dev/hostname": domain.Hostname,
+					"onmetal.dev/team-id":  domain.TeamId,
+				},
+			},
+			Spec: cmv1.CertificateSpec{
+				DNSNames: []string{domain.Hostname},
+				IssuerRef: cmmeta.ObjectReference{
+					Kind: "ClusterIssuer",
+					Name: domainIssuerName,
+				},
+				SecretName: name,
+			},
+		}
+		if err := createOrUpdateResource(ctx, ctrlClient, certificate); err != nil {
+			return fmt.Errorf("error creating or updating certificate: %v", err)
+		}
+	}
+
+	var existing cmv1.CertificateList
+	if err := ctrlClient.List(ctx, &existing, ctrlclient.InNamespace(gatewayNamespace), ctrlclient.MatchingLabels{"onmetal.dev/custom-domain": "true"}); err != nil {
+		return fmt.Errorf("error listing certificates: %v", err)
+	}
+	for _, certificate := range existing.Items {
+		if lo.ContainsBy(domains, func(d store.Domain) bool { return domainResourceName(d.Hostname) == certificate.Name }) {
+			continue
+		}
+		log.Info("deleting certificate of removed domain", slog.String("hostname", certificate.Annotations["onmetal.dev/hostname"]))
+		if err := ctrlClient.Delete(ctx, &certificate); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("error deleting certificate: %v", err)
+		}
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: certificate.Spec.SecretName, Namespace: gatewayNamespace}}
+		if err := ctrlClient.Delete(ctx, secret); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("error deleting certificate secret: %v", err)
+		}
+	}
+	return nil
+}
+
+func (p *TalosClusterCellProvider) SyncDomains(ctx context.Context, cellId string, deployment *store.Deployment) error {
+	clients, err := p.setupClients(ctx, cellId)
+	if err != nil {
+		return err
+	}
+	cell, err := p.cellStore.Get(cellId)
+	if err != nil {
+		return fmt.Errorf("error getting cell: %v", err)
+	}
+	domains, err := p.verifiedDomainsForCell(ctx, cell)
+	if err != nil {
+		return err
+	}
+	if err := ensureDomainCertificates(ctx, clients.ctrlClient, domains); err != nil {
+		return err
+	}
+	if err := p.createOrUpdateGateway(ctx, clients.k8sClient, clients.ctrlClient, cellId, domains); err != nil {
+		return fmt.Errorf("error updating gateway: %v", err)
+	}
+	if deployment == nil {
+		return nil
+	}
+
+	// only touch the hostnames of the routes, their backends may be mid-canary
+	httpRoutes, err := httpRoutesForDeployment(cellId, deployment, domains, nil)
+	if err != nil {
+		return fmt.Errorf("error getting http routes for deployment: %v", err)
+	}
+	for _, httpRoute := range httpRoutes {
+		var existing gatewayv1.HTTPRoute
+		if err := clients.ctrlClient.Get(ctx, ctrlclient.ObjectKey{Name: httpRoute.Name, Namespace: httpRoute.Namespace}, &existing); err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("error getting http route: %v", err)
+		}
+		existing.Spec.Hostnames = httpRoute.Spec.Hostnames
+		if err := clients.ctrlClient.Update(ctx, &existing); err != nil {
+			return fmt.Errorf("error updating http route: %v", err)
+		}
+	}
+	grpcRoutes, err := grpcRoutesForDeployment(cellId, deployment, domains, nil)
+	if err != nil {
+		return fmt.Errorf("error getting grpc routes for deployment: %v", err)
+	}
+	for _, grpcRoute := range grpcRoutes {
+		var existing gatewayv1.GRPCRoute
+		if err := clients.ctrlClient.Get(ctx, ctrlclient.ObjectKey{Name: grpcRoute.Name, Namespace: grpcRoute.Namespace}, &existing); err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("error getting grpc route: %v", err)
+		}
+		existing.Spec.Hostnames = grpcRoute.Spec.Hostnames
+		if err := clients.ctrlClient.Update(ctx, &existing); err != nil {
+			return fmt.Errorf("error updating grpc route: %v", err)
+		}
+	}
+	return nil
+}
+
+func (p *TalosClusterCellProvider) DomainCertificates(ctx context.Context, cellId string, domains []store.Domain) ([]DomainCertificate, error) {
+	clients, err := p.setupClients(ctx, cellId)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []DomainCertificate
+	for _, domain := range domains {
+		certificate := DomainCertificate{Hostname: domain.Hostname, Status: DomainCertificateStatusPending}
+		var cmCertificate cmv1.Certificate
+		if err := clients.ctrlClient.Get(ctx, ctrlclient.ObjectKey{Name: domainResourceName(domain.Hostname), Namespace: gatewayNamespace}, &cmCertificate); err != nil {
+			if !k8serrors.IsNotFound(err) {
+				return nil, fmt.Errorf("error getting certificate: %v", err)
+			}
+			certificate.StatusReason = "certificate not requested yet"
+			result = append(result, certificate)
+			continue
+		}
+
+		conditionOfType := func(conditionType cmv1.CertificateConditionType) cmv1.CertificateCondition {
+			condition, _ := lo.Find(cmCertificate.Status.Conditions, func(c cmv1.CertificateCondition) bool { return c.Type == conditionType })
+			return condition
+		}
+		ready, issuing := conditionOfType(cmv1.CertificateConditionReady), conditionOfType(cmv1.CertificateConditionIssuing)
+		switch {
+		case ready.Status == cmmeta.ConditionTrue:
+			certificate.Status = DomainCertificateStatusIssued
+			if cmCertificate.Status.NotAfter != nil {
+				certificate.ExpiresAt = &cmCertificate.Status.NotAfter.Time
+			}
+		case issuing.Reason == "Failed":
+			certificate.Status = DomainCertificateStatusFailed
+			certificate.StatusReason = issuing.Message
+		default:
+			certificate.Status = DomainCertificateStatusIssuing
+			certificate.StatusReason = ready.Message
+		}
+		result = append(result, certificate)
+	}
+	return result, nil
+}
@@ -0,0 +1,54 @@
+package cellprovider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/onmetal-dev/metal/lib/store"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// maxK8sEvents is how many k8s events are kept with a deployment event, the most recent ones
+const maxK8sEvents = 20
+
+// warningEvents returns the warnings k8s reported since the deployment started about the k8s deployment named name and
+// the replica sets and pods it owns, which are named after it
+func warningEvents(ctx context.Context, clientset *kubernetes.Clientset, deployment *store.Deployment, name string) ([]store.K8sEvent, error) {
+	events, err := clientset.CoreV1().Events(deployment.Env.Name).List(ctx, metav1.ListOptions{
+		FieldSelector: "type=" + corev1.EventTypeWarning,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing events: %v", err)
+	}
+	var result []store.K8sEvent
+	for _, event := range events.Items {
+		object := event.InvolvedObject
+		if object.Name != name && !strings.HasPrefix(object.Name, name+"-") {
+			continue
+		}
+		lastSeen := event.LastTimestamp.Time
+		if lastSeen.IsZero() {
+			lastSeen = event.EventTime.Time
+		}
+		if lastSeen.Before(deployment.CreatedAt) {
+			continue
+		}
+		result = append(result, store.K8sEvent{
+			Type:     event.Type,
+			Reason:   event.Reason,
+			Object:   fmt.Sprintf("%s/%s", object.Kind, object.Name),
+			Message:  event.Message,
+			Count:    int(event.Count),
+			LastSeen: lastSeen,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].LastSeen.Before(result[j].LastSeen) })
+	if len(result) > maxK8sEvents {
+		result = result[len(result)-maxK8sEvents:]
+	}
+	return result, nil
+}
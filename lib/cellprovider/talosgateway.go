@@ -9,6 +9,7 @@ import (
 	cmv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	"github.com/onmetal-dev/metal/lib/logger"
+	"github.com/onmetal-dev/metal/lib/store"
 	"github.com/samber/lo"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,7 +34,8 @@ func cellHostname(cellId string) string {
 // - istio is installed
 // - cert-manager is installed and a "letsencrypt-production" issuer is available
 // - external-dns is installed
-func (p *TalosClusterCellProvider) createOrUpdateGateway(ctx context.Context, k8sClient kubernetes.Interface, ctrlClient client.Client, cellId string) error {
+// Custom domains get listeners of their own, see domainListeners.
+func (p *TalosClusterCellProvider) createOrUpdateGateway(ctx context.Context, k8sClient kubernetes.Interface, ctrlClient client.Client, cellId string, domains []store.Domain) error {
 	log := logger.FromContext(ctx)
 	if err := ensureNamespaceWithLabels(ctx, k8sClient, gatewayNamespace, podSecurityLabels); err != nil {
 		return fmt.Errorf("failed to ensure gateway namespace: %w", err)
@@ -86,6 +88,12 @@ func (p *TalosClusterCellProvider) createOrUpdateGateway(ctx context.Context, k8
 		},
 		Spec: gatewayv1.GatewaySpec{
 			GatewayClassName: gatewayv1.ObjectName("istio"),
+			// lets the services of tcp ports share the gateway's address, see talosports.go
+			Infrastructure: &gatewayv1.GatewayInfrastructure{
+				Annotations: map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+					"metallb.universe.tf/allow-shared-ip": gatewayIPSharingKey,
+				},
+			},
 			Listeners: []gatewayv1.Listener{
 				{
 					Name:     gatewayv1.SectionName("http-subdomains-gateway"),
@@ -122,6 +130,7 @@ func (p *TalosClusterCellProvider) createOrUpdateGateway(ctx context.Context, k8
 			},
 		},
 	}
+	gateway.Spec.Listeners = append(gateway.Spec.Listeners, domainListeners(domains)...)
 
 	return createOrUpdateResource(ctx, ctrlClient, gateway)
 }
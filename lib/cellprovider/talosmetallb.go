@@ -35,19 +35,7 @@ func createOrUpdateResource(ctx context.Context, ctrlClient client.Client, obj c
 				return fmt.Errorf("failed to get existing %s: %w", resourceName, err)
 			}
 
-			// Update the existing resource with new spec and annotations
-			existingValue := reflect.ValueOf(existing).Elem()
-			newValue := reflect.ValueOf(obj).Elem()
-			// only set Spec if the field exists, since some resources like Secrets don't have it
-			if specField := existingValue.FieldByName("Spec"); specField.IsValid() {
-				specField.Set(newValue.FieldByName("Spec"))
-			}
-			if dataField := existingValue.FieldByName("Data"); dataField.IsValid() {
-				dataField.Set(newValue.FieldByName("Data"))
-			}
-			existingMeta := existingValue.FieldByName("ObjectMeta")
-			newMeta := newValue.FieldByName("ObjectMeta")
-			existingMeta.FieldByName("Annotations").Set(newMeta.FieldByName("Annotations"))
+			mergeForUpdate(existing, obj)
 			err = ctrlClient.Update(ctx, existing)
 			if err != nil {
 				return fmt.Errorf("failed to update %s: %w", resourceName, err)
@@ -59,6 +47,22 @@ func createOrUpdateResource(ctx context.Context, ctrlClient client.Client, obj c
 	return nil
 }
 
+// mergeForUpdate sets the fields of existing that createOrUpdateResource manages, i.e. its spec, data and annotations, to those of obj
+func mergeForUpdate(existing, obj client.Object) {
+	existingValue := reflect.ValueOf(existing).Elem()
+	newValue := reflect.ValueOf(obj).Elem()
+	// only set Spec if the field exists, since some resources like Secrets don't have it
+	if specField := existingValue.FieldByName("Spec"); specField.IsValid() {
+		specField.Set(newValue.FieldByName("Spec"))
+	}
+	if dataField := existingValue.FieldByName("Data"); dataField.IsValid() {
+		dataField.Set(newValue.FieldByName("Data"))
+	}
+	existingMeta := existingValue.FieldByName("ObjectMeta")
+	newMeta := newValue.FieldByName("ObjectMeta")
+	existingMeta.FieldByName("Annotations").Set(newMeta.FieldByName("Annotations"))
+}
+
 func (p *TalosClusterCellProvider) createOrUpdateMetalLBIPAddressPool(ctx context.Context, k8sClient *kubernetes.Clientset, ctrlClient client.Client) error {
 	nodeCIDRs, err := getNodeCidrs(ctx, k8sClient)
 	if err != nil {
@@ -0,0 +1,333 @@
+package cellprovider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/samber/lo"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// A plan builds the same objects handlePendingDeployment and rolloutDeployment would, and compares them to what the cell has.
+// Objects that exist are updated with a server-side dry run, so the diff shows the object as the API server would store it,
+// defaults and fields other controllers own included, rather than just the fields we set.
+
+// maskedEnvVarValue replaces the values of env vars in planned objects, since those are often secrets
+const maskedEnvVarValue = "********"
+
+// volatileAnnotations change with every deployment or rollout, so they are left out of diffs
+var volatileAnnotations = []string{
+	"onmetal.dev/deployment-id",
+	"kubernetes.io/change-cause",
+	"deployment.kubernetes.io/revision",
+}
+
+// plannedYAML renders an object for a diff: without status and server-managed metadata, with env var values masked
+func plannedYAML(obj runtime.Object) (string, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return "", fmt.Errorf("error converting object: %v", err)
+	}
+	delete(content, "status")
+	if metadata, ok := content["metadata"].(map[string]interface{}); ok {
+		for _, field := range []string{"managedFields", "resourceVersion", "uid", "generation", "creationTimestamp"} {
+			delete(metadata, field)
+		}
+	}
+	scrubPlanned(content)
+	out, err := yaml.Marshal(content)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling object: %v", err)
+	}
+	return string(out), nil
+}
+
+// scrubPlanned walks an unstructured object, masking env var values and dropping volatile annotations and empty fields
+func scrubPlanned(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			switch key {
+			case "env":
+				if envVars, ok := field.([]interface{}); ok {
+					for _, envVar := range envVars {
+						if envVar, ok := envVar.(map[string]interface{}); ok {
+							if _, ok := envVar["value"]; ok {
+								envVar["value"] = maskedEnvVarValue
+							}
+						}
+					}
+				}
+			case "annotations":
+				if annotations, ok := field.(map[string]interface{}); ok {
+					for _, annotation := range volatileAnnotations {
+						delete(annotations, annotation)
+					}
+				}
+			}
+			scrubPlanned(field)
+			if field == nil || isEmptyMap(field) {
+				delete(v, key)
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			scrubPlanned(item)
+		}
+	}
+}
+
+func isEmptyMap(value interface{}) bool {
+	m, ok := value.(map[string]interface{})
+	return ok && len(m) == 0
+}
+
+func unifiedDiff(from, to string) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from),
+		B:        difflib.SplitLines(to),
+		FromFile: "cell",
+		ToFile:   "planned",
+		Context:  3,
+	})
+}
+
+// planObject works out whether desired would be created or change the object that the cell has. existing is an empty object of
+// desired's type to fetch that into, and update applies desired to it the way the rollout does, returning the object to update it with.
+func planObject(ctx context.Context, ctrlClient ctrlclient.Client, kind string, desired, existing ctrlclient.Object, update func(existing ctrlclient.Object) ctrlclient.Object) (PlannedObject, error) {
+	planned := PlannedObject{Kind: kind, Name: desired.GetName()}
+	if err := ctrlClient.Get(ctx, ctrlclient.ObjectKeyFromObject(desired), existing); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return planned, fmt.Errorf("error getting existing %s: %v", kind, err)
+		}
+		to, err := plannedYAML(desired)
+		if err != nil {
+			return planned, err
+		}
+		planned.Action = PlannedObjectActionCreate
+		planned.Diff, err = unifiedDiff("", to)
+		return planned, err
+	}
+
+	from, err := plannedYAML(existing)
+	if err != nil {
+		return planned, err
+	}
+	updated := update(existing.DeepCopyObject().(ctrlclient.Object))
+	if err := ctrlClient.Update(ctx, updated, ctrlclient.DryRunAll); err != nil {
+		return planned, fmt.Errorf("error updating %s in a dry run: %v", kind, err)
+	}
+	to, err := plannedYAML(updated)
+	if err != nil {
+		return planned, err
+	}
+	if from == to {
+		planned.Action = PlannedObjectActionUnchanged
+		return planned, nil
+	}
+	planned.Action = PlannedObjectActionUpdate
+	planned.Diff, err = unifiedDiff(from, to)
+	return planned, err
+}
+
+// planDeletes returns the objects of the app that the rollout would delete, i.e. the ones of the given list type that aren't planned
+func planDeletes(ctx context.Context, ctrlClient ctrlclient.Client, deployment *store.Deployment, kind string, list ctrlclient.ObjectList, planned []PlannedObject) ([]PlannedObject, error) {
+	if err := ctrlClient.List(ctx, list, ctrlclient.InNamespace(deployment.Env.Name), ctrlclient.MatchingLabels{"onmetal.dev/app": deployment.App.Name}); err != nil {
+		return nil, fmt.Errorf("error listing %ss: %v", kind, err)
+	}
+	items, err := runtimeObjects(list)
+	if err != nil {
+		return nil, err
+	}
+	var deletes []PlannedObject
+	for _, item := range items {
+		if lo.ContainsBy(planned, func(p PlannedObject) bool { return p.Kind == kind && p.Name == item.GetName() }) {
+			continue
+		}
+		from, err := plannedYAML(item)
+		if err != nil {
+			return nil, err
+		}
+		diff, err := unifiedDiff(from, "")
+		if err != nil {
+			return nil, err
+		}
+		deletes = append(deletes, PlannedObject{Kind: kind, Name: item.GetName(), Action: PlannedObjectActionDelete, Diff: diff})
+	}
+	return deletes, nil
+}
+
+func runtimeObjects(list ctrlclient.ObjectList) ([]ctrlclient.Object, error) {
+	switch l := list.(type) {
+	case *appsv1.DeploymentList:
+		return lo.Map(l.Items, func(d appsv1.Deployment, _ int) ctrlclient.Object { return &d }), nil
+	case *corev1.ServiceList:
+		return lo.Map(l.Items, func(s corev1.Service, _ int) ctrlclient.Object { return &s }), nil
+	case *autoscalingv2.HorizontalPodAutoscalerList:
+		return lo.Map(l.Items, func(h autoscalingv2.HorizontalPodAutoscaler, _ int) ctrlclient.Object { return &h }), nil
+	}
+	return nil, fmt.Errorf("unexpected list type %T", list)
+}
+
+func (p *TalosClusterCellProvider) PlanDeployment(ctx context.Context, cellId string, deployment *store.Deployment) ([]PlannedObject, error) {
+	clients, err := p.setupClients(ctx, cellId)
+	if err != nil {
+		return nil, err
+	}
+	ctrlClient := clients.ctrlClient
+
+	var planned []PlannedObject
+	plan := func(kind string, desired, existing ctrlclient.Object, update func(existing ctrlclient.Object) ctrlclient.Object) error {
+		desired.SetNamespace(deployment.Env.Name)
+		object, err := planObject(ctx, ctrlClient, kind, desired, existing, update)
+		if err != nil {
+			return fmt.Errorf("error planning %s %s: %v", kind, desired.GetName(), err)
+		}
+		planned = append(planned, object)
+		return nil
+	}
+	// like createOrUpdateResource
+	updateSpec := func(desired ctrlclient.Object) func(ctrlclient.Object) ctrlclient.Object {
+		return func(existing ctrlclient.Object) ctrlclient.Object {
+			mergeForUpdate(existing, desired)
+			return existing
+		}
+	}
+
+	for _, volume := range deployment.AppSettings.Volumes.Data() {
+		pvc := pvcForVolume(deployment, volume)
+		if err := plan("PersistentVolumeClaim", pvc, &corev1.PersistentVolumeClaim{}, func(existing ctrlclient.Object) ctrlclient.Object {
+			// like ensureVolumes, only the size of an existing PVC changes
+			e := existing.(*corev1.PersistentVolumeClaim)
+			if e.Spec.Resources.Requests == nil {
+				e.Spec.Resources.Requests = corev1.ResourceList{}
+			}
+			e.Spec.Resources.Requests[corev1.ResourceStorage] = pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+			return e
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if hasReleasePhase(deployment) {
+		// every deployment gets a release job of its own
+		job, err := releaseJobForDeployment(deployment)
+		if err != nil {
+			return nil, err
+		}
+		to, err := plannedYAML(job)
+		if err != nil {
+			return nil, err
+		}
+		diff, err := unifiedDiff("", to)
+		if err != nil {
+			return nil, err
+		}
+		planned = append(planned, PlannedObject{Kind: "Job", Name: job.Name, Action: PlannedObjectActionCreate, Diff: diff})
+	}
+
+	for _, process := range deployment.Processes() {
+		if len(process.Ports) > 0 {
+			service, err := serviceForProcess(deployment, process, processResourceName(deployment, process), processLabels(deployment, process))
+			if err != nil {
+				return nil, err
+			}
+			if err := plan("Service", service, &corev1.Service{}, updateSpec(service)); err != nil {
+				return nil, err
+			}
+		}
+
+		k8sDeployment, err := k8sDeploymentForProcess(deployment, process)
+		if err != nil {
+			return nil, fmt.Errorf("error building deployment for process %s: %v", process.Name, err)
+		}
+		if err := plan("Deployment", k8sDeployment, &appsv1.Deployment{}, func(existing ctrlclient.Object) ctrlclient.Object {
+			// like rolloutDeployment, which replaces the k8s deployment
+			keepAutoscaledReplicas(k8sDeployment, existing.(*appsv1.Deployment), process)
+			k8sDeployment.ResourceVersion = existing.GetResourceVersion()
+			return k8sDeployment
+		}); err != nil {
+			return nil, err
+		}
+
+		if process.Autoscaling != nil {
+			hpa := hpaForProcess(deployment, process)
+			if err := plan("HorizontalPodAutoscaler", hpa, &autoscalingv2.HorizontalPodAutoscaler{}, func(existing ctrlclient.Object) ctrlclient.Object {
+				hpa.ResourceVersion = existing.GetResourceVersion()
+				return hpa
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	domains, err := p.domainStore.GetForAppEnv(ctx, deployment.AppId, deployment.EnvId)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching domains: %v", err)
+	}
+	newProcesses, err := canaryNewProcesses(ctx, ctrlClient, deployment)
+	if err != nil {
+		return nil, err
+	}
+	httpRoutes, err := httpRoutesForDeployment(cellId, deployment, domains, newProcesses)
+	if err != nil {
+		return nil, fmt.Errorf("error getting http routes for deployment: %v", err)
+	}
+	for i := range httpRoutes {
+		if err := plan("HTTPRoute", &httpRoutes[i], &gatewayv1.HTTPRoute{}, updateSpec(&httpRoutes[i])); err != nil {
+			return nil, err
+		}
+	}
+	grpcRoutes, err := grpcRoutesForDeployment(cellId, deployment, domains, newProcesses)
+	if err != nil {
+		return nil, fmt.Errorf("error getting grpc routes for deployment: %v", err)
+	}
+	for i := range grpcRoutes {
+		if err := plan("GRPCRoute", &grpcRoutes[i], &gatewayv1.GRPCRoute{}, updateSpec(&grpcRoutes[i])); err != nil {
+			return nil, err
+		}
+	}
+	tcpServices, err := tcpServicesForDeployment(deployment)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tcp services for deployment: %v", err)
+	}
+	for i := range tcpServices {
+		if err := plan("Service", &tcpServices[i], &corev1.Service{}, updateSpec(&tcpServices[i])); err != nil {
+			return nil, err
+		}
+	}
+
+	// the rollout deletes what belongs to processes, ports and autoscaling the app no longer has, and any canary
+	for _, kind := range []struct {
+		name string
+		list ctrlclient.ObjectList
+	}{
+		{"Deployment", &appsv1.DeploymentList{}},
+		{"Service", &corev1.ServiceList{}},
+		{"HorizontalPodAutoscaler", &autoscalingv2.HorizontalPodAutoscalerList{}},
+	} {
+		deletes, err := planDeletes(ctx, ctrlClient, deployment, kind.name, kind.list, planned)
+		if err != nil {
+			return nil, err
+		}
+		planned = append(planned, deletes...)
+	}
+
+	sort.SliceStable(planned, func(i, j int) bool {
+		if planned[i].Kind != planned[j].Kind {
+			return planned[i].Kind < planned[j].Kind
+		}
+		return planned[i].Name < planned[j].Name
+	})
+	return planned, nil
+}
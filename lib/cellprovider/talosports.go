@@ -0,0 +1,181 @@
+package cellprovider
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/onmetal-dev/metal/lib/logger"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// Besides http and https, external ports can be grpc or tcp.
+// grpc ports are GRPCRoutes on the gateway's https listener, so they are served over TLS on 443 of the same hostnames as the app's http routes.
+// tcp ports are LoadBalancer services that MetalLB gives an address of the cell's pool. They share the gateway's address (see gatewayIPSharingKey)
+// as long as no other service on it uses their port, otherwise they get another address of the pool, or wait for one to free up.
+
+// gatewayIPSharingKey lets MetalLB put the gateway's service and the services of tcp ports on the same address
+const gatewayIPSharingKey = "onmetal-gateway"
+
+// tcpServiceName encodes our convention for naming the LoadBalancer service of a tcp external port
+func tcpServiceName(deployment *store.Deployment, port store.ExternalPort) string {
+	return fmt.Sprintf("%s-%s-tcp", deployment.App.Name, port.Name)
+}
+
+// grpcRoutesForDeployment returns the GRPCRoutes that should be created for the grpc external ports of a deployment.
+// newProcesses are the processes a canary adds, see backendRefsForPort
+func grpcRoutesForDeployment(cellId string, deployment *store.Deployment, domains []store.Domain, newProcesses []string) ([]gatewayv1.GRPCRoute, error) {
+	grpcRoutes := []gatewayv1.GRPCRoute{}
+	hostnames := hostnamesForDeployment(cellId, deployment, domains)
+	for _, port := range deployment.AppSettings.ExternalPorts.Data() {
+		if port.Proto != "grpc" {
+			continue
+		}
+		process, err := processForExternalPort(deployment, port)
+		if err != nil {
+			return nil, err
+		}
+		grpcRoutes = append(grpcRoutes, gatewayv1.GRPCRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%s", deployment.App.Name, port.PortName),
+				Namespace: deployment.Env.Name,
+			},
+			Spec: gatewayv1.GRPCRouteSpec{
+				Hostnames: hostnames,
+				CommonRouteSpec: gatewayv1.CommonRouteSpec{
+					ParentRefs: []gatewayv1.ParentReference{
+						{
+							Group:     lo.ToPtr(gatewayv1.Group("gateway.networking.k8s.io")),
+							Kind:      lo.ToPtr(gatewayv1.Kind("Gateway")),
+							Name:      gatewayName,
+							Namespace: lo.ToPtr(gatewayv1.Namespace(gatewayNamespace)),
+							Port:      ptr.To(gatewayv1.PortNumber(443)),
+						},
+					},
+				},
+				Rules: []gatewayv1.GRPCRouteRule{
+					{
+						BackendRefs: lo.Map(backendRefsForPort(deployment, process, port, newProcesses), func(b gatewayv1.BackendRef, _ int) gatewayv1.GRPCBackendRef {
+							return gatewayv1.GRPCBackendRef{BackendRef: b}
+						}),
+					},
+				},
+			},
+		})
+	}
+	return grpcRoutes, nil
+}
+
+// tcpServicesForDeployment returns the LoadBalancer services that should be created for the tcp external ports of a deployment
+func tcpServicesForDeployment(deployment *store.Deployment) ([]corev1.Service, error) {
+	services := []corev1.Service{}
+	for _, port := range deployment.AppSettings.ExternalPorts.Data() {
+		if port.Proto != "tcp" {
+			continue
+		}
+		process, err := processForExternalPort(deployment, port)
+		if err != nil {
+			return nil, err
+		}
+		labels := processLabels(deployment, process)
+		labels["onmetal.dev/external-port"] = port.Name
+		services = append(services, corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      tcpServiceName(deployment, port),
+				Namespace: deployment.Env.Name,
+				Labels:    labels,
+				Annotations: map[string]string{
+					"onmetal.dev/app-id":                  deployment.App.Id,
+					"onmetal.dev/team-id":                 deployment.TeamId,
+					"metallb.universe.tf/allow-shared-ip": gatewayIPSharingKey,
+				},
+			},
+			Spec: corev1.ServiceSpec{
+				Type: corev1.ServiceTypeLoadBalancer,
+				Selector: map[string]string{
+					"app": processResourceName(deployment, process),
+				},
+				Ports: []corev1.ServicePort{
+					{
+						Name:       port.Name,
+						Port:       int32(port.Port),
+						Protocol:   corev1.ProtocolTCP,
+						TargetPort: intstr.FromString(port.PortName),
+					},
+				},
+			},
+		})
+	}
+	return services, nil
+}
+
+// ensureTCPServicesForDeployment creates or updates the services of the deployment's tcp external ports, and deletes the ones of tcp ports it no longer has.
+// A canary leaves them alone: tcp traffic can't be split by weight, so it keeps going to the regular k8s deployments until the canary is rolled out.
+func ensureTCPServicesForDeployment(ctx context.Context, ctrlClient ctrlclient.Client, deployment *store.Deployment) error {
+	if deployment.InCanaryPhase() {
+		return nil
+	}
+	log := logger.FromContext(ctx)
+	services, err := tcpServicesForDeployment(deployment)
+	if err != nil {
+		return fmt.Errorf("error getting tcp services for deployment: %v", err)
+	}
+	for _, service := range services {
+		if err := createOrUpdateResource(ctx, ctrlClient, &service); err != nil {
+			return fmt.Errorf("error creating or updating tcp service: %v", err)
+		}
+	}
+
+	var existing corev1.ServiceList
+	if err := ctrlClient.List(ctx, &existing, ctrlclient.InNamespace(deployment.Env.Name), ctrlclient.HasLabels{"onmetal.dev/external-port"}, ctrlclient.MatchingLabels{"onmetal.dev/app": deployment.App.Name}); err != nil {
+		return fmt.Errorf("error listing tcp services: %v", err)
+	}
+	for _, service := range existing.Items {
+		if lo.ContainsBy(services, func(s corev1.Service) bool { return s.Name == service.Name }) {
+			continue
+		}
+		log.Info("deleting tcp service", slog.String("externalPort", service.Labels["onmetal.dev/external-port"]))
+		if err := ctrlClient.Delete(ctx, &service); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("error deleting tcp service: %v", err)
+		}
+	}
+	return nil
+}
+
+func (p *TalosClusterCellProvider) Endpoints(ctx context.Context, cellId string, deployment *store.Deployment) ([]Endpoint, error) {
+	clientset, err := p.initializeK8sClientForCell(cellId)
+	if err != nil {
+		return nil, err
+	}
+
+	hostname := hostnameForDeployment(cellId, deployment)
+	var endpoints []Endpoint
+	for _, port := range deployment.AppSettings.ExternalPorts.Data() {
+		endpoint := Endpoint{Name: port.Name, Proto: port.Proto}
+		switch port.Proto {
+		case "http":
+			endpoint.Address = fmt.Sprintf("http://%s", hostname)
+		case "https":
+			endpoint.Address = fmt.Sprintf("https://%s", hostname)
+		case "grpc":
+			endpoint.Address = fmt.Sprintf("%s:443", hostname)
+		case "tcp":
+			service, err := clientset.CoreV1().Services(deployment.Env.Name).Get(ctx, tcpServiceName(deployment, port), metav1.GetOptions{})
+			if err != nil && !k8serrors.IsNotFound(err) {
+				return nil, fmt.Errorf("error getting tcp service: %v", err)
+			} else if err == nil && len(service.Status.LoadBalancer.Ingress) > 0 {
+				endpoint.Address = fmt.Sprintf("%s:%d", service.Status.LoadBalancer.Ingress[0].IP, port.Port)
+			}
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints, nil
+}
@@ -0,0 +1,120 @@
+package cellprovider
+
+import (
+	"testing"
+
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// portsDeployment is a deployment of an app with a web process that serves http and grpc, and a db process that serves tcp
+func portsDeployment(externalPorts store.ExternalPorts) *store.Deployment {
+	return &store.Deployment{
+		AppId:  "app_1",
+		EnvId:  "env_1",
+		TeamId: "team_1",
+		App:    store.App{Common: store.Common{Id: "app_1"}, Name: "shop"},
+		Env:    store.Env{Name: "production"},
+		AppSettings: store.AppSettings{
+			Processes: datatypes.NewJSONType(store.Processes{
+				{Name: "web", Ports: store.Ports{{Name: "http", Port: 8080, Proto: "http"}, {Name: "grpc", Port: 9090, Proto: "grpc"}}},
+				{Name: "db", Ports: store.Ports{{Name: "postgres", Port: 5432, Proto: "tcp"}}},
+			}),
+			ExternalPorts: datatypes.NewJSONType(externalPorts),
+		},
+	}
+}
+
+func TestGRPCRoutesForDeployment(t *testing.T) {
+	deployment := portsDeployment(store.ExternalPorts{
+		{Name: "web", PortName: "http", Proto: "https", Port: 443},
+		{Name: "api", PortName: "grpc", Proto: "grpc", Port: 443},
+		{Name: "db", PortName: "postgres", Proto: "tcp", Port: 5432},
+	})
+	domains := []store.Domain{
+		{AppId: "app_1", EnvId: "env_1", Hostname: "api.shop.example", VerificationStatus: store.DomainVerificationStatusVerified},
+		{AppId: "app_1", EnvId: "env_1", Hostname: "unverified.shop.example"},
+	}
+
+	routes, err := grpcRoutesForDeployment("cell_1", deployment, domains, nil)
+	require.NoError(t, err)
+	require.Len(t, routes, 1, "Expected a route for the grpc port only")
+	route := routes[0]
+	assert.Equal(t, "shop-grpc", route.Name)
+	assert.Equal(t, "production", route.Namespace)
+	assert.Equal(t, []gatewayv1.Hostname{"shop-production.cell-1.up.onmetal.run", "api.shop.example"}, route.Spec.Hostnames)
+	require.Len(t, route.Spec.ParentRefs, 1)
+	assert.Equal(t, gatewayv1.ObjectName(gatewayName), route.Spec.ParentRefs[0].Name)
+	assert.Equal(t, gatewayv1.PortNumber(443), *route.Spec.ParentRefs[0].Port, "Expected grpc to be served on the https listener")
+	require.Len(t, route.Spec.Rules, 1)
+	require.Len(t, route.Spec.Rules[0].BackendRefs, 1)
+	backend := route.Spec.Rules[0].BackendRefs[0]
+	assert.Equal(t, gatewayv1.ObjectName("shop"), backend.Name, "Expected the service of the web process")
+	assert.Equal(t, gatewayv1.PortNumber(443), *backend.Port)
+
+	t.Run("canary", func(t *testing.T) {
+		canary := portsDeployment(deployment.AppSettings.ExternalPorts.Data())
+		canary.CanarySteps = datatypes.NewJSONType([]int{25})
+		canary.CanaryWeight = 25
+		routes, err := grpcRoutesForDeployment("cell_1", canary, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, routes, 1)
+		backends := routes[0].Spec.Rules[0].BackendRefs
+		require.Len(t, backends, 2)
+		assert.Equal(t, gatewayv1.ObjectName("shop"), backends[0].Name)
+		assert.Equal(t, int32(75), *backends[0].Weight)
+		assert.Equal(t, gatewayv1.ObjectName("shop-canary"), backends[1].Name)
+		assert.Equal(t, int32(25), *backends[1].Weight)
+	})
+
+	t.Run("unknown container port", func(t *testing.T) {
+		_, err := grpcRoutesForDeployment("cell_1", portsDeployment(store.ExternalPorts{{Name: "api", PortName: "rpc", Proto: "grpc", Port: 443}}), nil, nil)
+		assert.ErrorContains(t, err, "container port rpc but it doesn't exist")
+	})
+}
+
+func TestHTTPRoutesForDeploymentSkipsTCPAndGRPCPorts(t *testing.T) {
+	deployment := portsDeployment(store.ExternalPorts{
+		{Name: "web", PortName: "http", Proto: "http", Port: 80},
+		{Name: "api", PortName: "grpc", Proto: "grpc", Port: 443},
+		{Name: "db", PortName: "postgres", Proto: "tcp", Port: 5432},
+	})
+	routes, err := httpRoutesForDeployment("cell_1", deployment, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+	assert.Equal(t, "shop-http", routes[0].Name)
+	assert.Equal(t, gatewayv1.PortNumber(80), *routes[0].Spec.ParentRefs[0].Port)
+
+	_, err = httpRoutesForDeployment("cell_1", portsDeployment(store.ExternalPorts{{Name: "web", PortName: "http", Proto: "udp", Port: 80}}), nil, nil)
+	assert.ErrorContains(t, err, "unsupported protocol in external port web: udp")
+}
+
+func TestTCPServicesForDeployment(t *testing.T) {
+	deployment := portsDeployment(store.ExternalPorts{
+		{Name: "web", PortName: "http", Proto: "http", Port: 80},
+		{Name: "db", PortName: "postgres", Proto: "tcp", Port: 15432},
+	})
+
+	services, err := tcpServicesForDeployment(deployment)
+	require.NoError(t, err)
+	require.Len(t, services, 1, "Expected a service for the tcp port only")
+	service := services[0]
+	assert.Equal(t, "shop-db-tcp", service.Name)
+	assert.Equal(t, "production", service.Namespace)
+	assert.Equal(t, "db", service.Labels["onmetal.dev/external-port"])
+	assert.Equal(t, "shop", service.Labels["onmetal.dev/app"])
+	assert.Equal(t, gatewayIPSharingKey, service.Annotations["metallb.universe.tf/allow-shared-ip"])
+	assert.Equal(t, corev1.ServiceTypeLoadBalancer, service.Spec.Type)
+	assert.Equal(t, map[string]string{"app": "shop-db"}, service.Spec.Selector, "Expected the pods of the db process")
+	assert.Equal(t, []corev1.ServicePort{{
+		Name:       "db",
+		Port:       15432,
+		Protocol:   corev1.ProtocolTCP,
+		TargetPort: intstr.FromString("postgres"),
+	}}, service.Spec.Ports)
+}
@@ -0,0 +1,236 @@
+package cellprovider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/onmetal-dev/metal/lib/logger"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// releaseJobDeadline is how long a release command may run before k8s kills it and the deployment fails
+	releaseJobDeadline = 30 * time.Minute
+	// releaseJobTTL is how long finished release jobs (and their logs) stick around
+	releaseJobTTL = 24 * time.Hour
+	// releaseJobStartTimeout bounds how long DeploymentLogsStream waits for a release job to show up
+	releaseJobStartTimeout = time.Minute
+)
+
+// hasReleasePhase returns true if the deployment should run the app's release command before rolling out.
+// Scales and restarts reuse an image that was already released, so they skip it.
+func hasReleasePhase(deployment *store.Deployment) bool {
+	return deployment.AppSettings.ReleaseCommand != "" &&
+		deployment.Type != store.DeploymentTypeScale &&
+		deployment.Type != store.DeploymentTypeRestart
+}
+
+// releaseJobName encodes our convention for naming release jobs. Job names end up in a label, so they must fit in 63 characters.
+func releaseJobName(deployment *store.Deployment) string {
+	suffix := fmt.Sprintf("-release-%d", deployment.Id)
+	appName := deployment.App.Name
+	if len(appName)+len(suffix) > 63 {
+		appName = appName[:63-len(suffix)]
+	}
+	return appName + suffix
+}
+
+// ensureReleaseJob creates the k8s Job that runs the release command for a deployment, using the deployment's image and env vars
+func ensureReleaseJob(ctx context.Context, k8sClient *kubernetes.Clientset, deployment *store.Deployment) error {
+	job, err := releaseJobForDeployment(deployment)
+	if err != nil {
+		return err
+	}
+	if _, err := k8sClient.BatchV1().Jobs(deployment.Env.Name).Create(ctx, job, metav1.CreateOptions{}); err != nil {
+		// a previous attempt may have created the job before failing
+		if k8serrors.IsAlreadyExists(err) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// releaseJobForDeployment builds the k8s Job that runs the release command for a deployment
+func releaseJobForDeployment(deployment *store.Deployment) (*batchv1.Job, error) {
+	limits, requests, err := getResourceLimits(deployment.AppSettings.Resources.Data())
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource limits: %v", err)
+	}
+	// deliberately not using the "app" label, otherwise the service and deployment log streaming would pick up the release pod
+	labels := map[string]string{
+		"onmetal.dev/release": deployment.App.Name,
+	}
+	annotations := map[string]string{
+		"onmetal.dev/app-id":        deployment.App.Id,
+		"onmetal.dev/team-id":       deployment.TeamId,
+		"onmetal.dev/deployment-id": fmt.Sprintf("%d", deployment.Id),
+	}
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        releaseJobName(deployment),
+			Namespace:   deployment.Env.Name,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            ptr.To(int32(0)),
+			ActiveDeadlineSeconds:   ptr.To(int64(releaseJobDeadline.Seconds())),
+			TTLSecondsAfterFinished: ptr.To(int32(releaseJobTTL.Seconds())),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      labels,
+					Annotations: annotations,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					ImagePullSecrets: []corev1.LocalObjectReference{
+						{
+							Name: dockerconfigjsonSecretName,
+						},
+					},
+					Containers: []corev1.Container{
+						{
+							Name:    "release",
+							Image:   deployment.AppSettings.Artifact.Data().Image.Name(),
+							Command: []string{"/bin/sh", "-c", deployment.AppSettings.ReleaseCommand},
+							Env:     convertEnvVars(deployment.AppEnvVars.EnvVars.Data()),
+							Resources: corev1.ResourceRequirements{
+								Limits:   limits,
+								Requests: requests,
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// handleReleasingDeployment waits for the release job to finish. Only once it succeeds do we roll out the new k8s deployment.
+func (p *TalosClusterCellProvider) handleReleasingDeployment(ctx context.Context, cellId string, deployment *store.Deployment) (*AdvanceDeploymentResult, error) {
+	log := logger.FromContext(ctx)
+	clientset, err := p.initializeK8sClientForCell(cellId)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := clientset.BatchV1().Jobs(deployment.Env.Name).Get(ctx, releaseJobName(deployment), metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting release job: %v", err)
+	}
+	pods, err := clientset.CoreV1().Pods(deployment.Env.Name).List(ctx, metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(job.Spec.Selector),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing release job pods: %v", err)
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			log.Info("release command succeeded, rolling out")
+			return rolloutDeployment(ctx, clientset, deployment)
+		case batchv1.JobFailed:
+			return &AdvanceDeploymentResult{
+				Status:       store.DeploymentStatusFailed,
+				StatusReason: releaseFailureReason(pods.Items, condition),
+			}, nil
+		}
+	}
+
+	// a pod that can't pull its image never fails the job on its own, so catch that here
+	for _, pod := range pods.Items {
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			if containerStatus.State.Waiting != nil && lo.Contains([]string{"ErrImagePull", "ImagePullBackOff"}, containerStatus.State.Waiting.Reason) {
+				return &AdvanceDeploymentResult{
+					Status:       store.DeploymentStatusFailed,
+					StatusReason: fmt.Sprintf("release command failed to start: %s", containerStatus.State.Waiting.Message),
+				}, nil
+			}
+		}
+	}
+
+	return &AdvanceDeploymentResult{
+		Status: store.DeploymentStatusReleasing,
+	}, nil
+}
+
+// releaseFailureReason prefers the exit status of the release container, falling back to the job's failure condition (e.g. the deadline was exceeded)
+func releaseFailureReason(pods []corev1.Pod, condition batchv1.JobCondition) string {
+	for _, pod := range pods {
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			terminated := containerStatus.State.Terminated
+			if terminated == nil || terminated.ExitCode == 0 {
+				continue
+			}
+			reason := fmt.Sprintf("release command failed with exit code %d (%s)", terminated.ExitCode, terminated.Reason)
+			if terminated.Message != "" {
+				reason += ": " + terminated.Message
+			}
+			return reason
+		}
+	}
+	return fmt.Sprintf("release command failed: %s: %s", condition.Reason, condition.Message)
+}
+
+// streamReleaseJobLogs follows the logs of a deployment's release job until the release command exits.
+// Log streams are usually opened right after a deployment is queued, so if the deployment hasn't been released yet we wait a bit for the job's pod to start.
+func streamReleaseJobLogs(ctx context.Context, clientset *kubernetes.Clientset, deployment *store.Deployment, options DeploymentLogsOptions, logs chan<- DeploymentLogsResult) error {
+	waitForJob := deployment.Status == store.DeploymentStatusPending || deployment.Status == store.DeploymentStatusReleasing
+	deadline := time.Now().Add(releaseJobStartTimeout)
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+	for {
+		job, err := clientset.BatchV1().Jobs(deployment.Env.Name).Get(ctx, releaseJobName(deployment), metav1.GetOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("error getting release job: %v", err)
+		}
+		if err == nil {
+			pods, err := clientset.CoreV1().Pods(deployment.Env.Name).List(ctx, metav1.ListOptions{
+				LabelSelector: metav1.FormatLabelSelector(job.Spec.Selector),
+			})
+			if err != nil {
+				return fmt.Errorf("error listing release job pods: %v", err)
+			}
+			for _, pod := range pods.Items {
+				if pod.Status.Phase == corev1.PodPending {
+					continue
+				}
+				podLogOptions := &corev1.PodLogOptions{
+					Timestamps: true,
+					Follow:     true,
+				}
+				if options.Since != nil {
+					podLogOptions.SinceTime = &metav1.Time{Time: time.Now().Add(-*options.Since)}
+				}
+				return streamPodLogs(ctx, clientset, pod, podLogOptions, logs)
+			}
+			if job.Status.CompletionTime != nil || job.Status.Failed > 0 {
+				return nil
+			}
+		} else if !waitForJob {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package cellprovider
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/onmetal-dev/metal/lib/logger"
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Volumes are PVCs in the env's namespace, provisioned by the default storage class (rook-ceph RBD, see Janitor).
+// RBD volumes are ReadWriteOnce, so processes that mount one are rolled out with the Recreate strategy: the old pod lets go of the volume before the new one mounts it.
+// The PVCs are never deleted by a deployment, only by DestroyVolumes when the app is deleted.
+
+// volumeResourceName encodes our convention for naming the PVC of a volume
+func volumeResourceName(deployment *store.Deployment, volume store.Volume) string {
+	return fmt.Sprintf("%s-%s", deployment.App.Name, volume.Name)
+}
+
+// pvcForVolume builds the PVC that backs a volume
+func pvcForVolume(deployment *store.Deployment, volume store.Volume) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: volumeResourceName(deployment, volume),
+			Labels: map[string]string{
+				"onmetal.dev/app":    deployment.App.Name,
+				"onmetal.dev/volume": volume.Name,
+			},
+			Annotations: map[string]string{
+				"onmetal.dev/app-id":  deployment.App.Id,
+				"onmetal.dev/team-id": deployment.TeamId,
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: resource.MustParse(fmt.Sprintf("%dGi", volume.SizeGiB)),
+				},
+			},
+		},
+	}
+}
+
+// ensureVolumes creates the PVCs of the deployment's volumes, and grows existing ones whose size went up. Volumes can't shrink.
+func ensureVolumes(ctx context.Context, k8sClient *kubernetes.Clientset, deployment *store.Deployment) error {
+	log := logger.FromContext(ctx)
+	pvcs := k8sClient.CoreV1().PersistentVolumeClaims(deployment.Env.Name)
+	for _, volume := range deployment.AppSettings.Volumes.Data() {
+		pvc := pvcForVolume(deployment, volume)
+		existing, err := pvcs.Get(ctx, pvc.Name, metav1.GetOptions{})
+		if err != nil {
+			if !k8serrors.IsNotFound(err) {
+				return fmt.Errorf("error checking existing pvc: %v", err)
+			}
+			log.Info("creating pvc", slog.String("volume", volume.Name), slog.Int("sizeGiB", volume.SizeGiB))
+			if _, err := pvcs.Create(ctx, pvc, metav1.CreateOptions{}); err != nil {
+				return fmt.Errorf("error creating pvc: %v", err)
+			}
+			continue
+		}
+
+		size := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		switch size.Cmp(existing.Spec.Resources.Requests[corev1.ResourceStorage]) {
+		case -1:
+			return fmt.Errorf("volume %s is larger than %d GiB already and can't shrink", volume.Name, volume.SizeGiB)
+		case 1:
+			log.Info("growing pvc", slog.String("volume", volume.Name), slog.Int("sizeGiB", volume.SizeGiB))
+			existing.Spec.Resources.Requests[corev1.ResourceStorage] = size
+			if _, err := pvcs.Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+				return fmt.Errorf("error growing pvc: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+// podVolumesForProcess returns the pod volumes and container mounts for the volumes of a process
+func podVolumesForProcess(deployment *store.Deployment, process store.Process) ([]corev1.Volume, []corev1.VolumeMount) {
+	volumes := deployment.AppSettings.Volumes.Data().ForProcess(process.Name)
+	podVolumes := lo.Map(volumes, func(v store.Volume, _ int) corev1.Volume {
+		return corev1.Volume{
+			Name: v.Name,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: volumeResourceName(deployment, v),
+				},
+			},
+		}
+	})
+	mounts := lo.Map(volumes, func(v store.Volume, _ int) corev1.VolumeMount {
+		return corev1.VolumeMount{
+			Name:      v.Name,
+			MountPath: v.MountPath,
+		}
+	})
+	return podVolumes, mounts
+}
+
+func (p *TalosClusterCellProvider) DestroyVolumes(ctx context.Context, cellId string, deployments []store.Deployment) error {
+	log := logger.FromContext(ctx)
+	clientset, err := p.initializeK8sClientForCell(cellId)
+	if err != nil {
+		return err
+	}
+
+	// an app's PVCs live in the namespace of each env it was deployed to
+	for _, deployment := range lo.UniqBy(deployments, func(d store.Deployment) string { return d.AppId + "/" + d.EnvId }) {
+		pvcs := clientset.CoreV1().PersistentVolumeClaims(deployment.Env.Name)
+		existing, err := pvcs.List(ctx, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("onmetal.dev/app=%s,onmetal.dev/volume", deployment.App.Name),
+		})
+		if err != nil {
+			return fmt.Errorf("error listing pvcs: %v", err)
+		}
+		for _, pvc := range existing.Items {
+			log.Info("deleting pvc", slog.String("pvc", pvc.Name), slog.String("env", deployment.Env.Name))
+			if err := pvcs.Delete(ctx, pvc.Name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+				return fmt.Errorf("error deleting pvc: %v", err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package cellprovider
+
+import (
+	"testing"
+
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func volumesDeployment(volumes store.Volumes) *store.Deployment {
+	return &store.Deployment{
+		AppId:  "app_1",
+		TeamId: "team_1",
+		App:    store.App{Common: store.Common{Id: "app_1"}, Name: "shop"},
+		Env:    store.Env{Name: "production"},
+		AppSettings: store.AppSettings{
+			Artifact:  datatypes.NewJSONType(store.Artifact{Image: &store.ImageArtifact{Repository: "shop", Tag: "v1"}}),
+			Processes: datatypes.NewJSONType(store.Processes{{Name: "web", Replicas: 1}, {Name: "worker", Replicas: 1}}),
+			Volumes:   datatypes.NewJSONType(volumes),
+		},
+	}
+}
+
+func TestPVCForVolume(t *testing.T) {
+	testCases := []struct {
+		sizeGiB      int
+		expectedSize string
+	}{
+		{1, "1Gi"},
+		{5, "5Gi"},
+		{1024, "1Ti"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.expectedSize, func(t *testing.T) {
+			volume := store.Volume{Name: "data", MountPath: "/data", SizeGiB: tc.sizeGiB}
+			pvc := pvcForVolume(volumesDeployment(store.Volumes{volume}), volume)
+			assert.Equal(t, "shop-data", pvc.Name)
+			assert.Equal(t, map[string]string{"onmetal.dev/app": "shop", "onmetal.dev/volume": "data"}, pvc.Labels)
+			assert.Equal(t, map[string]string{"onmetal.dev/app-id": "app_1", "onmetal.dev/team-id": "team_1"}, pvc.Annotations)
+			assert.Equal(t, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}, pvc.Spec.AccessModes)
+			size := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+			assert.Zero(t, size.Cmp(resource.MustParse(tc.expectedSize)), "Expected %s, got %s", tc.expectedSize, size.String())
+			assert.Equal(t, int64(tc.sizeGiB)<<30, size.Value())
+		})
+	}
+}
+
+func TestK8sDeploymentForProcessVolumes(t *testing.T) {
+	deployment := volumesDeployment(store.Volumes{{Name: "data", MountPath: "/data", SizeGiB: 5}})
+	processes := deployment.AppSettings.Processes.Data()
+
+	web, err := k8sDeploymentForProcess(deployment, processes[0])
+	require.NoError(t, err)
+	assert.Equal(t, appsv1.RecreateDeploymentStrategyType, web.Spec.Strategy.Type, "Expected a process with a volume to be recreated")
+	podSpec := web.Spec.Template.Spec
+	require.Len(t, podSpec.Volumes, 1)
+	assert.Equal(t, "data", podSpec.Volumes[0].Name)
+	require.NotNil(t, podSpec.Volumes[0].PersistentVolumeClaim)
+	assert.Equal(t, "shop-data", podSpec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Equal(t, []corev1.VolumeMount{{Name: "data", MountPath: "/data"}}, podSpec.Containers[0].VolumeMounts)
+
+	worker, err := k8sDeploymentForProcess(deployment, processes[1])
+	require.NoError(t, err)
+	assert.Equal(t, appsv1.RollingUpdateDeploymentStrategyType, worker.Spec.Strategy.Type, "Expected a process without volumes to be rolled")
+	assert.Empty(t, worker.Spec.Template.Spec.Volumes)
+	assert.Empty(t, worker.Spec.Template.Spec.Containers[0].VolumeMounts)
+}
@@ -0,0 +1,185 @@
+package approval
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/onmetal-dev/metal/lib/cli/common"
+	"github.com/onmetal-dev/metal/lib/cli/style"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+)
+
+type Msg struct {
+	Success *oapi.Deployment
+	Error   error
+}
+
+type model struct {
+	loading     spinner.Model
+	loadingText string
+	run         func() tea.Msg
+	success     func(d oapi.Deployment) string
+	msg         *Msg
+}
+
+var _ tea.Model = (*model)(nil)
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.loading.Tick, m.run)
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.loading, cmd = m.loading.Update(msg)
+		return m, cmd
+	case Msg:
+		m.msg = &msg
+		return m, tea.Quit
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyCtrlC:
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	if m.msg == nil {
+		return fmt.Sprintf("\n %s %s\n\n", m.loading.View(), lipgloss.NewStyle().Foreground(style.BaseLight).Render(m.loadingText))
+	}
+	if m.msg.Error != nil {
+		return fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Error).Render(fmt.Sprintf("Error: %v", m.msg.Error)))
+	}
+	return fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Success).Render(m.success(*m.msg.Success)))
+}
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approval",
+		Short: "Approve or reject a deployment to a protected environment",
+		Long:  "New deployments to a protected environment wait in pending-approval until a team admin other than whoever asked for them approves them. Rejected deployments are never rolled out.",
+	}
+	cmd.PersistentFlags().StringP("app", "a", "", "Name of the app")
+	cmd.PersistentFlags().StringP("env", "e", "", "Name of the environment")
+	cmd.PersistentFlags().IntP("deployment", "d", 0, "Id of the deployment. Defaults to the latest one pending approval")
+	cmd.PersistentFlags().StringP("message", "m", "", "Comment recorded with the approval or rejection")
+	cmd.MarkPersistentFlagRequired("app")
+	cmd.MarkPersistentFlagRequired("env")
+
+	approveCmd := &cobra.Command{
+		Use:     "approve",
+		Short:   "Approve a deployment and start rolling it out",
+		Example: "  metal approval approve -a myapp -e production -m 'checked the migration'",
+		PreRun:  common.CheckToken,
+		Run:     runApprove,
+	}
+
+	rejectCmd := &cobra.Command{
+		Use:     "reject",
+		Short:   "Reject a deployment so it is never rolled out",
+		Example: "  metal approval reject -a myapp -e production -d 42 -m 'wait until after the launch'",
+		PreRun:  common.CheckToken,
+		Run:     runReject,
+	}
+
+	cmd.AddCommand(approveCmd, rejectCmd)
+	return cmd
+}
+
+func runProgram(m model) {
+	m.loading = common.NewSpinner()
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
+
+// reviewRequest builds the request body from the flags shared by approve and reject
+func reviewRequest(cmd *cobra.Command) oapi.ReviewDeploymentRequest {
+	deploymentId, _ := cmd.Flags().GetInt("deployment")
+	return oapi.ReviewDeploymentRequest{
+		DeploymentId: lo.EmptyableToPtr(deploymentId),
+		Comment:      lo.EmptyableToPtr(cmd.Flags().Lookup("message").Value.String()),
+	}
+}
+
+func runApprove(cmd *cobra.Command, args []string) {
+	appName := cmd.Flags().Lookup("app").Value.String()
+	envName := cmd.Flags().Lookup("env").Value.String()
+	runProgram(model{
+		loadingText: fmt.Sprintf("approving deployment of %s in %s...", appName, envName),
+		run:         ApproveCmd(common.MustApiClient(), appName, envName, reviewRequest(cmd)),
+		success: func(d oapi.Deployment) string {
+			return fmt.Sprintf("✅ approved deployment %d of %s in %s, it is rolling out", d.Id, appName, envName)
+		},
+	})
+}
+
+func runReject(cmd *cobra.Command, args []string) {
+	appName := cmd.Flags().Lookup("app").Value.String()
+	envName := cmd.Flags().Lookup("env").Value.String()
+	runProgram(model{
+		loadingText: fmt.Sprintf("rejecting deployment of %s in %s...", appName, envName),
+		run:         RejectCmd(common.MustApiClient(), appName, envName, reviewRequest(cmd)),
+		success: func(d oapi.Deployment) string {
+			return fmt.Sprintf("✅ rejected deployment %d of %s in %s", d.Id, appName, envName)
+		},
+	})
+}
+
+// appEnvIds resolves app and env names to ids
+func appEnvIds(ctx context.Context, apiClient oapi.ClientWithResponsesInterface, appName, envName string) (string, string, error) {
+	app, err := common.FindAppByName(ctx, apiClient, appName)
+	if err != nil {
+		return "", "", err
+	}
+	env, err := common.FindEnvByName(ctx, apiClient, envName)
+	if err != nil {
+		return "", "", err
+	}
+	return app.Id, env.Id, nil
+}
+
+func ApproveCmd(apiClient oapi.ClientWithResponsesInterface, appName, envName string, body oapi.ReviewDeploymentRequest) func() tea.Msg {
+	return func() tea.Msg {
+		ctx := context.Background()
+		appId, envId, err := appEnvIds(ctx, apiClient, appName, envName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		resp, err := apiClient.ApproveDeploymentWithResponse(ctx, appId, envId, body)
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusOK {
+			return Msg{Error: fmt.Errorf("API returned non-200 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		return Msg{Success: resp.JSON200}
+	}
+}
+
+func RejectCmd(apiClient oapi.ClientWithResponsesInterface, appName, envName string, body oapi.ReviewDeploymentRequest) func() tea.Msg {
+	return func() tea.Msg {
+		ctx := context.Background()
+		appId, envId, err := appEnvIds(ctx, apiClient, appName, envName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		resp, err := apiClient.RejectDeploymentWithResponse(ctx, appId, envId, body)
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusOK {
+			return Msg{Error: fmt.Errorf("API returned non-200 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		return Msg{Success: resp.JSON200}
+	}
+}
@@ -0,0 +1,161 @@
+package autoscale
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/onmetal-dev/metal/lib/cli/common"
+	"github.com/onmetal-dev/metal/lib/cli/style"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/spf13/cobra"
+)
+
+type Msg struct {
+	Success *oapi.Deployment
+	Error   error
+}
+
+type model struct {
+	loading      spinner.Model
+	apiClient    oapi.ClientWithResponsesInterface
+	app          string
+	env          string
+	process      string
+	autoscaling  *oapi.Autoscaling
+	autoscaleMsg *Msg
+}
+
+var _ tea.Model = (*model)(nil)
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.loading.Tick, AutoscaleCmd(m.apiClient, m.app, m.env, m.process, m.autoscaling))
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.loading, cmd = m.loading.Update(msg)
+		return m, cmd
+	case Msg:
+		m.autoscaleMsg = &msg
+		return m, tea.Quit
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyCtrlC:
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+// target is what is being autoscaled, e.g. "myapp" or "myapp worker"
+func (m model) target() string {
+	if m.process == "" {
+		return m.app
+	}
+	return fmt.Sprintf("%s %s", m.app, m.process)
+}
+
+// change describes the new autoscaling, e.g. "autoscale myapp between 2 and 10 replicas"
+func (m model) change() string {
+	if m.autoscaling == nil {
+		return fmt.Sprintf("turn off autoscaling of %s", m.target())
+	}
+	return fmt.Sprintf("autoscale %s between %d and %d replicas", m.target(), m.autoscaling.MinReplicas, m.autoscaling.MaxReplicas)
+}
+
+func (m model) View() string {
+	if m.autoscaleMsg == nil {
+		return fmt.Sprintf("\n %s %s\n\n", m.loading.View(), lipgloss.NewStyle().Foreground(style.BaseLight).Render(fmt.Sprintf("updating %s in %s...", m.target(), m.env)))
+	}
+	if m.autoscaleMsg.Error != nil {
+		return fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Error).Render(fmt.Sprintf("Error: %v", m.autoscaleMsg.Error)))
+	}
+	d := m.autoscaleMsg.Success
+	return fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Success).Render(fmt.Sprintf("✅ deployment %d created to %s in %s", d.Id, m.change(), m.env)))
+}
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "autoscale",
+		Short: "Scale an app with its load",
+		Long:  "Creates a new deployment that reuses the latest settings and environment variables, with the process scaling between --min and --max replicas to keep its average CPU and/or memory utilization near the targets. Utilization is relative to the resources the process requests. Use --off to go back to a fixed replica count.",
+		Example: "  metal autoscale -a myapp -e production --min 2 --max 10 --cpu 70\n" +
+			"  metal autoscale -a myapp -e production -p worker --min 1 --max 5 --memory 80\n" +
+			"  metal autoscale -a myapp -e production --off",
+		PreRun: common.CheckToken,
+		Run:    runAutoscale,
+	}
+	cmd.Flags().StringP("app", "a", "", "Name of the app to autoscale")
+	cmd.Flags().StringP("env", "e", "", "Name of the environment to autoscale in")
+	cmd.Flags().StringP("process", "p", "", "Process to autoscale, e.g. worker. Required if the app defines its own processes")
+	cmd.Flags().Int("min", 1, "Minimum number of replicas")
+	cmd.Flags().Int("max", 0, "Maximum number of replicas")
+	cmd.Flags().Int("cpu", 0, "Average CPU utilization to aim for, in percent of requested CPU")
+	cmd.Flags().Int("memory", 0, "Average memory utilization to aim for, in percent of requested memory")
+	cmd.Flags().Bool("off", false, "Turn autoscaling off. The process goes back to its fixed replica count")
+	cmd.MarkFlagRequired("app")
+	cmd.MarkFlagRequired("env")
+	cmd.MarkFlagsMutuallyExclusive("off", "max")
+	cmd.MarkFlagsOneRequired("off", "max")
+	return cmd
+}
+
+func runAutoscale(cmd *cobra.Command, args []string) {
+	var autoscaling *oapi.Autoscaling
+	if off, _ := cmd.Flags().GetBool("off"); !off {
+		minReplicas, _ := cmd.Flags().GetInt("min")
+		maxReplicas, _ := cmd.Flags().GetInt("max")
+		cpu, _ := cmd.Flags().GetInt("cpu")
+		memory, _ := cmd.Flags().GetInt("memory")
+		autoscaling = &oapi.Autoscaling{
+			MinReplicas:                    minReplicas,
+			MaxReplicas:                    maxReplicas,
+			TargetCpuUtilizationPercent:    &cpu,
+			TargetMemoryUtilizationPercent: &memory,
+		}
+	}
+	p := tea.NewProgram(model{
+		loading:     common.NewSpinner(),
+		apiClient:   common.MustApiClient(),
+		app:         cmd.Flags().Lookup("app").Value.String(),
+		env:         cmd.Flags().Lookup("env").Value.String(),
+		process:     cmd.Flags().Lookup("process").Value.String(),
+		autoscaling: autoscaling,
+	})
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
+
+func AutoscaleCmd(apiClient oapi.ClientWithResponsesInterface, appName, envName, process string, autoscaling *oapi.Autoscaling) func() tea.Msg {
+	return func() tea.Msg {
+		ctx := context.Background()
+		app, err := common.FindAppByName(ctx, apiClient, appName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		env, err := common.FindEnvByName(ctx, apiClient, envName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		body := oapi.UpdateAutoscalingJSONRequestBody{Autoscaling: autoscaling}
+		if process != "" {
+			body.Process = &process
+		}
+		resp, err := apiClient.UpdateAutoscalingWithResponse(ctx, app.Id, env.Id, body)
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusCreated {
+			return Msg{Error: fmt.Errorf("API returned non-201 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		return Msg{Success: resp.JSON201}
+	}
+}
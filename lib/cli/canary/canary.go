@@ -0,0 +1,177 @@
+package canary
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/onmetal-dev/metal/lib/cli/common"
+	"github.com/onmetal-dev/metal/lib/cli/style"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/spf13/cobra"
+)
+
+type Msg struct {
+	Success *oapi.Deployment
+	Error   error
+}
+
+type model struct {
+	loading     spinner.Model
+	loadingText string
+	run         func() tea.Msg
+	success     func(d oapi.Deployment) string
+	msg         *Msg
+}
+
+var _ tea.Model = (*model)(nil)
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.loading.Tick, m.run)
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.loading, cmd = m.loading.Update(msg)
+		return m, cmd
+	case Msg:
+		m.msg = &msg
+		return m, tea.Quit
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyCtrlC:
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	if m.msg == nil {
+		return fmt.Sprintf("\n %s %s\n\n", m.loading.View(), lipgloss.NewStyle().Foreground(style.BaseLight).Render(m.loadingText))
+	}
+	if m.msg.Error != nil {
+		return fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Error).Render(fmt.Sprintf("Error: %v", m.msg.Error)))
+	}
+	return fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Success).Render(m.success(*m.msg.Success)))
+}
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "canary",
+		Short: "Promote or abort an app's canary",
+		Long:  "A canary is started with metal up --canary, which releases the new version next to the running one with a share of its traffic. Promote it to send it the next share of traffic, or abort it to send all traffic back to the running version.",
+	}
+	cmd.PersistentFlags().StringP("app", "a", "", "Name of the app")
+	cmd.PersistentFlags().StringP("env", "e", "", "Name of the environment")
+	cmd.MarkPersistentFlagRequired("app")
+	cmd.MarkPersistentFlagRequired("env")
+
+	promoteCmd := &cobra.Command{
+		Use:     "promote",
+		Short:   "Send the canary its next step of traffic, or roll it out fully from its last step",
+		Example: "  metal canary promote -a myapp -e production\n  metal canary promote -a myapp -e production --full",
+		PreRun:  common.CheckToken,
+		Run:     runPromote,
+	}
+	promoteCmd.Flags().Bool("full", false, "Skip the remaining steps and roll the canary out fully")
+
+	abortCmd := &cobra.Command{
+		Use:    "abort",
+		Short:  "Send all traffic back to the running version and tear the canary down",
+		PreRun: common.CheckToken,
+		Run:    runAbort,
+	}
+
+	cmd.AddCommand(promoteCmd, abortCmd)
+	return cmd
+}
+
+func runProgram(m model) {
+	m.loading = common.NewSpinner()
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
+
+func runPromote(cmd *cobra.Command, args []string) {
+	appName := cmd.Flags().Lookup("app").Value.String()
+	envName := cmd.Flags().Lookup("env").Value.String()
+	full, _ := cmd.Flags().GetBool("full")
+	runProgram(model{
+		loadingText: fmt.Sprintf("promoting canary of %s in %s...", appName, envName),
+		run:         PromoteCmd(common.MustApiClient(), appName, envName, full),
+		success: func(d oapi.Deployment) string {
+			if d.CanaryWeight != nil && *d.CanaryWeight < 100 {
+				return fmt.Sprintf("✅ sending %d%% of traffic to canary deployment %d", *d.CanaryWeight, d.Id)
+			}
+			return fmt.Sprintf("✅ rolling out canary deployment %d fully", d.Id)
+		},
+	})
+}
+
+func runAbort(cmd *cobra.Command, args []string) {
+	appName := cmd.Flags().Lookup("app").Value.String()
+	envName := cmd.Flags().Lookup("env").Value.String()
+	runProgram(model{
+		loadingText: fmt.Sprintf("aborting canary of %s in %s...", appName, envName),
+		run:         AbortCmd(common.MustApiClient(), appName, envName),
+		success: func(d oapi.Deployment) string {
+			return fmt.Sprintf("✅ aborting canary deployment %d, all traffic is going back to the running version", d.Id)
+		},
+	})
+}
+
+// appEnvIds resolves app and env names to ids
+func appEnvIds(ctx context.Context, apiClient oapi.ClientWithResponsesInterface, appName, envName string) (string, string, error) {
+	app, err := common.FindAppByName(ctx, apiClient, appName)
+	if err != nil {
+		return "", "", err
+	}
+	env, err := common.FindEnvByName(ctx, apiClient, envName)
+	if err != nil {
+		return "", "", err
+	}
+	return app.Id, env.Id, nil
+}
+
+func PromoteCmd(apiClient oapi.ClientWithResponsesInterface, appName, envName string, full bool) func() tea.Msg {
+	return func() tea.Msg {
+		ctx := context.Background()
+		appId, envId, err := appEnvIds(ctx, apiClient, appName, envName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		resp, err := apiClient.PromoteCanaryWithResponse(ctx, appId, envId, oapi.PromoteCanaryJSONRequestBody{Full: &full})
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusOK {
+			return Msg{Error: fmt.Errorf("API returned non-200 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		return Msg{Success: resp.JSON200}
+	}
+}
+
+func AbortCmd(apiClient oapi.ClientWithResponsesInterface, appName, envName string) func() tea.Msg {
+	return func() tea.Msg {
+		ctx := context.Background()
+		appId, envId, err := appEnvIds(ctx, apiClient, appName, envName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		resp, err := apiClient.AbortCanaryWithResponse(ctx, appId, envId)
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusOK {
+			return Msg{Error: fmt.Errorf("API returned non-200 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		return Msg{Success: resp.JSON200}
+	}
+}
@@ -0,0 +1,121 @@
+package cancel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/onmetal-dev/metal/lib/cli/common"
+	"github.com/onmetal-dev/metal/lib/cli/style"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/spf13/cobra"
+)
+
+type Msg struct {
+	Success *oapi.Deployment
+	Error   error
+}
+
+type model struct {
+	loading      spinner.Model
+	apiClient    oapi.ClientWithResponsesInterface
+	app          string
+	env          string
+	deploymentId int
+	cancelMsg    *Msg
+}
+
+var _ tea.Model = (*model)(nil)
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.loading.Tick, CancelCmd(m.apiClient, m.app, m.env, m.deploymentId))
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.loading, cmd = m.loading.Update(msg)
+		return m, cmd
+	case Msg:
+		m.cancelMsg = &msg
+		return m, tea.Quit
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyCtrlC:
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	if m.cancelMsg == nil {
+		return fmt.Sprintf("\n %s %s\n\n", m.loading.View(), lipgloss.NewStyle().Foreground(style.BaseLight).Render(fmt.Sprintf("canceling the deployment of %s in %s...", m.app, m.env)))
+	}
+	if m.cancelMsg.Error != nil {
+		return fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Error).Render(fmt.Sprintf("Error: %v", m.cancelMsg.Error)))
+	}
+	d := m.cancelMsg.Success
+	return fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Success).Render(fmt.Sprintf("✅ deployment %d of %s in %s is %s", d.Id, m.app, m.env, d.StatusReason)))
+}
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:    "cancel",
+		Short:  "Cancel a deployment that is in progress",
+		Long:   "Cancels a pending, releasing or deploying deployment and rolls the app back to the deployment that was running before it.",
+		PreRun: common.CheckToken,
+		Run:    runCancel,
+	}
+	cmd.Flags().StringP("app", "a", "", "Name of the app to cancel the deployment of")
+	cmd.Flags().StringP("env", "e", "", "Name of the environment to cancel the deployment in")
+	cmd.Flags().IntP("deployment", "d", 0, "Id of the deployment to cancel. Defaults to the latest one in progress")
+	cmd.MarkFlagRequired("app")
+	cmd.MarkFlagRequired("env")
+	return cmd
+}
+
+func runCancel(cmd *cobra.Command, args []string) {
+	deploymentId, _ := cmd.Flags().GetInt("deployment")
+	p := tea.NewProgram(model{
+		loading:      common.NewSpinner(),
+		apiClient:    common.MustApiClient(),
+		app:          cmd.Flags().Lookup("app").Value.String(),
+		env:          cmd.Flags().Lookup("env").Value.String(),
+		deploymentId: deploymentId,
+	})
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
+
+func CancelCmd(apiClient oapi.ClientWithResponsesInterface, appName, envName string, deploymentId int) func() tea.Msg {
+	return func() tea.Msg {
+		ctx := context.Background()
+		app, err := common.FindAppByName(ctx, apiClient, appName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		env, err := common.FindEnvByName(ctx, apiClient, envName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		body := oapi.CancelDeploymentJSONRequestBody{}
+		if deploymentId != 0 {
+			body.DeploymentId = &deploymentId
+		}
+		resp, err := apiClient.CancelDeploymentWithResponse(ctx, app.Id, env.Id, body)
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusOK {
+			return Msg{Error: fmt.Errorf("API returned non-200 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		return Msg{Success: resp.JSON200}
+	}
+}
@@ -0,0 +1,179 @@
+package cells
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/onmetal-dev/metal/lib/cli/common"
+	"github.com/onmetal-dev/metal/lib/cli/style"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+)
+
+// Msg carries the rendered result of a cells subcommand
+type Msg struct {
+	Success string
+	Error   error
+}
+
+type model struct {
+	loading     spinner.Model
+	loadingText string
+	run         func() tea.Msg
+	msg         *Msg
+}
+
+var _ tea.Model = (*model)(nil)
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.loading.Tick, m.run)
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.loading, cmd = m.loading.Update(msg)
+		return m, cmd
+	case Msg:
+		m.msg = &msg
+		return m, tea.Quit
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyCtrlC:
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	if m.msg == nil {
+		return fmt.Sprintf("\n %s %s\n\n", m.loading.View(), lipgloss.NewStyle().Foreground(style.BaseLight).Render(m.loadingText))
+	}
+	if m.msg.Error != nil {
+		return fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Error).Render(fmt.Sprintf("Error: %v", m.msg.Error)))
+	}
+	return m.msg.Success + "\n"
+}
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:    "cells",
+		Short:  "Manage the cells apps are deployed to",
+		Long:   "Cells are groups of servers, e.g. in different locations. An app can run on several cells of its team at once. Without a subcommand, lists the team's cells.",
+		PreRun: common.CheckToken,
+		Run:    runList,
+	}
+
+	listCmd := &cobra.Command{
+		Use:    "list",
+		Short:  "List the team's cells",
+		PreRun: common.CheckToken,
+		Run:    runList,
+	}
+
+	setCmd := &cobra.Command{
+		Use:     "set <cell>...",
+		Short:   "Deploy an app to the given cells",
+		Long:    "Redeploys the app's latest deployment to the given cells. It is running once it runs on all of them, and it is then torn down on the cells it was dropped from.",
+		Example: "  metal cells set -a myapp -e production fsn1 nbg1",
+		Args:    cobra.MinimumNArgs(1),
+		PreRun:  common.CheckToken,
+		Run:     runSet,
+	}
+	setCmd.Flags().StringP("app", "a", "", "Name of the app")
+	setCmd.Flags().StringP("env", "e", "", "Name of the environment")
+	setCmd.MarkFlagRequired("app")
+	setCmd.MarkFlagRequired("env")
+
+	cmd.AddCommand(listCmd, setCmd)
+	return cmd
+}
+
+func runProgram(loadingText string, run func() tea.Msg) {
+	p := tea.NewProgram(model{
+		loading:     common.NewSpinner(),
+		loadingText: loadingText,
+		run:         run,
+	})
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
+
+func renderTable(headers []string, rows [][]string) string {
+	baseStyle := lipgloss.NewStyle().Foreground(style.Primary)
+	return table.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(baseStyle).
+		Headers(headers...).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return baseStyle.Foreground(style.Neutral).Bold(true)
+			}
+			return baseStyle.Foreground(style.Neutral)
+		}).
+		Rows(rows...).
+		Render()
+}
+
+func runList(cmd *cobra.Command, args []string) {
+	apiClient := common.MustApiClient()
+	runProgram("loading cells...", func() tea.Msg {
+		resp, err := apiClient.GetCellsWithResponse(context.Background())
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusOK {
+			return Msg{Error: fmt.Errorf("API returned non-200 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		if len(*resp.JSON200) == 0 {
+			return Msg{Success: lipgloss.NewStyle().Foreground(style.BaseLight).Render("no cells yet, create one in the dashboard")}
+		}
+		rows := lo.Map(*resp.JSON200, func(c oapi.Cell, _ int) []string {
+			return []string{c.Name, c.Id, c.Type}
+		})
+		return Msg{Success: renderTable([]string{"Name", "Id", "Type"}, rows)}
+	})
+}
+
+func runSet(cmd *cobra.Command, args []string) {
+	apiClient := common.MustApiClient()
+	appName := cmd.Flags().Lookup("app").Value.String()
+	envName := cmd.Flags().Lookup("env").Value.String()
+	runProgram(fmt.Sprintf("deploying %s to %s...", appName, strings.Join(args, ", ")), func() tea.Msg {
+		ctx := context.Background()
+		app, err := common.FindAppByName(ctx, apiClient, appName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		env, err := common.FindEnvByName(ctx, apiClient, envName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		var cellIds []string
+		for _, name := range args {
+			cell, err := common.FindCellByName(ctx, apiClient, name)
+			if err != nil {
+				return Msg{Error: err}
+			}
+			cellIds = append(cellIds, cell.Id)
+		}
+		resp, err := apiClient.UpdateCellsWithResponse(ctx, app.Id, env.Id, oapi.UpdateCellsJSONRequestBody{CellIds: cellIds})
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusCreated {
+			return Msg{Error: fmt.Errorf("API returned non-201 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		return Msg{Success: lipgloss.NewStyle().Foreground(style.Success).Render(fmt.Sprintf("✅ deployment %d created, deploying %s to %s", resp.JSON201.Id, appName, strings.Join(args, ", ")))}
+	})
+}
@@ -29,10 +29,18 @@ func NewSpinner() spinner.Model {
 	return s
 }
 
+// setHeaders authenticates requests to the API, and asks it to deploy to locked envs if --override-lock is set
+func setHeaders(req *http.Request) {
+	req.Header.Set("Authorization", "Bearer "+viper.GetString("api-token"))
+	if viper.GetBool("override-lock") {
+		req.Header.Set("Metal-Override-Lock", "true")
+	}
+}
+
 func MustApiClient() oapi.ClientWithResponsesInterface {
 	client, err := oapi.NewClientWithResponses(viper.GetString("api-base-url"),
 		oapi.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
-			req.Header.Set("Authorization", "Bearer "+viper.GetString("api-token"))
+			setHeaders(req)
 			return nil
 		}))
 	if err != nil {
@@ -45,7 +53,7 @@ func MustApiClient() oapi.ClientWithResponsesInterface {
 func MustApiClientRaw() oapi.ClientInterface {
 	client, err := oapi.NewClient(viper.GetString("api-base-url"),
 		oapi.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
-			req.Header.Set("Authorization", "Bearer "+viper.GetString("api-token"))
+			setHeaders(req)
 			return nil
 		}))
 	if err != nil {
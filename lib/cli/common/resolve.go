@@ -0,0 +1,55 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/samber/lo"
+)
+
+// FindAppByName looks up an app belonging to the token's team by name
+func FindAppByName(ctx context.Context, apiClient oapi.ClientWithResponsesInterface, name string) (*oapi.App, error) {
+	resp, err := apiClient.GetAppsWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	} else if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("API returned non-200 status: %d: %s", resp.StatusCode(), string(resp.Body))
+	}
+	app, ok := lo.Find(*resp.JSON200, func(a oapi.App) bool { return a.Name == name })
+	if !ok {
+		return nil, fmt.Errorf("app %s not found", name)
+	}
+	return &app, nil
+}
+
+// FindEnvByName looks up an env belonging to the token's team by name
+func FindEnvByName(ctx context.Context, apiClient oapi.ClientWithResponsesInterface, name string) (*oapi.Env, error) {
+	resp, err := apiClient.GetEnvsWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	} else if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("API returned non-200 status: %d: %s", resp.StatusCode(), string(resp.Body))
+	}
+	env, ok := lo.Find(*resp.JSON200, func(e oapi.Env) bool { return e.Name == name })
+	if !ok {
+		return nil, fmt.Errorf("env %s not found", name)
+	}
+	return &env, nil
+}
+
+// FindCellByName looks up a cell belonging to the token's team by name or id
+func FindCellByName(ctx context.Context, apiClient oapi.ClientWithResponsesInterface, name string) (*oapi.Cell, error) {
+	resp, err := apiClient.GetCellsWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	} else if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("API returned non-200 status: %d: %s", resp.StatusCode(), string(resp.Body))
+	}
+	cell, ok := lo.Find(*resp.JSON200, func(c oapi.Cell) bool { return c.Name == name || c.Id == name })
+	if !ok {
+		return nil, fmt.Errorf("cell %s not found", name)
+	}
+	return &cell, nil
+}
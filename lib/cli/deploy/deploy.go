@@ -0,0 +1,190 @@
+package deploy
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/onmetal-dev/metal/lib/cli/common"
+	"github.com/onmetal-dev/metal/lib/cli/style"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/spf13/cobra"
+)
+
+var textStyle = lipgloss.NewStyle().Foreground(style.BaseLight)
+
+// errorPrefix starts the line the API ends the stream with when the deployment fails
+const errorPrefix = "error: "
+
+// deployMsg is sent when a line of the deployment logs is received, or the stream ends
+type deployMsg struct {
+	Line    string
+	Scanner *bufio.Scanner
+	Error   error
+	Done    bool
+}
+
+type model struct {
+	loading      spinner.Model
+	apiClient    oapi.ClientWithResponsesInterface
+	apiClientRaw oapi.ClientInterface
+	app          string
+	env          string
+	image        string
+	body         oapi.DeployImageJSONRequestBody
+	cell         string
+	logs         []string
+	done         bool
+	err          error
+}
+
+var _ tea.Model = (*model)(nil)
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.loading.Tick, DeployCmd(m.apiClient, m.apiClientRaw, m.app, m.env, m.cell, m.body))
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.loading, cmd = m.loading.Update(msg)
+		return m, cmd
+	case deployMsg:
+		if msg.Done {
+			m.done = true
+			if msg.Error != nil {
+				m.err = msg.Error
+			}
+			return m, tea.Quit
+		}
+		if strings.HasPrefix(msg.Line, errorPrefix) {
+			m.err = fmt.Errorf("%s", strings.TrimPrefix(msg.Line, errorPrefix))
+		} else {
+			m.logs = append(m.logs, msg.Line)
+		}
+		return m, streamDeployResponse(msg.Scanner)
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyCtrlC:
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	var view string
+	if len(m.logs) > 0 {
+		view = strings.Join(m.logs, "\n") + "\n"
+	}
+	if !m.done {
+		return view + fmt.Sprintf("\n %s %s\n\n", m.loading.View(), textStyle.Render(fmt.Sprintf("deploying %s to %s in %s...", m.image, m.app, m.env)))
+	}
+	if m.err != nil {
+		return view + fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Error).Render(fmt.Sprintf("❌ deploy failed: %v", m.err)))
+	}
+	return view + fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Success).Render("✅ deploy completed!"))
+}
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deploy",
+		Short: "Deploy a prebuilt image",
+		Long:  "Rolls out an image that was built elsewhere, e.g. in CI, with the settings of the app's latest deployment in the environment. Waits until the deployment is running and exits with an error if it fails.",
+		Example: "  metal deploy -a myapp -e production --image ghcr.io/acme/myapp:v1.2\n" +
+			`  metal deploy -a myapp -e production --image ghcr.io/acme/myapp@sha256:... --canary "10,50"`,
+		PreRun: common.CheckToken,
+		Run:    runDeploy,
+	}
+	cmd.Flags().StringP("app", "a", "", "Name of the app to deploy")
+	cmd.Flags().StringP("env", "e", "", "Name of the environment to deploy into")
+	cmd.Flags().String("image", "", "Image to deploy, e.g. repo:tag or repo@sha256:digest")
+	cmd.Flags().String("canary", "", `Release as a canary that gets these comma-separated percentages of traffic in turn, e.g. "10,50". Promote or abort it with metal canary`)
+	cmd.Flags().String("cell", "", "Name of the cell to deploy to, moving the app there if it runs elsewhere. If not specified, the app stays on its current cells, or goes to the env's default cell")
+	cmd.MarkFlagRequired("app")
+	cmd.MarkFlagRequired("env")
+	cmd.MarkFlagRequired("image")
+	return cmd
+}
+
+func runDeploy(cmd *cobra.Command, args []string) {
+	image := cmd.Flags().Lookup("image").Value.String()
+	body := oapi.DeployImageJSONRequestBody{Image: image}
+	if canary := cmd.Flags().Lookup("canary").Value.String(); canary != "" {
+		body.CanarySteps = &canary
+	}
+	p := tea.NewProgram(model{
+		loading:      common.NewSpinner(),
+		apiClient:    common.MustApiClient(),
+		apiClientRaw: common.MustApiClientRaw(),
+		app:          cmd.Flags().Lookup("app").Value.String(),
+		env:          cmd.Flags().Lookup("env").Value.String(),
+		image:        image,
+		cell:         cmd.Flags().Lookup("cell").Value.String(),
+		body:         body,
+	})
+	finalModel, err := p.Run()
+	if err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+	// CI relies on the exit code to tell whether the rollout worked
+	if m := finalModel.(model); !m.done || m.err != nil {
+		os.Exit(1)
+	}
+}
+
+// DeployCmd starts the deployment and returns the first line of its logs
+func DeployCmd(apiClient oapi.ClientWithResponsesInterface, apiClientRaw oapi.ClientInterface, appName, envName, cellName string, body oapi.DeployImageJSONRequestBody) func() tea.Msg {
+	return func() tea.Msg {
+		ctx := context.Background()
+		app, err := common.FindAppByName(ctx, apiClient, appName)
+		if err != nil {
+			return deployMsg{Done: true, Error: err}
+		}
+		env, err := common.FindEnvByName(ctx, apiClient, envName)
+		if err != nil {
+			return deployMsg{Done: true, Error: err}
+		}
+		if cellName != "" {
+			cell, err := common.FindCellByName(ctx, apiClient, cellName)
+			if err != nil {
+				return deployMsg{Done: true, Error: err}
+			}
+			body.CellId = &cell.Id
+		}
+		resp, err := apiClientRaw.DeployImage(ctx, app.Id, env.Id, body)
+		if err != nil {
+			return deployMsg{Done: true, Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode != http.StatusOK {
+			defer resp.Body.Close()
+			respBody, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return deployMsg{Done: true, Error: fmt.Errorf("error reading response body: %w", err)}
+			}
+			return deployMsg{Done: true, Error: fmt.Errorf("API returned non-200 status: %d: %s", resp.StatusCode, string(respBody))}
+		}
+		return streamDeployResponse(bufio.NewScanner(resp.Body))()
+	}
+}
+
+// streamDeployResponse is a command that reads the next line of the deployment logs
+func streamDeployResponse(scanner *bufio.Scanner) tea.Cmd {
+	return func() tea.Msg {
+		if scanner.Scan() {
+			return deployMsg{Scanner: scanner, Line: scanner.Text()}
+		}
+		if err := scanner.Err(); err != nil {
+			return deployMsg{Done: true, Error: fmt.Errorf("error streaming deployment logs: %w", err)}
+		}
+		return deployMsg{Done: true}
+	}
+}
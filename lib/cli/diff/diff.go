@@ -0,0 +1,203 @@
+package diff
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/onmetal-dev/metal/lib/cli/common"
+	"github.com/onmetal-dev/metal/lib/cli/style"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+)
+
+type Msg struct {
+	Success *oapi.DeploymentDiff
+	Error   error
+}
+
+type model struct {
+	loading   spinner.Model
+	apiClient oapi.ClientWithResponsesInterface
+	app       string
+	env       string
+	body      oapi.DiffDeploymentJSONRequestBody
+	diffMsg   *Msg
+}
+
+var _ tea.Model = (*model)(nil)
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.loading.Tick, DiffCmd(m.apiClient, m.app, m.env, m.body))
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.loading, cmd = m.loading.Update(msg)
+		return m, cmd
+	case Msg:
+		m.diffMsg = &msg
+		return m, tea.Quit
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyCtrlC:
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func renderChanges(changes []oapi.DeploymentChange) string {
+	baseStyle := lipgloss.NewStyle().Foreground(style.Primary)
+	rows := lo.Map(changes, func(c oapi.DeploymentChange, _ int) []string {
+		return []string{c.Field, lo.FromPtrOr(c.From, "-"), lo.FromPtrOr(c.To, "-")}
+	})
+	return table.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(baseStyle).
+		Headers("Setting", "From", "To").
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return baseStyle.Foreground(style.Neutral).Bold(true)
+			}
+			return baseStyle.Foreground(style.Neutral)
+		}).
+		Rows(rows...).
+		Render()
+}
+
+// renderDiff colors the added and removed lines of a unified diff
+func renderDiff(diff string) string {
+	lines := strings.Split(strings.TrimSuffix(diff, "\n"), "\n")
+	return strings.Join(lo.Map(lines, func(line string, _ int) string {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"), strings.HasPrefix(line, "@@"):
+			return lipgloss.NewStyle().Foreground(style.Info).Render(line)
+		case strings.HasPrefix(line, "+"):
+			return lipgloss.NewStyle().Foreground(style.Success).Render(line)
+		case strings.HasPrefix(line, "-"):
+			return lipgloss.NewStyle().Foreground(style.Error).Render(line)
+		}
+		return lipgloss.NewStyle().Foreground(style.BaseLight).Render(line)
+	}), "\n")
+}
+
+var actionColors = map[oapi.PlannedObjectAction]lipgloss.Color{
+	oapi.PlannedObjectActionCreate:    style.Success,
+	oapi.PlannedObjectActionUpdate:    style.Warning,
+	oapi.PlannedObjectActionDelete:    style.Error,
+	oapi.PlannedObjectActionUnchanged: style.BaseLight,
+}
+
+func (m model) View() string {
+	if m.diffMsg == nil {
+		return fmt.Sprintf("\n %s %s\n\n", m.loading.View(), lipgloss.NewStyle().Foreground(style.BaseLight).Render(fmt.Sprintf("comparing with the latest deployment of %s in %s...", m.app, m.env)))
+	}
+	if m.diffMsg.Error != nil {
+		return fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Error).Render(fmt.Sprintf("Error: %v", m.diffMsg.Error)))
+	}
+	diff := m.diffMsg.Success
+	var b strings.Builder
+	if len(diff.Changes) == 0 {
+		b.WriteString(lipgloss.NewStyle().Foreground(style.BaseLight).Render("no settings change") + "\n")
+	} else {
+		b.WriteString(renderChanges(diff.Changes) + "\n")
+	}
+	for _, object := range diff.Objects {
+		b.WriteString("\n" + lipgloss.NewStyle().Foreground(actionColors[object.Action]).Bold(true).Render(fmt.Sprintf("%s %s %s", object.Action, object.Kind, object.Name)) + "\n")
+		if object.Diff != nil {
+			b.WriteString(renderDiff(*object.Diff) + "\n")
+		}
+	}
+	return b.String()
+}
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Preview what a deployment would change",
+		Long:  "Compares the latest deployment of an app in an environment with one that has the given changes, and shows how the settings and the Kubernetes objects on the cell would change. Nothing is deployed. Env var values are always masked.",
+		Example: "  metal diff -a myapp -e production\n" +
+			"  metal diff -a myapp -e production --image ghcr.io/acme/myapp:v2 --replicas 3\n" +
+			"  metal diff -a myapp -e production --set-env LOG_LEVEL=debug --unset-env DEBUG",
+		PreRun: common.CheckToken,
+		Run:    runDiff,
+	}
+	cmd.Flags().StringP("app", "a", "", "Name of the app to diff")
+	cmd.Flags().StringP("env", "e", "", "Name of the environment to diff in")
+	cmd.Flags().String("image", "", "Image to deploy instead of the current one, e.g. ghcr.io/acme/myapp:v2")
+	cmd.Flags().Int("replicas", 0, "Number of replicas to run instead of the current number")
+	cmd.Flags().StringArray("set-env", nil, "Env var to add or change, as KEY=VALUE. Can be repeated")
+	cmd.Flags().StringArray("unset-env", nil, "Name of an env var to remove. Can be repeated")
+	cmd.MarkFlagRequired("app")
+	cmd.MarkFlagRequired("env")
+	return cmd
+}
+
+func runDiff(cmd *cobra.Command, args []string) {
+	body := oapi.DiffDeploymentJSONRequestBody{}
+	if image, _ := cmd.Flags().GetString("image"); image != "" {
+		body.Image = &image
+	}
+	if replicas, _ := cmd.Flags().GetInt("replicas"); replicas != 0 {
+		body.Replicas = &replicas
+	}
+	setEnv, _ := cmd.Flags().GetStringArray("set-env")
+	envVars := []oapi.EnvVar{}
+	for _, s := range setEnv {
+		name, value, ok := strings.Cut(s, "=")
+		if !ok || name == "" {
+			fmt.Println(lipgloss.NewStyle().Foreground(style.Error).Render(fmt.Sprintf("Error: --set-env %s is not KEY=VALUE", s)))
+			os.Exit(1)
+		}
+		envVars = append(envVars, oapi.EnvVar{Name: name, Value: value})
+	}
+	if len(envVars) > 0 {
+		body.SetEnvVars = &envVars
+	}
+	if unsetEnv, _ := cmd.Flags().GetStringArray("unset-env"); len(unsetEnv) > 0 {
+		body.UnsetEnvVars = &unsetEnv
+	}
+
+	p := tea.NewProgram(model{
+		loading:   common.NewSpinner(),
+		apiClient: common.MustApiClient(),
+		app:       cmd.Flags().Lookup("app").Value.String(),
+		env:       cmd.Flags().Lookup("env").Value.String(),
+		body:      body,
+	})
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
+
+func DiffCmd(apiClient oapi.ClientWithResponsesInterface, appName, envName string, body oapi.DiffDeploymentJSONRequestBody) func() tea.Msg {
+	return func() tea.Msg {
+		ctx := context.Background()
+		app, err := common.FindAppByName(ctx, apiClient, appName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		env, err := common.FindEnvByName(ctx, apiClient, envName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		resp, err := apiClient.DiffDeploymentWithResponse(ctx, app.Id, env.Id, body)
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusOK {
+			return Msg{Error: fmt.Errorf("API returned non-200 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		return Msg{Success: resp.JSON200}
+	}
+}
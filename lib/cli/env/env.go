@@ -0,0 +1,305 @@
+package env
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/onmetal-dev/metal/lib/cli/common"
+	"github.com/onmetal-dev/metal/lib/cli/style"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+)
+
+type Msg struct {
+	Success *oapi.Env
+	// Vars is set instead of Success by the commands that manage the env vars shared by the env's apps
+	Vars  *oapi.SharedEnvVars
+	Error error
+}
+
+type model struct {
+	loading     spinner.Model
+	loadingText string
+	run         func() tea.Msg
+	success     func(e oapi.Env) string
+	varsSuccess func(v oapi.SharedEnvVars) string
+	msg         *Msg
+}
+
+var _ tea.Model = (*model)(nil)
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.loading.Tick, m.run)
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.loading, cmd = m.loading.Update(msg)
+		return m, cmd
+	case Msg:
+		m.msg = &msg
+		return m, tea.Quit
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyCtrlC:
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	if m.msg == nil {
+		return fmt.Sprintf("\n %s %s\n\n", m.loading.View(), lipgloss.NewStyle().Foreground(style.BaseLight).Render(m.loadingText))
+	}
+	if m.msg.Error != nil {
+		return fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Error).Render(fmt.Sprintf("Error: %v", m.msg.Error)))
+	}
+	if m.msg.Vars != nil {
+		return fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Success).Render(m.varsSuccess(*m.msg.Vars)))
+	}
+	return fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Success).Render(m.success(*m.msg.Success)))
+}
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "env",
+		Short: "Manage an environment's deployment policy and shared env vars",
+	}
+	cmd.PersistentFlags().StringP("env", "e", "", "Name of the environment")
+	cmd.MarkPersistentFlagRequired("env")
+
+	updateCmd := &cobra.Command{
+		Use:   "update",
+		Short: "Change how deployments to the environment behave",
+		Long:  "With auto rollback on, a deployment that fails because its pods crash or because it stops making progress is rolled back to the deployment that was running before it. The progress deadline is how long a deployment may go without progress before it fails. The default cell is where metal up builds and deploys apps that aren't deployed to the environment yet. New deployments to a protected environment wait until a team admin other than whoever asked for them approves them with metal approval approve.",
+		Example: "  metal env update -e production --auto-rollback\n" +
+			"  metal env update -e production --progress-deadline 3m\n" +
+			"  metal env update -e production --default-cell fsn1\n" +
+			"  metal env update -e production --protected\n" +
+			"  metal env update -e production --auto-rollback=false --progress-deadline 0\n" +
+			"  metal env update -e production --freeze-window 'Fri 16:00-Mon 09:00' --timezone Europe/Berlin --freeze-reason weekend\n" +
+			"  metal env update -e production --freeze-window ''",
+		PreRun: common.CheckToken,
+		Run:    runUpdate,
+	}
+	updateCmd.Flags().Bool("auto-rollback", false, "Roll failed deployments back to the deployment that was running before them")
+	updateCmd.Flags().Duration("progress-deadline", 0, "How long a deployment may go without progress before it fails, between 30s and 1h. 0 uses the Kubernetes default of 10m")
+	updateCmd.Flags().Bool("protected", false, "Hold new deployments until a team admin other than whoever asked for them approves them")
+	updateCmd.Flags().String("default-cell", "", `Name of the cell apps are deployed to the first time they are deployed to the environment. "" removes the default`)
+	updateCmd.Flags().StringArray("freeze-window", nil, `Weekly window during which deployments are rejected, e.g. "Fri 16:00-Mon 09:00". Repeat for several windows. Replaces the current windows, "" removes them`)
+	updateCmd.Flags().String("timezone", "UTC", "IANA time zone the freeze windows are in, e.g. Europe/Berlin")
+	updateCmd.Flags().String("freeze-reason", "", "Why deployments are frozen during the freeze windows")
+
+	lockCmd := &cobra.Command{
+		Use:   "lock",
+		Short: "Lock the environment, rejecting deployments to it until it is unlocked",
+		Long:  "While an environment is locked, or in one of its freeze windows, deployments to it are rejected. Admins can deploy anyway by passing --override-lock.",
+		Example: "  metal env lock -e production --reason 'incident 42'\n" +
+			"  metal up -a api -e production --override-lock",
+		PreRun: common.CheckToken,
+		Run:    runLock,
+	}
+	lockCmd.Flags().String("reason", "", "Why the environment is locked")
+
+	unlockCmd := &cobra.Command{
+		Use:    "unlock",
+		Short:  "Unlock the environment. Its freeze windows still apply",
+		PreRun: common.CheckToken,
+		Run:    runUnlock,
+	}
+
+	cmd.AddCommand(updateCmd, lockCmd, unlockCmd, newVarsCmd())
+	return cmd
+}
+
+func runProgram(m model) {
+	m.loading = common.NewSpinner()
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
+
+// policy describes the deployment policy of an env
+func policy(e oapi.Env) string {
+	autoRollback := "off"
+	if e.AutoRollback {
+		autoRollback = "on"
+	}
+	progressDeadline := "default"
+	if e.ProgressDeadlineSeconds > 0 {
+		progressDeadline = (time.Duration(e.ProgressDeadlineSeconds) * time.Second).String()
+	}
+	defaultCell := "none"
+	if e.DefaultCellId != "" {
+		defaultCell = e.DefaultCellId
+	}
+	freezeWindows := lo.Map(e.FreezeWindows, func(w oapi.FreezeWindow, _ int) string {
+		return fmt.Sprintf("%s to %s %s", w.Start, w.End, lo.CoalesceOrEmpty(lo.FromPtr(w.Timezone), "UTC"))
+	})
+	s := fmt.Sprintf("auto rollback %s, progress deadline %s, default cell %s, freeze windows %s", autoRollback, progressDeadline, defaultCell,
+		lo.CoalesceOrEmpty(strings.Join(freezeWindows, ", "), "none"))
+	if e.Protected {
+		s += ", protected"
+	}
+	if e.Lock != nil {
+		s += fmt.Sprintf(", locked by %s: %s", e.Lock.LockedBy, lo.CoalesceOrEmpty(e.Lock.Reason, "no reason given"))
+	}
+	return s
+}
+
+// parseFreezeWindow parses a window given as its start and end, e.g. "Fri 16:00-Mon 09:00"
+func parseFreezeWindow(s string, timezone string, reason string) (oapi.FreezeWindow, error) {
+	start, end, ok := strings.Cut(s, "-")
+	if !ok {
+		return oapi.FreezeWindow{}, fmt.Errorf("freeze window %q is not a start and end like \"Fri 16:00-Mon 09:00\"", s)
+	}
+	return oapi.FreezeWindow{
+		Start:    strings.TrimSpace(start),
+		End:      strings.TrimSpace(end),
+		Timezone: lo.EmptyableToPtr(timezone),
+		Reason:   lo.EmptyableToPtr(reason),
+	}, nil
+}
+
+func runUpdate(cmd *cobra.Command, args []string) {
+	envName := cmd.Flags().Lookup("env").Value.String()
+	body := oapi.UpdateEnvJSONRequestBody{}
+	if cmd.Flags().Changed("auto-rollback") {
+		autoRollback, _ := cmd.Flags().GetBool("auto-rollback")
+		body.AutoRollback = &autoRollback
+	}
+	if cmd.Flags().Changed("progress-deadline") {
+		progressDeadline, _ := cmd.Flags().GetDuration("progress-deadline")
+		seconds := int(progressDeadline.Seconds())
+		body.ProgressDeadlineSeconds = &seconds
+	}
+	if cmd.Flags().Changed("protected") {
+		protected, _ := cmd.Flags().GetBool("protected")
+		body.Protected = &protected
+	}
+	if cmd.Flags().Changed("freeze-window") {
+		windows, _ := cmd.Flags().GetStringArray("freeze-window")
+		timezone := cmd.Flags().Lookup("timezone").Value.String()
+		reason := cmd.Flags().Lookup("freeze-reason").Value.String()
+		freezeWindows := []oapi.FreezeWindow{}
+		for _, w := range lo.Compact(windows) {
+			window, err := parseFreezeWindow(w, timezone, reason)
+			if err != nil {
+				fmt.Println(lipgloss.NewStyle().Foreground(style.Error).Render(fmt.Sprintf("Error: %v", err)))
+				os.Exit(1)
+			}
+			freezeWindows = append(freezeWindows, window)
+		}
+		body.FreezeWindows = &freezeWindows
+	}
+	var defaultCell *string
+	if cmd.Flags().Changed("default-cell") {
+		defaultCell = lo.ToPtr(cmd.Flags().Lookup("default-cell").Value.String())
+	}
+	runProgram(model{
+		loadingText: fmt.Sprintf("updating %s...", envName),
+		run:         UpdateCmd(common.MustApiClient(), envName, defaultCell, body),
+		success: func(e oapi.Env) string {
+			return fmt.Sprintf("✅ %s: %s", e.Name, policy(e))
+		},
+	})
+}
+
+// UpdateCmd updates an env. defaultCell is the name or id of the env's new default cell, if it changes
+func UpdateCmd(apiClient oapi.ClientWithResponsesInterface, envName string, defaultCell *string, body oapi.UpdateEnvJSONRequestBody) func() tea.Msg {
+	return func() tea.Msg {
+		ctx := context.Background()
+		env, err := common.FindEnvByName(ctx, apiClient, envName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		if defaultCell != nil {
+			body.DefaultCellId = lo.ToPtr("")
+			if *defaultCell != "" {
+				cell, err := common.FindCellByName(ctx, apiClient, *defaultCell)
+				if err != nil {
+					return Msg{Error: err}
+				}
+				body.DefaultCellId = &cell.Id
+			}
+		}
+		resp, err := apiClient.UpdateEnvWithResponse(ctx, env.Id, body)
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusOK {
+			return Msg{Error: fmt.Errorf("API returned non-200 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		return Msg{Success: resp.JSON200}
+	}
+}
+
+func runLock(cmd *cobra.Command, args []string) {
+	envName := cmd.Flags().Lookup("env").Value.String()
+	reason := cmd.Flags().Lookup("reason").Value.String()
+	runProgram(model{
+		loadingText: fmt.Sprintf("locking %s...", envName),
+		run:         LockCmd(common.MustApiClient(), envName, reason),
+		success: func(e oapi.Env) string {
+			return fmt.Sprintf("🔒 %s: %s", e.Name, policy(e))
+		},
+	})
+}
+
+// LockCmd locks an env, recording why
+func LockCmd(apiClient oapi.ClientWithResponsesInterface, envName string, reason string) func() tea.Msg {
+	return func() tea.Msg {
+		ctx := context.Background()
+		env, err := common.FindEnvByName(ctx, apiClient, envName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		resp, err := apiClient.LockEnvWithResponse(ctx, env.Id, oapi.LockEnvJSONRequestBody{Reason: lo.EmptyableToPtr(reason)})
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusOK {
+			return Msg{Error: fmt.Errorf("API returned non-200 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		return Msg{Success: resp.JSON200}
+	}
+}
+
+func runUnlock(cmd *cobra.Command, args []string) {
+	envName := cmd.Flags().Lookup("env").Value.String()
+	runProgram(model{
+		loadingText: fmt.Sprintf("unlocking %s...", envName),
+		run:         UnlockCmd(common.MustApiClient(), envName),
+		success: func(e oapi.Env) string {
+			return fmt.Sprintf("🔓 %s: %s", e.Name, policy(e))
+		},
+	})
+}
+
+// UnlockCmd unlocks an env
+func UnlockCmd(apiClient oapi.ClientWithResponsesInterface, envName string) func() tea.Msg {
+	return func() tea.Msg {
+		ctx := context.Background()
+		env, err := common.FindEnvByName(ctx, apiClient, envName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		resp, err := apiClient.UnlockEnvWithResponse(ctx, env.Id)
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusOK {
+			return Msg{Error: fmt.Errorf("API returned non-200 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		return Msg{Success: resp.JSON200}
+	}
+}
@@ -0,0 +1,146 @@
+package env
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/onmetal-dev/metal/lib/cli/common"
+	"github.com/onmetal-dev/metal/lib/cli/style"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+)
+
+func newVarsCmd() *cobra.Command {
+	varsCmd := &cobra.Command{
+		Use:   "vars",
+		Short: "List the env vars shared by all apps in the environment",
+		Long: "Shared env vars, e.g. SENTRY_DSN, are added to the env vars of every app in the environment. An app's own env vars win over shared ones with the same name. " +
+			"Apps pick up changes with their next deployment, or right away if you pass --redeploy. Values are never shown.",
+		Example: "  metal env vars -e production\n" +
+			"  metal env vars set -e production SENTRY_DSN=https://key@sentry.example.com/1 LOG_LEVEL=info\n" +
+			"  metal env vars set -e production LOG_LEVEL=debug --redeploy\n" +
+			"  metal env vars unset -e production LOG_LEVEL",
+		Args:   cobra.NoArgs,
+		PreRun: common.CheckToken,
+		Run:    runVars,
+	}
+
+	setCmd := &cobra.Command{
+		Use:    "set KEY=VALUE...",
+		Short:  "Add or change env vars shared by all apps in the environment",
+		Args:   cobra.MinimumNArgs(1),
+		PreRun: common.CheckToken,
+		Run:    runSetVars,
+	}
+	setCmd.Flags().Bool("redeploy", false, "Redeploy the apps running in the environment that the change affects")
+
+	unsetCmd := &cobra.Command{
+		Use:    "unset KEY...",
+		Short:  "Remove env vars shared by all apps in the environment",
+		Args:   cobra.MinimumNArgs(1),
+		PreRun: common.CheckToken,
+		Run:    runUnsetVars,
+	}
+	unsetCmd.Flags().Bool("redeploy", false, "Redeploy the apps running in the environment that the change affects")
+
+	varsCmd.AddCommand(setCmd, unsetCmd)
+	return varsCmd
+}
+
+// sharedVars describes the env vars shared by an env's apps, and the deployments that roll a change of them out
+func sharedVars(envName string, v oapi.SharedEnvVars) string {
+	s := fmt.Sprintf("%s has no shared env vars", envName)
+	if len(v.Names) > 0 {
+		s = fmt.Sprintf("%s shares %s with its apps", envName, strings.Join(v.Names, ", "))
+	}
+	if v.Redeployed != nil {
+		s += fmt.Sprintf(", redeploying %d apps", len(*v.Redeployed))
+	}
+	for _, e := range lo.FromPtr(v.RedeployErrors) {
+		s += fmt.Sprintf("\n❌ couldn't redeploy %s: %s", e.AppName, e.Error)
+	}
+	return s
+}
+
+func runVars(cmd *cobra.Command, args []string) {
+	envName := cmd.Flags().Lookup("env").Value.String()
+	runProgram(model{
+		loadingText: fmt.Sprintf("getting the shared env vars of %s...", envName),
+		run:         VarsCmd(common.MustApiClient(), envName),
+		varsSuccess: func(v oapi.SharedEnvVars) string {
+			return fmt.Sprintf("🔑 %s", sharedVars(envName, v))
+		},
+	})
+}
+
+// VarsCmd lists the names of the env vars shared by an env's apps
+func VarsCmd(apiClient oapi.ClientWithResponsesInterface, envName string) func() tea.Msg {
+	return func() tea.Msg {
+		ctx := context.Background()
+		env, err := common.FindEnvByName(ctx, apiClient, envName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		resp, err := apiClient.GetSharedEnvVarsWithResponse(ctx, env.Id)
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusOK {
+			return Msg{Error: fmt.Errorf("API returned non-200 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		return Msg{Vars: resp.JSON200}
+	}
+}
+
+func runSetVars(cmd *cobra.Command, args []string) {
+	envVars := []oapi.EnvVar{}
+	for _, arg := range args {
+		name, value, ok := strings.Cut(arg, "=")
+		if !ok || name == "" {
+			fmt.Println(lipgloss.NewStyle().Foreground(style.Error).Render(fmt.Sprintf("Error: %s is not KEY=VALUE", arg)))
+			os.Exit(1)
+		}
+		envVars = append(envVars, oapi.EnvVar{Name: name, Value: value})
+	}
+	redeploy, _ := cmd.Flags().GetBool("redeploy")
+	runUpdateVars(cmd, oapi.UpdateSharedEnvVarsJSONRequestBody{SetEnvVars: &envVars, Redeploy: lo.ToPtr(redeploy)})
+}
+
+func runUnsetVars(cmd *cobra.Command, args []string) {
+	redeploy, _ := cmd.Flags().GetBool("redeploy")
+	runUpdateVars(cmd, oapi.UpdateSharedEnvVarsJSONRequestBody{UnsetEnvVars: &args, Redeploy: lo.ToPtr(redeploy)})
+}
+
+func runUpdateVars(cmd *cobra.Command, body oapi.UpdateSharedEnvVarsJSONRequestBody) {
+	envName := cmd.Flags().Lookup("env").Value.String()
+	runProgram(model{
+		loadingText: fmt.Sprintf("updating the shared env vars of %s...", envName),
+		run:         UpdateVarsCmd(common.MustApiClient(), envName, body),
+		varsSuccess: func(v oapi.SharedEnvVars) string {
+			return fmt.Sprintf("✅ %s", sharedVars(envName, v))
+		},
+	})
+}
+
+// UpdateVarsCmd changes the env vars shared by an env's apps
+func UpdateVarsCmd(apiClient oapi.ClientWithResponsesInterface, envName string, body oapi.UpdateSharedEnvVarsJSONRequestBody) func() tea.Msg {
+	return func() tea.Msg {
+		ctx := context.Background()
+		env, err := common.FindEnvByName(ctx, apiClient, envName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		resp, err := apiClient.UpdateSharedEnvVarsWithResponse(ctx, env.Id, body)
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusOK {
+			return Msg{Error: fmt.Errorf("API returned non-200 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		return Msg{Vars: resp.JSON200}
+	}
+}
@@ -0,0 +1,296 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/dustin/go-humanize"
+	"github.com/onmetal-dev/metal/lib/cli/common"
+	"github.com/onmetal-dev/metal/lib/cli/style"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+)
+
+// Msg carries the rendered result of a jobs subcommand
+type Msg struct {
+	Success string
+	Error   error
+}
+
+type model struct {
+	loading     spinner.Model
+	loadingText string
+	run         func() tea.Msg
+	msg         *Msg
+}
+
+var _ tea.Model = (*model)(nil)
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.loading.Tick, m.run)
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.loading, cmd = m.loading.Update(msg)
+		return m, cmd
+	case Msg:
+		m.msg = &msg
+		return m, tea.Quit
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyCtrlC:
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	if m.msg == nil {
+		return fmt.Sprintf("\n %s %s\n\n", m.loading.View(), lipgloss.NewStyle().Foreground(style.BaseLight).Render(m.loadingText))
+	}
+	if m.msg.Error != nil {
+		return fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Error).Render(fmt.Sprintf("Error: %v", m.msg.Error)))
+	}
+	return m.msg.Success + "\n"
+}
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:    "jobs",
+		Short:  "Manage an app's scheduled jobs",
+		Long:   "Scheduled jobs run a command on a cron schedule in an env, using the image and environment variables of the app's running deployment. Without a subcommand, lists the app's jobs.",
+		PreRun: common.CheckToken,
+		Run:    runList,
+	}
+	cmd.PersistentFlags().StringP("app", "a", "", "Name of the app")
+	cmd.PersistentFlags().StringP("env", "e", "", "Name of the environment")
+	cmd.MarkPersistentFlagRequired("app")
+	cmd.MarkPersistentFlagRequired("env")
+
+	listCmd := &cobra.Command{
+		Use:    "list",
+		Short:  "List an app's scheduled jobs",
+		PreRun: common.CheckToken,
+		Run:    runList,
+	}
+
+	createCmd := &cobra.Command{
+		Use:     "create <name>",
+		Short:   "Create a scheduled job",
+		Example: `  metal jobs create nightly-report -a myapp -e production --schedule "0 3 * * *" --timezone America/New_York --command "bin/report"`,
+		Args:    cobra.ExactArgs(1),
+		PreRun:  common.CheckToken,
+		Run:     runCreate,
+	}
+	createCmd.Flags().String("schedule", "", `Five-field cron schedule, e.g. "0 3 * * *"`)
+	createCmd.Flags().String("command", "", "Command to run with /bin/sh -c")
+	createCmd.Flags().String("timezone", "UTC", "IANA time zone the schedule is in")
+	createCmd.Flags().String("concurrency-policy", string(oapi.CronJobConcurrencyPolicyAllow), "What to do when a run is due while the previous one is still going: allow, forbid or replace")
+	createCmd.MarkFlagRequired("schedule")
+	createCmd.MarkFlagRequired("command")
+
+	deleteCmd := &cobra.Command{
+		Use:    "delete <name>",
+		Short:  "Delete a scheduled job",
+		Args:   cobra.ExactArgs(1),
+		PreRun: common.CheckToken,
+		Run:    runDelete,
+	}
+
+	runsCmd := &cobra.Command{
+		Use:    "runs <name>",
+		Short:  "Show the recent runs of a scheduled job",
+		Args:   cobra.ExactArgs(1),
+		PreRun: common.CheckToken,
+		Run:    runRuns,
+	}
+
+	logsCmd := &cobra.Command{
+		Use:    "logs <name> <run>",
+		Short:  "Show the logs of a run of a scheduled job",
+		Args:   cobra.ExactArgs(2),
+		PreRun: common.CheckToken,
+		Run:    runLogs,
+	}
+
+	cmd.AddCommand(listCmd, createCmd, deleteCmd, runsCmd, logsCmd)
+	return cmd
+}
+
+func runProgram(loadingText string, run func() tea.Msg) {
+	p := tea.NewProgram(model{
+		loading:     common.NewSpinner(),
+		loadingText: loadingText,
+		run:         run,
+	})
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
+
+// appEnvIds resolves the --app and --env flags to ids
+func appEnvIds(ctx context.Context, apiClient oapi.ClientWithResponsesInterface, cmd *cobra.Command) (string, string, error) {
+	app, err := common.FindAppByName(ctx, apiClient, cmd.Flags().Lookup("app").Value.String())
+	if err != nil {
+		return "", "", err
+	}
+	env, err := common.FindEnvByName(ctx, apiClient, cmd.Flags().Lookup("env").Value.String())
+	if err != nil {
+		return "", "", err
+	}
+	return app.Id, env.Id, nil
+}
+
+func renderTable(headers []string, rows [][]string) string {
+	baseStyle := lipgloss.NewStyle().Foreground(style.Primary)
+	return table.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(baseStyle).
+		Headers(headers...).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return baseStyle.Foreground(style.Neutral).Bold(true)
+			}
+			return baseStyle.Foreground(style.Neutral)
+		}).
+		Rows(rows...).
+		Render()
+}
+
+func runList(cmd *cobra.Command, args []string) {
+	apiClient := common.MustApiClient()
+	runProgram("loading jobs...", func() tea.Msg {
+		ctx := context.Background()
+		appId, envId, err := appEnvIds(ctx, apiClient, cmd)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		resp, err := apiClient.GetCronJobsWithResponse(ctx, appId, envId)
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusOK {
+			return Msg{Error: fmt.Errorf("API returned non-200 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		if len(*resp.JSON200) == 0 {
+			return Msg{Success: lipgloss.NewStyle().Foreground(style.BaseLight).Render("no jobs yet, create one with metal jobs create")}
+		}
+		rows := lo.Map(*resp.JSON200, func(c oapi.CronJob, _ int) []string {
+			return []string{c.Name, c.Schedule, c.Timezone, string(c.ConcurrencyPolicy), c.Command}
+		})
+		return Msg{Success: renderTable([]string{"Name", "Schedule", "Timezone", "Concurrency", "Command"}, rows)}
+	})
+}
+
+func runCreate(cmd *cobra.Command, args []string) {
+	apiClient := common.MustApiClient()
+	name := args[0]
+	timezone := cmd.Flags().Lookup("timezone").Value.String()
+	concurrencyPolicy := oapi.CronJobConcurrencyPolicy(cmd.Flags().Lookup("concurrency-policy").Value.String())
+	body := oapi.CreateCronJobJSONRequestBody{
+		Name:              name,
+		Schedule:          cmd.Flags().Lookup("schedule").Value.String(),
+		Command:           cmd.Flags().Lookup("command").Value.String(),
+		Timezone:          &timezone,
+		ConcurrencyPolicy: &concurrencyPolicy,
+	}
+	runProgram(fmt.Sprintf("creating job %s...", name), func() tea.Msg {
+		ctx := context.Background()
+		appId, envId, err := appEnvIds(ctx, apiClient, cmd)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		resp, err := apiClient.CreateCronJobWithResponse(ctx, appId, envId, body)
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusCreated {
+			return Msg{Error: fmt.Errorf("API returned non-201 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		c := resp.JSON201
+		return Msg{Success: lipgloss.NewStyle().Foreground(style.Success).Render(fmt.Sprintf("✅ job %s created, runs on %q (%s)", c.Name, c.Schedule, c.Timezone))}
+	})
+}
+
+func runDelete(cmd *cobra.Command, args []string) {
+	apiClient := common.MustApiClient()
+	name := args[0]
+	runProgram(fmt.Sprintf("deleting job %s...", name), func() tea.Msg {
+		ctx := context.Background()
+		appId, envId, err := appEnvIds(ctx, apiClient, cmd)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		resp, err := apiClient.DeleteCronJobWithResponse(ctx, appId, envId, name)
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusNoContent {
+			return Msg{Error: fmt.Errorf("API returned non-204 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		return Msg{Success: lipgloss.NewStyle().Foreground(style.Success).Render(fmt.Sprintf("✅ job %s deleted", name))}
+	})
+}
+
+func runRuns(cmd *cobra.Command, args []string) {
+	apiClient := common.MustApiClient()
+	name := args[0]
+	runProgram(fmt.Sprintf("loading runs of job %s...", name), func() tea.Msg {
+		ctx := context.Background()
+		appId, envId, err := appEnvIds(ctx, apiClient, cmd)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		resp, err := apiClient.GetCronJobRunsWithResponse(ctx, appId, envId, name)
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusOK {
+			return Msg{Error: fmt.Errorf("API returned non-200 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		if len(*resp.JSON200) == 0 {
+			return Msg{Success: lipgloss.NewStyle().Foreground(style.BaseLight).Render(fmt.Sprintf("job %s hasn't run yet", name))}
+		}
+		rows := lo.Map(*resp.JSON200, func(r oapi.CronJobRun, _ int) []string {
+			duration := "-"
+			if r.CompletedAt != nil {
+				duration = r.CompletedAt.Sub(r.StartedAt).Round(time.Second).String()
+			}
+			return []string{r.Name, string(r.Status), humanize.Time(r.StartedAt), duration, r.StatusReason}
+		})
+		return Msg{Success: renderTable([]string{"Run", "Status", "Started", "Duration", "Reason"}, rows)}
+	})
+}
+
+func runLogs(cmd *cobra.Command, args []string) {
+	apiClient := common.MustApiClient()
+	name, runName := args[0], args[1]
+	runProgram(fmt.Sprintf("loading logs of run %s...", runName), func() tea.Msg {
+		ctx := context.Background()
+		appId, envId, err := appEnvIds(ctx, apiClient, cmd)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		resp, err := apiClient.GetCronJobRunLogsWithResponse(ctx, appId, envId, name, runName)
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusOK {
+			return Msg{Error: fmt.Errorf("API returned non-200 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		timeStyle := lipgloss.NewStyle().Foreground(style.BaseLight)
+		lines := lo.Map(*resp.JSON200, func(l oapi.LogEntry, _ int) string {
+			return fmt.Sprintf("%s %s", timeStyle.Render(l.Time.Format(time.RFC3339)), l.Message)
+		})
+		return Msg{Success: strings.Join(lines, "\n")}
+	})
+}
@@ -0,0 +1,134 @@
+package promote
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/onmetal-dev/metal/lib/cli/common"
+	"github.com/onmetal-dev/metal/lib/cli/style"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/spf13/cobra"
+)
+
+type Msg struct {
+	Success *oapi.Deployment
+	Error   error
+}
+
+type model struct {
+	loading      spinner.Model
+	apiClient    oapi.ClientWithResponsesInterface
+	app          string
+	from         string
+	to           string
+	deploymentId int
+	promoteMsg   *Msg
+}
+
+var _ tea.Model = (*model)(nil)
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.loading.Tick, PromoteCmd(m.apiClient, m.app, m.from, m.to, m.deploymentId))
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.loading, cmd = m.loading.Update(msg)
+		return m, cmd
+	case Msg:
+		m.promoteMsg = &msg
+		return m, tea.Quit
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyCtrlC:
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	if m.promoteMsg == nil {
+		return fmt.Sprintf("\n %s %s\n\n", m.loading.View(), lipgloss.NewStyle().Foreground(style.BaseLight).Render(fmt.Sprintf("promoting %s from %s to %s...", m.app, m.from, m.to)))
+	}
+	if m.promoteMsg.Error != nil {
+		return fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Error).Render(fmt.Sprintf("Error: %v", m.promoteMsg.Error)))
+	}
+	d := m.promoteMsg.Success
+	msg := fmt.Sprintf("✅ deployment %d created to promote %s from %s to %s", d.Id, m.app, m.from, m.to)
+	if d.Status == oapi.DeploymentStatusPendingApproval {
+		msg += ". It is waiting for a team admin to approve it"
+	}
+	return fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Success).Render(msg))
+}
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "promote",
+		Short:   "Deploy the build an app runs in one environment to another",
+		Long:    "Deploys the image of an app's deployment in one environment to another without rebuilding it. The new deployment takes its image, ports and resources from the environment it is promoted from, and keeps the environment variables and other settings of the one it is promoted to.",
+		Example: "  metal promote -a myapp --from staging --to production\n  metal promote -a myapp --from staging --to production -d 42",
+		PreRun:  common.CheckToken,
+		Run:     runPromote,
+	}
+	cmd.Flags().StringP("app", "a", "", "Name of the app to promote")
+	cmd.Flags().String("from", "", "Name of the environment to promote from")
+	cmd.Flags().String("to", "", "Name of the environment to promote to")
+	cmd.Flags().IntP("deployment", "d", 0, "Id of the deployment to promote. Defaults to the one running in the environment promoted from")
+	cmd.MarkFlagRequired("app")
+	cmd.MarkFlagRequired("from")
+	cmd.MarkFlagRequired("to")
+	return cmd
+}
+
+func runPromote(cmd *cobra.Command, args []string) {
+	deploymentId, _ := cmd.Flags().GetInt("deployment")
+	p := tea.NewProgram(model{
+		loading:      common.NewSpinner(),
+		apiClient:    common.MustApiClient(),
+		app:          cmd.Flags().Lookup("app").Value.String(),
+		from:         cmd.Flags().Lookup("from").Value.String(),
+		to:           cmd.Flags().Lookup("to").Value.String(),
+		deploymentId: deploymentId,
+	})
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
+
+func PromoteCmd(apiClient oapi.ClientWithResponsesInterface, appName, fromEnvName, toEnvName string, deploymentId int) func() tea.Msg {
+	return func() tea.Msg {
+		ctx := context.Background()
+		app, err := common.FindAppByName(ctx, apiClient, appName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		from, err := common.FindEnvByName(ctx, apiClient, fromEnvName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		to, err := common.FindEnvByName(ctx, apiClient, toEnvName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		body := oapi.PromoteJSONRequestBody{FromEnvId: from.Id}
+		if deploymentId != 0 {
+			body.DeploymentId = &deploymentId
+		}
+		resp, err := apiClient.PromoteWithResponse(ctx, app.Id, to.Id, body)
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusCreated {
+			return Msg{Error: fmt.Errorf("API returned non-201 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		return Msg{Success: resp.JSON201}
+	}
+}
@@ -0,0 +1,113 @@
+package restart
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/onmetal-dev/metal/lib/cli/common"
+	"github.com/onmetal-dev/metal/lib/cli/style"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/spf13/cobra"
+)
+
+type Msg struct {
+	Success *oapi.Deployment
+	Error   error
+}
+
+type model struct {
+	loading    spinner.Model
+	apiClient  oapi.ClientWithResponsesInterface
+	app        string
+	env        string
+	restartMsg *Msg
+}
+
+var _ tea.Model = (*model)(nil)
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.loading.Tick, RestartCmd(m.apiClient, m.app, m.env))
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.loading, cmd = m.loading.Update(msg)
+		return m, cmd
+	case Msg:
+		m.restartMsg = &msg
+		return m, tea.Quit
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyCtrlC:
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	if m.restartMsg == nil {
+		return fmt.Sprintf("\n %s %s\n\n", m.loading.View(), lipgloss.NewStyle().Foreground(style.BaseLight).Render(fmt.Sprintf("restarting %s in %s...", m.app, m.env)))
+	}
+	if m.restartMsg.Error != nil {
+		return fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Error).Render(fmt.Sprintf("Error: %v", m.restartMsg.Error)))
+	}
+	d := m.restartMsg.Success
+	return fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Success).Render(fmt.Sprintf("✅ deployment %d created to restart %s in %s", d.Id, m.app, m.env)))
+}
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:    "restart",
+		Short:  "Restart all replicas of an app",
+		Long:   "Creates a new deployment that rolls every replica of an app without changing its image or environment variables.",
+		PreRun: common.CheckToken,
+		Run:    runRestart,
+	}
+	cmd.Flags().StringP("app", "a", "", "Name of the app to restart")
+	cmd.Flags().StringP("env", "e", "", "Name of the environment to restart in")
+	cmd.MarkFlagRequired("app")
+	cmd.MarkFlagRequired("env")
+	return cmd
+}
+
+func runRestart(cmd *cobra.Command, args []string) {
+	p := tea.NewProgram(model{
+		loading:   common.NewSpinner(),
+		apiClient: common.MustApiClient(),
+		app:       cmd.Flags().Lookup("app").Value.String(),
+		env:       cmd.Flags().Lookup("env").Value.String(),
+	})
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
+
+func RestartCmd(apiClient oapi.ClientWithResponsesInterface, appName, envName string) func() tea.Msg {
+	return func() tea.Msg {
+		ctx := context.Background()
+		app, err := common.FindAppByName(ctx, apiClient, appName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		env, err := common.FindEnvByName(ctx, apiClient, envName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		resp, err := apiClient.RestartWithResponse(ctx, app.Id, env.Id)
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusCreated {
+			return Msg{Error: fmt.Errorf("API returned non-201 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		return Msg{Success: resp.JSON201}
+	}
+}
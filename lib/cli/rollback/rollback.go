@@ -0,0 +1,118 @@
+package rollback
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/onmetal-dev/metal/lib/cli/common"
+	"github.com/onmetal-dev/metal/lib/cli/style"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/spf13/cobra"
+)
+
+type Msg struct {
+	Success *oapi.Deployment
+	Error   error
+}
+
+type model struct {
+	loading      spinner.Model
+	apiClient    oapi.ClientWithResponsesInterface
+	app          string
+	env          string
+	deploymentId int
+	rollbackMsg  *Msg
+}
+
+var _ tea.Model = (*model)(nil)
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.loading.Tick, RollbackCmd(m.apiClient, m.app, m.env, m.deploymentId))
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.loading, cmd = m.loading.Update(msg)
+		return m, cmd
+	case Msg:
+		m.rollbackMsg = &msg
+		return m, tea.Quit
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyCtrlC:
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	if m.rollbackMsg == nil {
+		return fmt.Sprintf("\n %s %s\n\n", m.loading.View(), lipgloss.NewStyle().Foreground(style.BaseLight).Render(fmt.Sprintf("rolling back %s in %s to deployment %d...", m.app, m.env, m.deploymentId)))
+	}
+	if m.rollbackMsg.Error != nil {
+		return fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Error).Render(fmt.Sprintf("Error: %v", m.rollbackMsg.Error)))
+	}
+	d := m.rollbackMsg.Success
+	return fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Success).Render(fmt.Sprintf("✅ deployment %d created to roll back %s in %s to deployment %d", d.Id, m.app, m.env, m.deploymentId)))
+}
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:    "rollback",
+		Short:  "Roll an app back to a previous deployment",
+		Long:   "Creates a new deployment that reuses the settings, environment variables, and replica count of a previous deployment.",
+		PreRun: common.CheckToken,
+		Run:    runRollback,
+	}
+	cmd.Flags().StringP("app", "a", "", "Name of the app to roll back")
+	cmd.Flags().StringP("env", "e", "", "Name of the environment to roll back in")
+	cmd.Flags().IntP("deployment", "d", 0, "Id of the previous deployment to roll back to")
+	cmd.MarkFlagRequired("app")
+	cmd.MarkFlagRequired("env")
+	cmd.MarkFlagRequired("deployment")
+	return cmd
+}
+
+func runRollback(cmd *cobra.Command, args []string) {
+	deploymentId, _ := cmd.Flags().GetInt("deployment")
+	p := tea.NewProgram(model{
+		loading:      common.NewSpinner(),
+		apiClient:    common.MustApiClient(),
+		app:          cmd.Flags().Lookup("app").Value.String(),
+		env:          cmd.Flags().Lookup("env").Value.String(),
+		deploymentId: deploymentId,
+	})
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
+
+func RollbackCmd(apiClient oapi.ClientWithResponsesInterface, appName, envName string, deploymentId int) func() tea.Msg {
+	return func() tea.Msg {
+		ctx := context.Background()
+		app, err := common.FindAppByName(ctx, apiClient, appName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		env, err := common.FindEnvByName(ctx, apiClient, envName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		resp, err := apiClient.RollbackWithResponse(ctx, app.Id, env.Id, oapi.RollbackJSONRequestBody{DeploymentId: deploymentId})
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusCreated {
+			return Msg{Error: fmt.Errorf("API returned non-201 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		return Msg{Success: resp.JSON201}
+	}
+}
@@ -6,6 +6,19 @@ import (
 	"path"
 	"strings"
 
+	"github.com/onmetal-dev/metal/lib/cli/approval"
+	"github.com/onmetal-dev/metal/lib/cli/autoscale"
+	"github.com/onmetal-dev/metal/lib/cli/canary"
+	"github.com/onmetal-dev/metal/lib/cli/cancel"
+	"github.com/onmetal-dev/metal/lib/cli/cells"
+	"github.com/onmetal-dev/metal/lib/cli/deploy"
+	"github.com/onmetal-dev/metal/lib/cli/diff"
+	"github.com/onmetal-dev/metal/lib/cli/env"
+	"github.com/onmetal-dev/metal/lib/cli/jobs"
+	"github.com/onmetal-dev/metal/lib/cli/promote"
+	"github.com/onmetal-dev/metal/lib/cli/restart"
+	"github.com/onmetal-dev/metal/lib/cli/rollback"
+	"github.com/onmetal-dev/metal/lib/cli/scale"
 	"github.com/onmetal-dev/metal/lib/cli/up"
 	"github.com/onmetal-dev/metal/lib/cli/whoami"
 	"github.com/spf13/cobra"
@@ -52,12 +65,26 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.metal/config.yaml)")
 	rootCmd.PersistentFlags().String("api-base-url", "https://www.onmetal.dev", "API base URL")
 	rootCmd.PersistentFlags().String("api-token", "", "Token for authentication")
+	rootCmd.PersistentFlags().Bool("override-lock", false, "Deploy even if the environment is locked or in a freeze window. Only admins can override locks")
 	rootCmd.PersistentFlags().VisitAll(func(flag *pflag.Flag) {
 		viper.BindPFlag(flag.Name, flag)
 	})
 
 	rootCmd.AddCommand(whoami.NewCmd())
 	rootCmd.AddCommand(up.NewCmd())
+	rootCmd.AddCommand(rollback.NewCmd())
+	rootCmd.AddCommand(scale.NewCmd())
+	rootCmd.AddCommand(restart.NewCmd())
+	rootCmd.AddCommand(jobs.NewCmd())
+	rootCmd.AddCommand(canary.NewCmd())
+	rootCmd.AddCommand(autoscale.NewCmd())
+	rootCmd.AddCommand(diff.NewCmd())
+	rootCmd.AddCommand(cancel.NewCmd())
+	rootCmd.AddCommand(approval.NewCmd())
+	rootCmd.AddCommand(env.NewCmd())
+	rootCmd.AddCommand(cells.NewCmd())
+	rootCmd.AddCommand(deploy.NewCmd())
+	rootCmd.AddCommand(promote.NewCmd())
 }
 
 // initConfig reads in config file and ENV variables if set.
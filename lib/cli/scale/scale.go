@@ -0,0 +1,134 @@
+package scale
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dustin/go-humanize/english"
+	"github.com/onmetal-dev/metal/lib/cli/common"
+	"github.com/onmetal-dev/metal/lib/cli/style"
+	"github.com/onmetal-dev/metal/lib/oapi"
+	"github.com/spf13/cobra"
+)
+
+type Msg struct {
+	Success *oapi.Deployment
+	Error   error
+}
+
+type model struct {
+	loading   spinner.Model
+	apiClient oapi.ClientWithResponsesInterface
+	app       string
+	env       string
+	process   string
+	replicas  int
+	scaleMsg  *Msg
+}
+
+var _ tea.Model = (*model)(nil)
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.loading.Tick, ScaleCmd(m.apiClient, m.app, m.env, m.process, m.replicas))
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.loading, cmd = m.loading.Update(msg)
+		return m, cmd
+	case Msg:
+		m.scaleMsg = &msg
+		return m, tea.Quit
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyCtrlC:
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+// target is what is being scaled, e.g. "myapp" or "myapp worker"
+func (m model) target() string {
+	if m.process == "" {
+		return m.app
+	}
+	return fmt.Sprintf("%s %s", m.app, m.process)
+}
+
+func (m model) View() string {
+	if m.scaleMsg == nil {
+		return fmt.Sprintf("\n %s %s\n\n", m.loading.View(), lipgloss.NewStyle().Foreground(style.BaseLight).Render(fmt.Sprintf("scaling %s in %s to %s...", m.target(), m.env, english.Plural(m.replicas, "replica", ""))))
+	}
+	if m.scaleMsg.Error != nil {
+		return fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Error).Render(fmt.Sprintf("Error: %v", m.scaleMsg.Error)))
+	}
+	d := m.scaleMsg.Success
+	return fmt.Sprintf("%s\n", lipgloss.NewStyle().Foreground(style.Success).Render(fmt.Sprintf("✅ deployment %d created to scale %s in %s to %s", d.Id, m.target(), m.env, english.Plural(m.replicas, "replica", ""))))
+}
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:    "scale",
+		Short:  "Change the number of replicas an app runs",
+		Long:   "Creates a new deployment that reuses the latest settings and environment variables with a different replica count. No rebuild is performed.",
+		PreRun: common.CheckToken,
+		Run:    runScale,
+	}
+	cmd.Flags().StringP("app", "a", "", "Name of the app to scale")
+	cmd.Flags().StringP("env", "e", "", "Name of the environment to scale in")
+	cmd.Flags().IntP("replicas", "r", 0, "Number of replicas to run")
+	cmd.Flags().StringP("process", "p", "", "Process to scale, e.g. worker. Required if the app defines its own processes")
+	cmd.MarkFlagRequired("app")
+	cmd.MarkFlagRequired("env")
+	cmd.MarkFlagRequired("replicas")
+	return cmd
+}
+
+func runScale(cmd *cobra.Command, args []string) {
+	replicas, _ := cmd.Flags().GetInt("replicas")
+	p := tea.NewProgram(model{
+		loading:   common.NewSpinner(),
+		apiClient: common.MustApiClient(),
+		app:       cmd.Flags().Lookup("app").Value.String(),
+		env:       cmd.Flags().Lookup("env").Value.String(),
+		process:   cmd.Flags().Lookup("process").Value.String(),
+		replicas:  replicas,
+	})
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
+
+func ScaleCmd(apiClient oapi.ClientWithResponsesInterface, appName, envName, process string, replicas int) func() tea.Msg {
+	return func() tea.Msg {
+		ctx := context.Background()
+		app, err := common.FindAppByName(ctx, apiClient, appName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		env, err := common.FindEnvByName(ctx, apiClient, envName)
+		if err != nil {
+			return Msg{Error: err}
+		}
+		body := oapi.ScaleJSONRequestBody{Replicas: replicas}
+		if process != "" {
+			body.Process = &process
+		}
+		resp, err := apiClient.ScaleWithResponse(ctx, app.Id, env.Id, body)
+		if err != nil {
+			return Msg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusCreated {
+			return Msg{Error: fmt.Errorf("API returned non-201 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		return Msg{Success: resp.JSON201}
+	}
+}
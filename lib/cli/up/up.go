@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"iter"
@@ -11,6 +12,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -99,6 +101,35 @@ func getEnvsCmd(client oapi.ClientWithResponsesInterface) tea.Cmd {
 	}
 }
 
+// cellsToItems converts a list of cells to a list of list.Items
+func cellsToItems(cells []oapi.Cell) []list.Item {
+	return lo.Map(cells, func(cell oapi.Cell, _ int) list.Item {
+		return item{
+			title: cell.Name,
+			desc:  cell.Id,
+		}
+	})
+}
+
+// getCellsMsg is a message sent when cells have been fetched from the API
+type getCellsMsg struct {
+	Cells []oapi.Cell
+	Error error
+}
+
+// getCellsCmd is a command that fetches cells from the API
+func getCellsCmd(client oapi.ClientWithResponsesInterface) tea.Cmd {
+	return func() tea.Msg {
+		resp, err := client.GetCellsWithResponse(context.Background())
+		if err != nil {
+			return getCellsMsg{Error: fmt.Errorf("error making request: %w", err)}
+		} else if resp.StatusCode() != http.StatusOK {
+			return getCellsMsg{Error: fmt.Errorf("API returned non-200 status: %d: %s", resp.StatusCode(), string(resp.Body))}
+		}
+		return getCellsMsg{Cells: *resp.JSON200}
+	}
+}
+
 // upRequestMsg is the result of initiating a request to the /up API endpoint
 type upRequestMsg struct {
 	Result io.ReadCloser
@@ -166,6 +197,9 @@ func upRequestIterCmd(pull iterPull2[Progress], client oapi.ClientInterface, wri
 	}
 }
 
+// errorPrefix starts the line the API ends the stream with when the build or deployment fails
+const errorPrefix = "error: "
+
 // upResponseMsg is sent when a line of the build / deploy logs is received
 type upResponseMsg struct {
 	Line    string
@@ -209,6 +243,12 @@ type model struct {
 	selectedEnv *oapi.Env
 	envList     *list.Model
 
+	// selectedCell stays nil if the server picks the cell: the app's current cells, or the env's default, or the team's only cell
+	cells        *getCellsMsg
+	selectedCell *oapi.Cell
+	cellList     *list.Model
+	cellsDone    bool
+
 	upProgress    *progress.Model
 	lastProgress  *Progress
 	upLogsSpinner spinner.Model
@@ -224,8 +264,9 @@ func (m model) Init() tea.Cmd {
 }
 
 const (
-	createNewApp = "+ create a new app"
-	createNewEnv = "+ create a new env"
+	createNewApp     = "+ create a new app"
+	createNewEnv     = "+ create a new env"
+	keepCurrentCells = "+ keep the app on its current cells"
 )
 
 const (
@@ -255,6 +296,8 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.appList.SetSize(msg.Width-h, msg.Height-v)
 		} else if m.envList != nil {
 			m.envList.SetSize(msg.Width-h, msg.Height-v)
+		} else if m.cellList != nil {
+			m.cellList.SetSize(msg.Width-h, msg.Height-v)
 		} else if m.upProgress != nil {
 			m.upProgress.Width = msg.Width - padding*2 - 4
 			if m.upProgress.Width > maxWidth {
@@ -306,6 +349,35 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		envList.Title = "pick which env to deploy into"
 		envList.SetStatusBarItemName("option", "options")
 		m.envList = &envList
+	case getCellsMsg:
+		m.cells = &msg
+		if m.cells.Error != nil {
+			m.exitError = m.cells.Error
+			return m, tea.Quit
+		}
+		if m.flags.cell != "" {
+			cell, ok := lo.Find(m.cells.Cells, func(c oapi.Cell) bool { return c.Name == m.flags.cell || c.Id == m.flags.cell })
+			if !ok {
+				m.exitError = fmt.Errorf("cell %s not found", m.flags.cell)
+				return m, tea.Quit
+			}
+			m.selectedCell = &cell
+			return m.startUp()
+		}
+		// the server only needs to be told where to go if the team has a choice of cells and the env has no default
+		if len(m.cells.Cells) <= 1 || m.selectedEnv.DefaultCellId != "" {
+			return m.startUp()
+		}
+		items := append(cellsToItems(m.cells.Cells), item{
+			title: keepCurrentCells,
+		})
+		dd := list.NewDefaultDelegate()
+		dd.ShowDescription = false
+		dd.SetSpacing(0)
+		cellList := list.New(items, dd, 0, 0)
+		cellList.Title = "pick which cell to build on and deploy to"
+		cellList.SetStatusBarItemName("option", "options")
+		m.cellList = &cellList
 	case upRequestIterMsg:
 		if msg.Error != nil {
 			m.exitError = fmt.Errorf("error uploading directory: %w", msg.Error)
@@ -327,9 +399,15 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case upResponseMsg:
 		if msg.Done {
 			m.upDone = true
-			m.upError = msg.Error
+			if msg.Error != nil {
+				m.upError = msg.Error
+			}
 			return m, tea.Sequence(finalPause(), tea.Quit)
 		}
+		if strings.HasPrefix(msg.Line, errorPrefix) {
+			m.upError = errors.New(strings.TrimPrefix(msg.Line, errorPrefix))
+			return m, streamUpResponse(msg.Scanner)
+		}
 		m.upLogs = append(m.upLogs, msg.Line)
 		return m, streamUpResponse(msg.Scanner)
 	case tea.KeyMsg:
@@ -367,24 +445,22 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				} else {
 					m.selectedEnv = &env
 					m.envList = nil
-					m.upProgress = lo.ToPtr(progress.New(progress.WithGradient(string(style.Secondary), string(style.Primary))))
-					var body bytes.Buffer
-					writer := multipart.NewWriter(&body)
-					if err := writer.WriteField("env_id", m.selectedEnv.Id); err != nil {
-						m.exitError = fmt.Errorf("error writing env_id: %w", err)
-						return m, tea.Quit
-					}
-					if err := writer.WriteField("app_id", m.selectedApp.Id); err != nil {
-						m.exitError = fmt.Errorf("error writing app_id: %w", err)
-						return m, tea.Quit
-					}
-					part, err := writer.CreateFormFile("archive", "archive.tar.gz")
-					if err != nil {
-						m.exitError = fmt.Errorf("error creating form file: %w", err)
+					return m, getCellsCmd(m.apiClient)
+				}
+			} else if m.cellList != nil && m.cellList.SelectedItem() != nil {
+				selected := m.cellList.SelectedItem().(item)
+				if selected.title != keepCurrentCells {
+					if cell, ok := lo.Find(m.cells.Cells, func(cell oapi.Cell) bool {
+						return cell.Id == selected.desc
+					}); !ok {
+						m.exitError = fmt.Errorf("cell %s not found", selected.title)
 						return m, tea.Quit
+					} else {
+						m.selectedCell = &cell
 					}
-					return m, tea.Batch(m.upLogsSpinner.Tick, upRequestCmd(m.args.path, part, m.apiClientRaw, writer, &body))
 				}
+				m.cellList = nil
+				return m.startUp()
 			}
 		}
 	}
@@ -394,10 +470,58 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		*m.appList, cmd = m.appList.Update(msg)
 	} else if m.envList != nil {
 		*m.envList, cmd = m.envList.Update(msg)
+	} else if m.cellList != nil {
+		*m.cellList, cmd = m.cellList.Update(msg)
 	}
 	return m, cmd
 }
 
+// startUp uploads the code once the app, env and cell are picked
+func (m model) startUp() (tea.Model, tea.Cmd) {
+	m.cellsDone = true
+	m.upProgress = lo.ToPtr(progress.New(progress.WithGradient(string(style.Secondary), string(style.Primary))))
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	if err := writer.WriteField("env_id", m.selectedEnv.Id); err != nil {
+		m.exitError = fmt.Errorf("error writing env_id: %w", err)
+		return m, tea.Quit
+	}
+	if err := writer.WriteField("app_id", m.selectedApp.Id); err != nil {
+		m.exitError = fmt.Errorf("error writing app_id: %w", err)
+		return m, tea.Quit
+	}
+	if m.flags.canary != "" {
+		if err := writer.WriteField("canary_steps", m.flags.canary); err != nil {
+			m.exitError = fmt.Errorf("error writing canary_steps: %w", err)
+			return m, tea.Quit
+		}
+	}
+	if m.selectedCell != nil {
+		if err := writer.WriteField("cell_id", m.selectedCell.Id); err != nil {
+			m.exitError = fmt.Errorf("error writing cell_id: %w", err)
+			return m, tea.Quit
+		}
+	}
+	if m.flags.preview != "" {
+		if err := writer.WriteField("preview_branch", m.flags.preview); err != nil {
+			m.exitError = fmt.Errorf("error writing preview_branch: %w", err)
+			return m, tea.Quit
+		}
+		if m.flags.previewTTL > 0 {
+			if err := writer.WriteField("preview_idle_ttl_seconds", strconv.Itoa(int(m.flags.previewTTL.Seconds()))); err != nil {
+				m.exitError = fmt.Errorf("error writing preview_idle_ttl_seconds: %w", err)
+				return m, tea.Quit
+			}
+		}
+	}
+	part, err := writer.CreateFormFile("archive", "archive.tar.gz")
+	if err != nil {
+		m.exitError = fmt.Errorf("error creating form file: %w", err)
+		return m, tea.Quit
+	}
+	return m, tea.Batch(m.upLogsSpinner.Tick, upRequestCmd(m.args.path, part, m.apiClientRaw, writer, &body))
+}
+
 func (m model) View() string {
 	renderLoading := func(msg string, args ...any) string {
 		return fmt.Sprintf("\n %s %s\n\n", m.loading.View(), textStyle.Render(fmt.Sprintf(msg, args...)))
@@ -455,6 +579,22 @@ func (m model) View() string {
 		return lipgloss.JoinVertical(lipgloss.Left, appSelection, envSelection)
 	}
 
+	// teams with several cells pick one, unless the env has a default
+	var cellSelection string
+	if m.cells == nil {
+		cellSelection = renderLoading("getting list of cells...")
+	} else if m.selectedCell != nil {
+		cellSelection = textStyle.Render(fmt.Sprintf("✅ cell selected: %s", m.selectedCell.Name))
+	} else if m.cellList != nil {
+		m.cellList.SetSize(m.width, m.height-lipgloss.Height(appSelection)-lipgloss.Height(envSelection))
+		cellSelection = m.cellList.View()
+	}
+
+	// don't continue unless cell is settled
+	if !m.cellsDone {
+		return lipgloss.JoinVertical(lipgloss.Left, appSelection, envSelection, cellSelection)
+	}
+
 	// we have an app and env selected, time to upload the archive
 	var upResult string
 	if m.upProgress == nil {
@@ -493,32 +633,42 @@ func (m model) View() string {
 		if m.upDone {
 			if m.upError != nil {
 				upLogs += textStyle.Render(fmt.Sprintf("❌ deploy failed!\n\n%s\n", m.upError))
+			} else if m.flags.canary != "" {
+				upLogs += textStyle.Render("✅ canary released! promote or abort it with metal canary\n")
 			} else {
 				upLogs += textStyle.Render("✅ deploy completed!\n")
 			}
 		}
 	}
 
-	return lipgloss.JoinVertical(lipgloss.Left, appSelection, envSelection, upResult, upLogs)
+	return lipgloss.JoinVertical(lipgloss.Left, appSelection, envSelection, cellSelection, upResult, upLogs)
 }
 
 func NewCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "up [path]",
 		Short:   "Launch an application. Defaults to launching the application code in the current directory.",
-		Example: "metal up .",
+		Example: "  metal up .\n  metal up . -a web -e staging --preview feature/login-page --preview-ttl 24h",
 		PreRun:  common.CheckToken,
 		Run:     runUp,
 		Args:    cobra.MaximumNArgs(1),
 	}
 	cmd.Flags().StringP("app", "a", "", "Specifies the app name to deploy. If not specified, will prompt interactively")
 	cmd.Flags().StringP("env", "e", "", "Environment name to deploy into. If not specified, will prompt interactively")
+	cmd.Flags().String("canary", "", `Release as a canary that gets these comma-separated percentages of traffic in turn, e.g. "10,50". Promote or abort it with metal canary`)
+	cmd.Flags().String("cell", "", "Name of the cell to build on and deploy to, moving the app there if it runs elsewhere. If not specified, the app stays on its current cells, or goes to the env's default cell")
+	cmd.Flags().String("preview", "", "Deploy to a temporary preview environment for this branch instead, with its own hostname. The first deployment to it starts out with the app's settings and env vars in the environment given with --env")
+	cmd.Flags().Duration("preview-ttl", 0, "How long the preview environment may go without a deployment before it is torn down, between 10m and 720h. Defaults to 48h")
 	return cmd
 }
 
 type flags struct {
-	app string
-	env string
+	app        string
+	env        string
+	canary     string
+	cell       string
+	preview    string
+	previewTTL time.Duration
 }
 
 type args struct {
@@ -549,10 +699,15 @@ func runUp(cmd *cobra.Command, argss []string) {
 		os.Exit(1)
 	}
 
+	previewTTL, _ := cmd.Flags().GetDuration("preview-ttl")
 	p = tea.NewProgram(model{
 		flags: flags{
-			app: cmd.Flags().Lookup("app").Value.String(),
-			env: cmd.Flags().Lookup("env").Value.String(),
+			app:        cmd.Flags().Lookup("app").Value.String(),
+			env:        cmd.Flags().Lookup("env").Value.String(),
+			canary:     cmd.Flags().Lookup("canary").Value.String(),
+			cell:       cmd.Flags().Lookup("cell").Value.String(),
+			preview:    cmd.Flags().Lookup("preview").Value.String(),
+			previewTTL: previewTTL,
 		},
 		args: args{
 			path: path,
@@ -568,4 +723,7 @@ func runUp(cmd *cobra.Command, argss []string) {
 		os.Exit(1)
 	}
 	fmt.Println(finalModel.View())
+	if m := finalModel.(model); m.exitError != nil || m.upError != nil {
+		os.Exit(1)
+	}
 }
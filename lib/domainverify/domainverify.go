@@ -0,0 +1,54 @@
+// package domainverify checks that whoever adds a custom domain controls its DNS
+package domainverify
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// Resolver looks up the DNS records verification relies on. *net.Resolver implements it, tests can fake it.
+type Resolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+	LookupCNAME(ctx context.Context, host string) (string, error)
+}
+
+// TXTRecordName is where the verification TXT record for a hostname goes
+func TXTRecordName(hostname string) string {
+	return fmt.Sprintf("_onmetal-verification.%s", hostname)
+}
+
+// TXTRecordValue is the content the verification TXT record must have
+func TXTRecordValue(token string) string {
+	return fmt.Sprintf("onmetal-verification=%s", token)
+}
+
+// Verify succeeds if the hostname has a TXT record with the verification token, or is a CNAME to one of cnameTargets.
+// The error says what was found instead, so that it can be shown to whoever is setting up the records.
+func Verify(ctx context.Context, resolver Resolver, hostname, token string, cnameTargets []string) error {
+	txts, txtErr := resolver.LookupTXT(ctx, TXTRecordName(hostname))
+	if txtErr == nil && lo.Contains(txts, TXTRecordValue(token)) {
+		return nil
+	}
+	cname, cnameErr := resolver.LookupCNAME(ctx, hostname)
+	cname = strings.ToLower(strings.TrimSuffix(cname, "."))
+	if cnameErr == nil && lo.ContainsBy(cnameTargets, func(target string) bool { return strings.EqualFold(target, cname) }) {
+		return nil
+	}
+
+	var found []string
+	if txtErr != nil {
+		found = append(found, fmt.Sprintf("no TXT record at %s", TXTRecordName(hostname)))
+	} else {
+		found = append(found, fmt.Sprintf("TXT records at %s were %q", TXTRecordName(hostname), txts))
+	}
+	if cnameErr != nil || cname == "" || cname == strings.ToLower(hostname) {
+		found = append(found, fmt.Sprintf("no CNAME at %s", hostname))
+	} else {
+		found = append(found, fmt.Sprintf("%s is a CNAME to %s", hostname, cname))
+	}
+	return fmt.Errorf("add a TXT record at %s with value %s, or a CNAME to %s. found %s",
+		TXTRecordName(hostname), TXTRecordValue(token), strings.Join(cnameTargets, " or "), strings.Join(found, " and "))
+}
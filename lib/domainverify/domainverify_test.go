@@ -0,0 +1,84 @@
+package domainverify
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeResolver struct {
+	txts   map[string][]string
+	cnames map[string]string
+}
+
+func (r fakeResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	txts, ok := r.txts[name]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return txts, nil
+}
+
+func (r fakeResolver) LookupCNAME(ctx context.Context, host string) (string, error) {
+	cname, ok := r.cnames[host]
+	if !ok {
+		return "", errors.New("no such host")
+	}
+	return cname, nil
+}
+
+func TestVerify(t *testing.T) {
+	const hostname = "www.example.com"
+	const token = "abc123"
+	targets := []string{"myapp-production.cell-1.up.onmetal.run"}
+
+	testCases := []struct {
+		name     string
+		resolver fakeResolver
+		errMsg   string
+	}{
+		{
+			name:     "txt record",
+			resolver: fakeResolver{txts: map[string][]string{"_onmetal-verification.www.example.com": {"something-else", "onmetal-verification=abc123"}}},
+		},
+		{
+			name:     "cname",
+			resolver: fakeResolver{cnames: map[string]string{hostname: "MyApp-Production.cell-1.up.onmetal.run."}},
+		},
+		{
+			name:     "nothing",
+			resolver: fakeResolver{},
+			errMsg:   "found no TXT record at _onmetal-verification.www.example.com and no CNAME at www.example.com",
+		},
+		{
+			name:     "wrong txt record",
+			resolver: fakeResolver{txts: map[string][]string{"_onmetal-verification.www.example.com": {"onmetal-verification=other"}}},
+			errMsg:   `TXT records at _onmetal-verification.www.example.com were ["onmetal-verification=other"]`,
+		},
+		{
+			name:     "cname elsewhere",
+			resolver: fakeResolver{cnames: map[string]string{hostname: "example.herokudns.com."}},
+			errMsg:   "www.example.com is a CNAME to example.herokudns.com",
+		},
+		{
+			name:     "no cname",
+			resolver: fakeResolver{cnames: map[string]string{hostname: "www.example.com."}},
+			errMsg:   "no CNAME at www.example.com",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Verify(context.Background(), tc.resolver, hostname, token, targets)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "add a TXT record at _onmetal-verification.www.example.com with value onmetal-verification=abc123, or a CNAME to myapp-production.cell-1.up.onmetal.run")
+			assert.Contains(t, err.Error(), tc.errMsg)
+		})
+	}
+}
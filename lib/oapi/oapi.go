@@ -1,6 +1,6 @@
 // Package oapi provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.3.0 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.0 DO NOT EDIT.
 package oapi
 
 import (
@@ -29,6 +29,83 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for CronJobConcurrencyPolicy.
+const (
+	CronJobConcurrencyPolicyAllow   CronJobConcurrencyPolicy = "allow"
+	CronJobConcurrencyPolicyForbid  CronJobConcurrencyPolicy = "forbid"
+	CronJobConcurrencyPolicyReplace CronJobConcurrencyPolicy = "replace"
+)
+
+// Defines values for CronJobRunStatus.
+const (
+	CronJobRunStatusFailed    CronJobRunStatus = "failed"
+	CronJobRunStatusRunning   CronJobRunStatus = "running"
+	CronJobRunStatusSucceeded CronJobRunStatus = "succeeded"
+)
+
+// Defines values for DeploymentStatus.
+const (
+	DeploymentStatusAborting        DeploymentStatus = "aborting"
+	DeploymentStatusCanary          DeploymentStatus = "canary"
+	DeploymentStatusCanceled        DeploymentStatus = "canceled"
+	DeploymentStatusCanceling       DeploymentStatus = "canceling"
+	DeploymentStatusDeploying       DeploymentStatus = "deploying"
+	DeploymentStatusFailed          DeploymentStatus = "failed"
+	DeploymentStatusPending         DeploymentStatus = "pending"
+	DeploymentStatusPendingApproval DeploymentStatus = "pending-approval"
+	DeploymentStatusPromoting       DeploymentStatus = "promoting"
+	DeploymentStatusRejected        DeploymentStatus = "rejected"
+	DeploymentStatusReleasing       DeploymentStatus = "releasing"
+	DeploymentStatusRunning         DeploymentStatus = "running"
+	DeploymentStatusStopped         DeploymentStatus = "stopped"
+)
+
+// Defines values for DeploymentType.
+const (
+	DeploymentTypeDeploy   DeploymentType = "deploy"
+	DeploymentTypeRestart  DeploymentType = "restart"
+	DeploymentTypeRollback DeploymentType = "rollback"
+	DeploymentTypeScale    DeploymentType = "scale"
+)
+
+// Defines values for DomainCertificateStatus.
+const (
+	DomainCertificateStatusFailed  DomainCertificateStatus = "failed"
+	DomainCertificateStatusIssued  DomainCertificateStatus = "issued"
+	DomainCertificateStatusIssuing DomainCertificateStatus = "issuing"
+	DomainCertificateStatusPending DomainCertificateStatus = "pending"
+)
+
+// Defines values for DomainVerificationStatus.
+const (
+	DomainVerificationStatusFailed   DomainVerificationStatus = "failed"
+	DomainVerificationStatusPending  DomainVerificationStatus = "pending"
+	DomainVerificationStatusVerified DomainVerificationStatus = "verified"
+)
+
+// Defines values for ExternalPortProto.
+const (
+	ExternalPortProtoGrpc  ExternalPortProto = "grpc"
+	ExternalPortProtoHttp  ExternalPortProto = "http"
+	ExternalPortProtoHttps ExternalPortProto = "https"
+	ExternalPortProtoTcp   ExternalPortProto = "tcp"
+)
+
+// Defines values for PlannedObjectAction.
+const (
+	PlannedObjectActionCreate    PlannedObjectAction = "create"
+	PlannedObjectActionDelete    PlannedObjectAction = "delete"
+	PlannedObjectActionUnchanged PlannedObjectAction = "unchanged"
+	PlannedObjectActionUpdate    PlannedObjectAction = "update"
+)
+
+// Defines values for PortProto.
+const (
+	PortProtoGrpc PortProto = "grpc"
+	PortProtoHttp PortProto = "http"
+	PortProtoTcp  PortProto = "tcp"
+)
+
 // App defines model for App.
 type App struct {
 	CreatedAt time.Time `json:"created_at"`
@@ -48,16 +125,260 @@ type App struct {
 // Apps defines model for Apps.
 type Apps = []App
 
+// Autoscaling Lets the cell scale a process between min_replicas and max_replicas to keep its average utilization near the targets. Utilization is relative to the resources the process requests. At least one target is required.
+type Autoscaling struct {
+	MaxReplicas int `json:"max_replicas"`
+	MinReplicas int `json:"min_replicas"`
+
+	// TargetCpuUtilizationPercent Average CPU utilization to aim for, e.g. 70. Omit or use 0 to not scale on CPU
+	TargetCpuUtilizationPercent *int `json:"target_cpu_utilization_percent,omitempty"`
+
+	// TargetMemoryUtilizationPercent Average memory utilization to aim for. Omit or use 0 to not scale on memory
+	TargetMemoryUtilizationPercent *int `json:"target_memory_utilization_percent,omitempty"`
+}
+
+// Cell A group of servers that apps are deployed to
+type Cell struct {
+	// Id A string with a prefix, underscore, and 26 alphanumeric characters (type ID)
+	Id   Id     `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// CellStatus defines model for CellStatus.
+type CellStatus struct {
+	// CellId A string with a prefix, underscore, and 26 alphanumeric characters (type ID)
+	CellId       Id               `json:"cell_id"`
+	Status       DeploymentStatus `json:"status"`
+	StatusReason string           `json:"status_reason"`
+}
+
+// Cells defines model for Cells.
+type Cells = []Cell
+
+// CronJob defines model for CronJob.
+type CronJob struct {
+	// AppId A string with a prefix, underscore, and 26 alphanumeric characters (type ID)
+	AppId Id `json:"app_id"`
+
+	// Command Command to run with /bin/sh -c
+	Command string `json:"command"`
+
+	// ConcurrencyPolicy What to do when a run is due while the previous run is still going. allow runs them side by side, forbid skips the new run, replace stops the previous run
+	ConcurrencyPolicy CronJobConcurrencyPolicy `json:"concurrency_policy"`
+	CreatedAt         time.Time                `json:"created_at"`
+
+	// EnvId A string with a prefix, underscore, and 26 alphanumeric characters (type ID)
+	EnvId Id `json:"env_id"`
+
+	// Id A string with a prefix, underscore, and 26 alphanumeric characters (type ID)
+	Id Id `json:"id"`
+
+	// Name A string with only lowercase alphanumeric characters and hyphens
+	Name LowercaseAlphaNumHyphen `json:"name"`
+
+	// Schedule Five-field cron schedule, e.g. "0 3 * * *"
+	Schedule string `json:"schedule"`
+
+	// Timezone IANA time zone of the schedule, e.g. America/New_York
+	Timezone  string    `json:"timezone"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CronJobConcurrencyPolicy What to do when a run is due while the previous run is still going. allow runs them side by side, forbid skips the new run, replace stops the previous run
+type CronJobConcurrencyPolicy string
+
+// CronJobRun defines model for CronJobRun.
+type CronJobRun struct {
+	CompletedAt  *time.Time       `json:"completed_at,omitempty"`
+	Name         string           `json:"name"`
+	StartedAt    time.Time        `json:"started_at"`
+	Status       CronJobRunStatus `json:"status"`
+	StatusReason string           `json:"status_reason"`
+}
+
+// CronJobRunStatus defines model for CronJobRun.Status.
+type CronJobRunStatus string
+
+// CronJobRuns defines model for CronJobRuns.
+type CronJobRuns = []CronJobRun
+
+// CronJobs defines model for CronJobs.
+type CronJobs = []CronJob
+
+// Deployment defines model for Deployment.
+type Deployment struct {
+	// AppId A string with a prefix, underscore, and 26 alphanumeric characters (type ID)
+	AppId Id `json:"app_id"`
+
+	// CanarySteps Percentages of traffic a canary goes through before it is rolled out fully. Omitted for regular deployments
+	CanarySteps *[]int `json:"canary_steps,omitempty"`
+
+	// CanaryWeight Percentage of traffic a canary currently receives
+	CanaryWeight *int `json:"canary_weight,omitempty"`
+
+	// Cells Status of the deployment on each of the cells it is deployed to. Its status is rolled up from them
+	Cells     *[]CellStatus `json:"cells,omitempty"`
+	CreatedAt time.Time     `json:"created_at"`
+
+	// EnvId A string with a prefix, underscore, and 26 alphanumeric characters (type ID)
+	EnvId Id `json:"env_id"`
+
+	// Id Monotonic id of the deployment within an app/env combination
+	Id       int `json:"id"`
+	Replicas int `json:"replicas"`
+
+	// Review The approval or rejection of a deployment that waited for approval in a protected environment
+	Review *DeploymentReview `json:"review,omitempty"`
+
+	// RollbackOf Id of the failed deployment this deployment rolls back automatically. Omitted for deployments that weren't created by an automatic rollback
+	RollbackOf   *int             `json:"rollback_of,omitempty"`
+	Status       DeploymentStatus `json:"status"`
+	StatusReason string           `json:"status_reason"`
+
+	// TeamId A string with a prefix, underscore, and 26 alphanumeric characters (type ID)
+	TeamId    Id             `json:"team_id"`
+	Type      DeploymentType `json:"type"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// DeploymentChange A setting that differs between the latest deployment and the planned one. from and to are omitted if the setting isn't set on that side. Env var values are always masked.
+type DeploymentChange struct {
+	// Field e.g. image, replicas, processes or env.DATABASE_URL
+	Field string  `json:"field"`
+	From  *string `json:"from,omitempty"`
+	To    *string `json:"to,omitempty"`
+}
+
+// DeploymentDiff defines model for DeploymentDiff.
+type DeploymentDiff struct {
+	Changes []DeploymentChange `json:"changes"`
+	Objects []PlannedObject    `json:"objects"`
+}
+
+// DeploymentEvent A transition of a deployment's status
+type DeploymentEvent struct {
+	// Actor Who caused the transition. "metal" for transitions metal made itself, otherwise the email of a user or the name of an API token
+	Actor        string    `json:"actor"`
+	CreatedAt    time.Time `json:"created_at"`
+	DeploymentId int       `json:"deployment_id"`
+
+	// K8sEvents Kubernetes events that explain the transition, e.g. why the pods of a failed deployment didn't start
+	K8sEvents *[]K8sEvent      `json:"k8s_events,omitempty"`
+	Reason    string           `json:"reason"`
+	Status    DeploymentStatus `json:"status"`
+}
+
+// DeploymentReview The approval or rejection of a deployment that waited for approval in a protected environment
+type DeploymentReview struct {
+	Approved   bool      `json:"approved"`
+	Comment    string    `json:"comment"`
+	ReviewedAt time.Time `json:"reviewed_at"`
+
+	// Reviewer Email of the team admin who approved or rejected the deployment
+	Reviewer string `json:"reviewer"`
+}
+
+// DeploymentStatus defines model for DeploymentStatus.
+type DeploymentStatus string
+
+// DeploymentType defines model for DeploymentType.
+type DeploymentType string
+
+// Domain A custom hostname for an app in an env. It routes to the app once verified, with a certificate issued for it
+type Domain struct {
+	// AppId A string with a prefix, underscore, and 26 alphanumeric characters (type ID)
+	AppId       Id                `json:"app_id"`
+	Certificate DomainCertificate `json:"certificate"`
+	CreatedAt   time.Time         `json:"created_at"`
+
+	// EnvId A string with a prefix, underscore, and 26 alphanumeric characters (type ID)
+	EnvId    Id     `json:"env_id"`
+	Hostname string `json:"hostname"`
+
+	// Id A string with a prefix, underscore, and 26 alphanumeric characters (type ID)
+	Id           Id                 `json:"id"`
+	Verification DomainVerification `json:"verification"`
+}
+
+// DomainCertificate defines model for DomainCertificate.
+type DomainCertificate struct {
+	ExpiresAt    *time.Time              `json:"expires_at,omitempty"`
+	Status       DomainCertificateStatus `json:"status"`
+	StatusReason string                  `json:"status_reason"`
+}
+
+// DomainCertificateStatus defines model for DomainCertificate.Status.
+type DomainCertificateStatus string
+
+// DomainVerification defines model for DomainVerification.
+type DomainVerification struct {
+	// CnameTargets Alternatively the domain is verified by being a CNAME to one of these
+	CnameTargets []string                 `json:"cname_targets"`
+	Status       DomainVerificationStatus `json:"status"`
+	StatusReason string                   `json:"status_reason"`
+
+	// TxtRecordName Where to add a TXT record to verify the domain
+	TxtRecordName string `json:"txt_record_name"`
+
+	// TxtRecordValue Value of the TXT record that verifies the domain
+	TxtRecordValue string     `json:"txt_record_value"`
+	VerifiedAt     *time.Time `json:"verified_at,omitempty"`
+}
+
+// DomainVerificationStatus defines model for DomainVerification.Status.
+type DomainVerificationStatus string
+
+// Domains defines model for Domains.
+type Domains = []Domain
+
 // Env defines model for Env.
 type Env struct {
-	CreatedAt time.Time `json:"created_at"`
+	// AutoRollback Whether a failed deployment is rolled back to the deployment that was running before it
+	AutoRollback bool      `json:"auto_rollback"`
+	CreatedAt    time.Time `json:"created_at"`
+
+	// DefaultCellId Cell that apps are built on and deployed to the first time they are deployed to the environment. Empty if the environment has no default
+	DefaultCellId string         `json:"default_cell_id"`
+	FreezeWindows []FreezeWindow `json:"freeze_windows"`
 
 	// Id A string with a prefix, underscore, and 26 alphanumeric characters (type ID)
-	Id        Id        `json:"id"`
-	Name      string    `json:"name"`
+	Id Id `json:"id"`
+
+	// Lock Who locked an environment and why. Deployments to a locked environment are rejected unless an admin overrides the lock
+	Lock *EnvLock `json:"lock,omitempty"`
+	Name string   `json:"name"`
+
+	// Preview The branch a preview environment was created for with metal up --preview. Preview environments are torn down once they go idle_ttl_seconds without a deployment
+	Preview *EnvPreview `json:"preview,omitempty"`
+
+	// ProgressDeadlineSeconds Seconds a deployment may go without progress before it fails. 0 means the Kubernetes default of 600
+	ProgressDeadlineSeconds int `json:"progress_deadline_seconds"`
+
+	// Protected Whether new deployments wait in pending-approval until a team admin other than whoever asked for them approves them
+	Protected bool      `json:"protected"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// EnvLock Who locked an environment and why. Deployments to a locked environment are rejected unless an admin overrides the lock
+type EnvLock struct {
+	LockedAt time.Time `json:"locked_at"`
+	LockedBy string    `json:"locked_by"`
+	Reason   string    `json:"reason"`
+}
+
+// EnvPreview The branch a preview environment was created for with metal up --preview. Preview environments are torn down once they go idle_ttl_seconds without a deployment
+type EnvPreview struct {
+	Branch         string `json:"branch"`
+	IdleTtlSeconds int    `json:"idle_ttl_seconds"`
+}
+
+// EnvVar defines model for EnvVar.
+type EnvVar struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
 // Envs defines model for Envs.
 type Envs = []Env
 
@@ -67,9 +388,171 @@ type Error struct {
 	Error string  `json:"error"`
 }
 
+// ExternalPort Exposes a container port outside the cell. http and https ports are served on the app's hostname, and so are grpc ports, over TLS on 443. tcp ports are exposed on port of an address the cell assigns, which the dashboard shows once it is assigned.
+type ExternalPort struct {
+	// Name A string with only lowercase alphanumeric characters and hyphens
+	Name LowercaseAlphaNumHyphen `json:"name"`
+
+	// Port Port to expose it on. tcp ports can't use 80 or 443
+	Port int `json:"port"`
+
+	// PortName Name of the container port to expose. http and https need an http port, tcp a tcp port and grpc a grpc port
+	PortName string            `json:"port_name"`
+	Proto    ExternalPortProto `json:"proto"`
+}
+
+// ExternalPortProto defines model for ExternalPort.Proto.
+type ExternalPortProto string
+
+// FreezeWindow A weekly window during which deployments to an environment are rejected, as if it were locked
+type FreezeWindow struct {
+	// End Day of the week and time of day the window ends, e.g. "Mon 09:00"
+	End    string  `json:"end"`
+	Reason *string `json:"reason,omitempty"`
+
+	// Start Day of the week and time of day the window starts, e.g. "Fri 16:00"
+	Start string `json:"start"`
+
+	// Timezone IANA time zone that start and end are in, e.g. Europe/Berlin. Defaults to UTC
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// HealthCheck Check used for both the readiness and liveness probes of an app's containers. It runs command if one is set. Otherwise it sends GET requests to path on http ports and opens connections on tcp ports
+type HealthCheck struct {
+	// Command Command to run with /bin/sh -c in the container instead. The check passes if it exits 0
+	Command *string `json:"command,omitempty"`
+
+	// FailureThreshold Consecutive failures before a container is considered unhealthy. Defaults to 3
+	FailureThreshold *int `json:"failure_threshold,omitempty"`
+
+	// InitialDelaySeconds Seconds to wait after a container starts before checking it
+	InitialDelaySeconds *int `json:"initial_delay_seconds,omitempty"`
+
+	// Path Path to send GET requests to, e.g. /healthz. Only for http ports
+	Path *string `json:"path,omitempty"`
+
+	// PeriodSeconds Seconds between checks. Defaults to 10
+	PeriodSeconds *int `json:"period_seconds,omitempty"`
+
+	// PortName Name of the container port to check. It also picks the process that is checked
+	PortName string `json:"port_name"`
+}
+
 // Id A string with a prefix, underscore, and 26 alphanumeric characters (type ID)
 type Id = string
 
+// K8sEvent A Kubernetes event about one of a deployment's objects
+type K8sEvent struct {
+	Count    int       `json:"count"`
+	LastSeen time.Time `json:"last_seen"`
+	Message  string    `json:"message"`
+
+	// Object Kind and name of the object the event is about, e.g. Pod/myapp-web-5d9f-x2x7q
+	Object string `json:"object"`
+
+	// Reason e.g. BackOff or FailedScheduling
+	Reason string `json:"reason"`
+
+	// Type Normal or Warning
+	Type string `json:"type"`
+}
+
+// LogEntries defines model for LogEntries.
+type LogEntries = []LogEntry
+
+// LogEntry defines model for LogEntry.
+type LogEntry struct {
+	Message string    `json:"message"`
+	Time    time.Time `json:"time"`
+}
+
+// LowercaseAlphaNumHyphen A string with only lowercase alphanumeric characters and hyphens
+type LowercaseAlphaNumHyphen = string
+
+// PlannedObject What deploying would do to one of the Kubernetes objects the cell runs the app with
+type PlannedObject struct {
+	Action PlannedObjectAction `json:"action"`
+
+	// Diff Unified diff of the object's YAML, from what the cell has to what it would have. Env var values are masked. Empty if unchanged
+	Diff *string `json:"diff,omitempty"`
+
+	// Kind e.g. Deployment, Service or HTTPRoute
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// PlannedObjectAction defines model for PlannedObject.Action.
+type PlannedObjectAction string
+
+// Port A container port
+type Port struct {
+	// Name A string with only lowercase alphanumeric characters and hyphens
+	Name LowercaseAlphaNumHyphen `json:"name"`
+	Port int                     `json:"port"`
+
+	// Proto What the container speaks on the port. grpc is cleartext HTTP/2, tcp is anything else
+	Proto PortProto `json:"proto"`
+}
+
+// PortProto What the container speaks on the port. grpc is cleartext HTTP/2, tcp is anything else
+type PortProto string
+
+// Process A Procfile-style process type, e.g. web or worker. All of an app's processes run the same image with the same env vars.
+type Process struct {
+	// Autoscaling Lets the cell scale a process between min_replicas and max_replicas to keep its average utilization near the targets. Utilization is relative to the resources the process requests. At least one target is required.
+	Autoscaling *Autoscaling `json:"autoscaling,omitempty"`
+
+	// Command Command to run with /bin/sh -c. Omit to run the image's default entrypoint
+	Command *string `json:"command,omitempty"`
+
+	// Name A string with only lowercase alphanumeric characters and hyphens
+	Name LowercaseAlphaNumHyphen `json:"name"`
+
+	// Ports Container ports. Only processes with ports are exposed with a service and HTTP routes
+	Ports     *[]Port   `json:"ports,omitempty"`
+	Replicas  int       `json:"replicas"`
+	Resources Resources `json:"resources"`
+}
+
+// RedeployError defines model for RedeployError.
+type RedeployError struct {
+	AppId   string `json:"app_id"`
+	AppName string `json:"app_name"`
+	Error   string `json:"error"`
+}
+
+// ResourceAmounts defines model for ResourceAmounts.
+type ResourceAmounts struct {
+	CpuCores  float64 `json:"cpu_cores"`
+	MemoryMib int     `json:"memory_mib"`
+}
+
+// Resources defines model for Resources.
+type Resources struct {
+	Limits   ResourceAmounts `json:"limits"`
+	Requests ResourceAmounts `json:"requests"`
+}
+
+// ReviewDeploymentRequest defines model for ReviewDeploymentRequest.
+type ReviewDeploymentRequest struct {
+	// Comment Why the deployment is approved or rejected, recorded on the deployment
+	Comment *string `json:"comment,omitempty"`
+
+	// DeploymentId Deployment to review. Defaults to the latest one pending approval
+	DeploymentId *int `json:"deployment_id,omitempty"`
+}
+
+// SharedEnvVars Env vars shared by all apps in an environment, e.g. SENTRY_DSN. Apps get them next to their own env vars, which win if both set the same name. Values are never returned
+type SharedEnvVars struct {
+	Names []string `json:"names"`
+
+	// RedeployErrors Apps that couldn't be redeployed. The new env vars are saved either way, so they reach these apps on their next deployment
+	RedeployErrors *[]RedeployError `json:"redeploy_errors,omitempty"`
+
+	// Redeployed Deployments created to roll the changed env vars out to the apps they affect
+	Redeployed *[]Deployment `json:"redeployed,omitempty"`
+}
+
 // UpLog defines model for UpLog.
 type UpLog struct {
 	// Message Content of the log.
@@ -79,6 +562,21 @@ type UpLog struct {
 	Time *time.Time `json:"time,omitempty"`
 }
 
+// Volume Persistent storage mounted into one of an app's processes. Volumes are ReadWriteOnce, so the process can only run a single replica. Their data survives redeploys and is only deleted along with the app.
+type Volume struct {
+	// MountPath Absolute path to mount the volume at, e.g. /data
+	MountPath string `json:"mount_path"`
+
+	// Name A string with only lowercase alphanumeric characters and hyphens
+	Name LowercaseAlphaNumHyphen `json:"name"`
+
+	// Process Process to mount the volume into. Defaults to web
+	Process *string `json:"process,omitempty"`
+
+	// SizeGib Size in GiB. Volumes can grow but not shrink
+	SizeGib int `json:"size_gib"`
+}
+
 // WhoAmI defines model for WhoAmI.
 type WhoAmI struct {
 	CreatedAt time.Time `json:"created_at"`
@@ -100,52 +598,315 @@ type InternalServerError = Error
 // NotFound defines model for NotFound.
 type NotFound = Error
 
+// DeleteAppParams defines parameters for DeleteApp.
+type DeleteAppParams struct {
+	// DeleteVolumes Confirms that the data in the app's volumes should be deleted along with it. Required if the app has volumes
+	DeleteVolumes *bool `form:"delete_volumes,omitempty" json:"delete_volumes,omitempty"`
+}
+
 // CreateAppJSONBody defines parameters for CreateApp.
 type CreateAppJSONBody struct {
 	Name string `json:"name"`
 }
 
+// UpdateAutoscalingJSONBody defines parameters for UpdateAutoscaling.
+type UpdateAutoscalingJSONBody struct {
+	// Autoscaling Lets the cell scale a process between min_replicas and max_replicas to keep its average utilization near the targets. Utilization is relative to the resources the process requests. At least one target is required.
+	Autoscaling *Autoscaling `json:"autoscaling,omitempty"`
+
+	// Process Process to autoscale. Required if the app defines its own processes
+	Process *string `json:"process,omitempty"`
+}
+
+// PromoteCanaryJSONBody defines parameters for PromoteCanary.
+type PromoteCanaryJSONBody struct {
+	// Full Skip the remaining steps and roll the canary out fully
+	Full *bool `json:"full,omitempty"`
+}
+
+// CancelDeploymentJSONBody defines parameters for CancelDeployment.
+type CancelDeploymentJSONBody struct {
+	// DeploymentId Deployment to cancel. Defaults to the latest one that is still in progress.
+	DeploymentId *int `json:"deployment_id,omitempty"`
+}
+
+// UpdateCellsJSONBody defines parameters for UpdateCells.
+type UpdateCellsJSONBody struct {
+	// CellIds Ids of cells of the team
+	CellIds []Id `json:"cell_ids"`
+}
+
+// CreateCronJobJSONBody defines parameters for CreateCronJob.
+type CreateCronJobJSONBody struct {
+	// Command Command to run with /bin/sh -c
+	Command string `json:"command"`
+
+	// ConcurrencyPolicy What to do when a run is due while the previous run is still going. allow runs them side by side, forbid skips the new run, replace stops the previous run
+	ConcurrencyPolicy *CronJobConcurrencyPolicy `json:"concurrency_policy,omitempty"`
+
+	// Name A string with only lowercase alphanumeric characters and hyphens
+	Name LowercaseAlphaNumHyphen `json:"name"`
+
+	// Schedule Five-field cron schedule, e.g. "0 3 * * *"
+	Schedule string `json:"schedule"`
+
+	// Timezone IANA time zone of the schedule. Defaults to UTC
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// UpdateDependenciesJSONBody defines parameters for UpdateDependencies.
+type UpdateDependenciesJSONBody struct {
+	// Dependencies Names of other apps of the team
+	Dependencies []LowercaseAlphaNumHyphen `json:"dependencies"`
+}
+
+// DeployImageJSONBody defines parameters for DeployImage.
+type DeployImageJSONBody struct {
+	// CanarySteps Comma-separated percentages of traffic to release the image to as a canary, e.g. "10,50". Omit to deploy normally
+	CanarySteps *string `json:"canary_steps,omitempty"`
+
+	// CellId A string with a prefix, underscore, and 26 alphanumeric characters (type ID)
+	CellId *Id `json:"cell_id,omitempty"`
+
+	// Image Image to deploy, e.g. ghcr.io/acme/api:v1.2 or ghcr.io/acme/api@sha256:... A missing tag means latest
+	Image string `json:"image"`
+}
+
+// DiffDeploymentJSONBody defines parameters for DiffDeployment.
+type DiffDeploymentJSONBody struct {
+	Dependencies  *[]LowercaseAlphaNumHyphen `json:"dependencies,omitempty"`
+	ExternalPorts *[]ExternalPort            `json:"external_ports,omitempty"`
+
+	// HealthCheck Check used for both the readiness and liveness probes of an app's containers. It runs command if one is set. Otherwise it sends GET requests to path on http ports and opens connections on tcp ports
+	HealthCheck *HealthCheck `json:"health_check,omitempty"`
+
+	// Image Image to deploy, e.g. ghcr.io/acme/api:v1.2.3
+	Image          *string    `json:"image,omitempty"`
+	Processes      *[]Process `json:"processes,omitempty"`
+	ReleaseCommand *string    `json:"release_command,omitempty"`
+
+	// Replicas Replicas of apps that don't define their own processes
+	Replicas *int `json:"replicas,omitempty"`
+
+	// SetEnvVars Env vars to add or change
+	SetEnvVars *[]EnvVar `json:"set_env_vars,omitempty"`
+
+	// UnsetEnvVars Names of env vars to remove
+	UnsetEnvVars *[]string `json:"unset_env_vars,omitempty"`
+	Volumes      *[]Volume `json:"volumes,omitempty"`
+}
+
+// CreateDomainJSONBody defines parameters for CreateDomain.
+type CreateDomainJSONBody struct {
+	Hostname string `json:"hostname"`
+}
+
+// UpdateExternalPortsJSONBody defines parameters for UpdateExternalPorts.
+type UpdateExternalPortsJSONBody struct {
+	ExternalPorts []ExternalPort `json:"external_ports"`
+}
+
+// UpdateHealthCheckJSONBody defines parameters for UpdateHealthCheck.
+type UpdateHealthCheckJSONBody struct {
+	// HealthCheck Check used for both the readiness and liveness probes of an app's containers. It runs command if one is set. Otherwise it sends GET requests to path on http ports and opens connections on tcp ports
+	HealthCheck *HealthCheck `json:"health_check,omitempty"`
+}
+
+// UpdateProcessesJSONBody defines parameters for UpdateProcesses.
+type UpdateProcessesJSONBody struct {
+	Processes []Process `json:"processes"`
+}
+
+// PromoteJSONBody defines parameters for Promote.
+type PromoteJSONBody struct {
+	// DeploymentId Id of the deployment in the env to promote from. Defaults to the one running there
+	DeploymentId *int `json:"deployment_id,omitempty"`
+
+	// FromEnvId A string with a prefix, underscore, and 26 alphanumeric characters (type ID)
+	FromEnvId Id `json:"from_env_id"`
+}
+
+// UpdateReleaseCommandJSONBody defines parameters for UpdateReleaseCommand.
+type UpdateReleaseCommandJSONBody struct {
+	// ReleaseCommand Command to run before each rollout, e.g. ./migrate up
+	ReleaseCommand string `json:"release_command"`
+}
+
+// RollbackJSONBody defines parameters for Rollback.
+type RollbackJSONBody struct {
+	// DeploymentId Id of the deployment to roll back to
+	DeploymentId int `json:"deployment_id"`
+}
+
+// ScaleJSONBody defines parameters for Scale.
+type ScaleJSONBody struct {
+	// Process Process to scale. Required if the app defines its own processes
+	Process *string `json:"process,omitempty"`
+
+	// Replicas Number of replicas to run
+	Replicas int `json:"replicas"`
+}
+
+// UpdateVolumesJSONBody defines parameters for UpdateVolumes.
+type UpdateVolumesJSONBody struct {
+	Volumes []Volume `json:"volumes"`
+}
+
+// UpdateEnvJSONBody defines parameters for UpdateEnv.
+type UpdateEnvJSONBody struct {
+	// AutoRollback Roll failed deployments back to the deployment that was running before them
+	AutoRollback *bool `json:"auto_rollback,omitempty"`
+
+	// DefaultCellId Id of a cell of the team that apps are deployed to the first time they are deployed to the environment. An empty string removes the default
+	DefaultCellId *string `json:"default_cell_id,omitempty"`
+
+	// FreezeWindows Weekly windows during which deployments to the environment are rejected. Replaces the current windows. Only team admins can change them
+	FreezeWindows *[]FreezeWindow `json:"freeze_windows,omitempty"`
+
+	// ProgressDeadlineSeconds Seconds a deployment may go without progress before it fails, between 30 and 3600. 0 goes back to the Kubernetes default of 600
+	ProgressDeadlineSeconds *int `json:"progress_deadline_seconds,omitempty"`
+
+	// Protected Hold new deployments in pending-approval until a team admin other than whoever asked for them approves them. Only team admins can change it
+	Protected *bool `json:"protected,omitempty"`
+}
+
 // CreateEnvJSONBody defines parameters for CreateEnv.
 type CreateEnvJSONBody struct {
 	Name string `json:"name"`
 }
 
+// UpdateSharedEnvVarsJSONBody defines parameters for UpdateSharedEnvVars.
+type UpdateSharedEnvVarsJSONBody struct {
+	// Redeploy Redeploy the apps running in the environment that the change affects. Apps that set all of the changed env vars themselves aren't affected
+	Redeploy *bool `json:"redeploy,omitempty"`
+
+	// SetEnvVars Env vars to add or change
+	SetEnvVars *[]EnvVar `json:"set_env_vars,omitempty"`
+
+	// UnsetEnvVars Names of env vars to remove
+	UnsetEnvVars *[]string `json:"unset_env_vars,omitempty"`
+}
+
+// LockEnvJSONBody defines parameters for LockEnv.
+type LockEnvJSONBody struct {
+	// Reason Why the environment is locked, e.g. "incident 42" or "holidays"
+	Reason *string `json:"reason,omitempty"`
+}
+
 // UpMultipartBody defines parameters for Up.
 type UpMultipartBody struct {
 	// AppId A string with a prefix, underscore, and 26 alphanumeric characters (type ID)
 	AppId   Id                 `json:"app_id"`
 	Archive openapi_types.File `json:"archive"`
 
+	// CanarySteps Comma-separated percentages of traffic to release the new version to as a canary, e.g. "10,50". Omit to deploy normally
+	CanarySteps *string `json:"canary_steps,omitempty"`
+
+	// CellId A string with a prefix, underscore, and 26 alphanumeric characters (type ID)
+	CellId *Id `json:"cell_id,omitempty"`
+
 	// EnvId A string with a prefix, underscore, and 26 alphanumeric characters (type ID)
 	EnvId Id `json:"env_id"`
+
+	// PreviewBranch Deploy to the preview environment of this branch instead of env_id, creating it if it doesn't exist. An app's first deployment to it starts out with the settings and env vars it has in env_id
+	PreviewBranch *string `json:"preview_branch,omitempty"`
+
+	// PreviewIdleTtlSeconds How long the preview environment may go without a deployment before it is torn down, between 600 and 2592000. Defaults to 172800
+	PreviewIdleTtlSeconds *int `json:"preview_idle_ttl_seconds,omitempty"`
 }
 
 // CreateAppJSONRequestBody defines body for CreateApp for application/json ContentType.
 type CreateAppJSONRequestBody CreateAppJSONBody
 
-// CreateEnvJSONRequestBody defines body for CreateEnv for application/json ContentType.
-type CreateEnvJSONRequestBody CreateEnvJSONBody
+// ApproveDeploymentJSONRequestBody defines body for ApproveDeployment for application/json ContentType.
+type ApproveDeploymentJSONRequestBody = ReviewDeploymentRequest
 
-// UpMultipartRequestBody defines body for Up for multipart/form-data ContentType.
-type UpMultipartRequestBody UpMultipartBody
+// UpdateAutoscalingJSONRequestBody defines body for UpdateAutoscaling for application/json ContentType.
+type UpdateAutoscalingJSONRequestBody UpdateAutoscalingJSONBody
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+// PromoteCanaryJSONRequestBody defines body for PromoteCanary for application/json ContentType.
+type PromoteCanaryJSONRequestBody PromoteCanaryJSONBody
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
-}
+// CancelDeploymentJSONRequestBody defines body for CancelDeployment for application/json ContentType.
+type CancelDeploymentJSONRequestBody CancelDeploymentJSONBody
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
-	// to the server, such as https://api.deepmap.com/dev-test, and all the
-	// paths in the swagger spec will be appended to the server.
-	Server string
+// UpdateCellsJSONRequestBody defines body for UpdateCells for application/json ContentType.
+type UpdateCellsJSONRequestBody UpdateCellsJSONBody
+
+// CreateCronJobJSONRequestBody defines body for CreateCronJob for application/json ContentType.
+type CreateCronJobJSONRequestBody CreateCronJobJSONBody
+
+// UpdateDependenciesJSONRequestBody defines body for UpdateDependencies for application/json ContentType.
+type UpdateDependenciesJSONRequestBody UpdateDependenciesJSONBody
+
+// DeployImageJSONRequestBody defines body for DeployImage for application/json ContentType.
+type DeployImageJSONRequestBody DeployImageJSONBody
+
+// DiffDeploymentJSONRequestBody defines body for DiffDeployment for application/json ContentType.
+type DiffDeploymentJSONRequestBody DiffDeploymentJSONBody
+
+// CreateDomainJSONRequestBody defines body for CreateDomain for application/json ContentType.
+type CreateDomainJSONRequestBody CreateDomainJSONBody
+
+// UpdateExternalPortsJSONRequestBody defines body for UpdateExternalPorts for application/json ContentType.
+type UpdateExternalPortsJSONRequestBody UpdateExternalPortsJSONBody
+
+// UpdateHealthCheckJSONRequestBody defines body for UpdateHealthCheck for application/json ContentType.
+type UpdateHealthCheckJSONRequestBody UpdateHealthCheckJSONBody
+
+// UpdateProcessesJSONRequestBody defines body for UpdateProcesses for application/json ContentType.
+type UpdateProcessesJSONRequestBody UpdateProcessesJSONBody
+
+// PromoteJSONRequestBody defines body for Promote for application/json ContentType.
+type PromoteJSONRequestBody PromoteJSONBody
+
+// RejectDeploymentJSONRequestBody defines body for RejectDeployment for application/json ContentType.
+type RejectDeploymentJSONRequestBody = ReviewDeploymentRequest
+
+// UpdateReleaseCommandJSONRequestBody defines body for UpdateReleaseCommand for application/json ContentType.
+type UpdateReleaseCommandJSONRequestBody UpdateReleaseCommandJSONBody
+
+// RollbackJSONRequestBody defines body for Rollback for application/json ContentType.
+type RollbackJSONRequestBody RollbackJSONBody
+
+// ScaleJSONRequestBody defines body for Scale for application/json ContentType.
+type ScaleJSONRequestBody ScaleJSONBody
+
+// UpdateVolumesJSONRequestBody defines body for UpdateVolumes for application/json ContentType.
+type UpdateVolumesJSONRequestBody UpdateVolumesJSONBody
+
+// UpdateEnvJSONRequestBody defines body for UpdateEnv for application/json ContentType.
+type UpdateEnvJSONRequestBody UpdateEnvJSONBody
+
+// CreateEnvJSONRequestBody defines body for CreateEnv for application/json ContentType.
+type CreateEnvJSONRequestBody CreateEnvJSONBody
+
+// UpdateSharedEnvVarsJSONRequestBody defines body for UpdateSharedEnvVars for application/json ContentType.
+type UpdateSharedEnvVarsJSONRequestBody UpdateSharedEnvVarsJSONBody
+
+// LockEnvJSONRequestBody defines body for LockEnv for application/json ContentType.
+type LockEnvJSONRequestBody LockEnvJSONBody
+
+// UpMultipartRequestBody defines body for Up for multipart/form-data ContentType.
+type UpMultipartRequestBody UpMultipartBody
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
 
 	// Doer for performing requests, typically a *http.Client with any
 	// customized settings, such as certificate chains.
@@ -206,7 +967,7 @@ type ClientInterface interface {
 	GetApps(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteApp request
-	DeleteApp(ctx context.Context, appId Id, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteApp(ctx context.Context, appId Id, params *DeleteAppParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetApp request
 	GetApp(ctx context.Context, appId Id, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -216,6 +977,134 @@ type ClientInterface interface {
 
 	CreateApp(ctx context.Context, appId Id, body CreateAppJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ApproveDeploymentWithBody request with any body
+	ApproveDeploymentWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApproveDeployment(ctx context.Context, appId Id, envId Id, body ApproveDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateAutoscalingWithBody request with any body
+	UpdateAutoscalingWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateAutoscaling(ctx context.Context, appId Id, envId Id, body UpdateAutoscalingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AbortCanary request
+	AbortCanary(ctx context.Context, appId Id, envId Id, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PromoteCanaryWithBody request with any body
+	PromoteCanaryWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PromoteCanary(ctx context.Context, appId Id, envId Id, body PromoteCanaryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelDeploymentWithBody request with any body
+	CancelDeploymentWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CancelDeployment(ctx context.Context, appId Id, envId Id, body CancelDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateCellsWithBody request with any body
+	UpdateCellsWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCells(ctx context.Context, appId Id, envId Id, body UpdateCellsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCronJobs request
+	GetCronJobs(ctx context.Context, appId Id, envId Id, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateCronJobWithBody request with any body
+	CreateCronJobWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateCronJob(ctx context.Context, appId Id, envId Id, body CreateCronJobJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteCronJob request
+	DeleteCronJob(ctx context.Context, appId Id, envId Id, name LowercaseAlphaNumHyphen, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCronJobRuns request
+	GetCronJobRuns(ctx context.Context, appId Id, envId Id, name LowercaseAlphaNumHyphen, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCronJobRunLogs request
+	GetCronJobRunLogs(ctx context.Context, appId Id, envId Id, name LowercaseAlphaNumHyphen, runName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateDependenciesWithBody request with any body
+	UpdateDependenciesWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateDependencies(ctx context.Context, appId Id, envId Id, body UpdateDependenciesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeployImageWithBody request with any body
+	DeployImageWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeployImage(ctx context.Context, appId Id, envId Id, body DeployImageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDeploymentEvents request
+	GetDeploymentEvents(ctx context.Context, appId Id, envId Id, deploymentId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DiffDeploymentWithBody request with any body
+	DiffDeploymentWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DiffDeployment(ctx context.Context, appId Id, envId Id, body DiffDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDomains request
+	GetDomains(ctx context.Context, appId Id, envId Id, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateDomainWithBody request with any body
+	CreateDomainWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateDomain(ctx context.Context, appId Id, envId Id, body CreateDomainJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteDomain request
+	DeleteDomain(ctx context.Context, appId Id, envId Id, hostname string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VerifyDomain request
+	VerifyDomain(ctx context.Context, appId Id, envId Id, hostname string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateExternalPortsWithBody request with any body
+	UpdateExternalPortsWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateExternalPorts(ctx context.Context, appId Id, envId Id, body UpdateExternalPortsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateHealthCheckWithBody request with any body
+	UpdateHealthCheckWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateHealthCheck(ctx context.Context, appId Id, envId Id, body UpdateHealthCheckJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateProcessesWithBody request with any body
+	UpdateProcessesWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateProcesses(ctx context.Context, appId Id, envId Id, body UpdateProcessesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PromoteWithBody request with any body
+	PromoteWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Promote(ctx context.Context, appId Id, envId Id, body PromoteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RejectDeploymentWithBody request with any body
+	RejectDeploymentWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RejectDeployment(ctx context.Context, appId Id, envId Id, body RejectDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateReleaseCommandWithBody request with any body
+	UpdateReleaseCommandWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateReleaseCommand(ctx context.Context, appId Id, envId Id, body UpdateReleaseCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Restart request
+	Restart(ctx context.Context, appId Id, envId Id, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RollbackWithBody request with any body
+	RollbackWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Rollback(ctx context.Context, appId Id, envId Id, body RollbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ScaleWithBody request with any body
+	ScaleWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Scale(ctx context.Context, appId Id, envId Id, body ScaleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateVolumesWithBody request with any body
+	UpdateVolumesWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateVolumes(ctx context.Context, appId Id, envId Id, body UpdateVolumesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCells request
+	GetCells(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetEnvs request
 	GetEnvs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -225,11 +1114,32 @@ type ClientInterface interface {
 	// GetEnv request
 	GetEnv(ctx context.Context, envId Id, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateEnvWithBody request with any body
+	UpdateEnvWithBody(ctx context.Context, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateEnv(ctx context.Context, envId Id, body UpdateEnvJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateEnvWithBody request with any body
 	CreateEnvWithBody(ctx context.Context, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	CreateEnv(ctx context.Context, envId Id, body CreateEnvJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetSharedEnvVars request
+	GetSharedEnvVars(ctx context.Context, envId Id, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSharedEnvVarsWithBody request with any body
+	UpdateSharedEnvVarsWithBody(ctx context.Context, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSharedEnvVars(ctx context.Context, envId Id, body UpdateSharedEnvVarsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnlockEnv request
+	UnlockEnv(ctx context.Context, envId Id, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LockEnvWithBody request with any body
+	LockEnvWithBody(ctx context.Context, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LockEnv(ctx context.Context, envId Id, body LockEnvJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UpWithBody request with any body
 	UpWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -249,8 +1159,8 @@ func (c *Client) GetApps(ctx context.Context, reqEditors ...RequestEditorFn) (*h
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteApp(ctx context.Context, appId Id, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteAppRequest(c.Server, appId)
+func (c *Client) DeleteApp(ctx context.Context, appId Id, params *DeleteAppParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAppRequest(c.Server, appId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -297,8 +1207,8 @@ func (c *Client) CreateApp(ctx context.Context, appId Id, body CreateAppJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetEnvs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetEnvsRequest(c.Server)
+func (c *Client) ApproveDeploymentWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveDeploymentRequestWithBody(c.Server, appId, envId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -309,8 +1219,8 @@ func (c *Client) GetEnvs(ctx context.Context, reqEditors ...RequestEditorFn) (*h
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteEnv(ctx context.Context, envId Id, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteEnvRequest(c.Server, envId)
+func (c *Client) ApproveDeployment(ctx context.Context, appId Id, envId Id, body ApproveDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveDeploymentRequest(c.Server, appId, envId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -321,8 +1231,8 @@ func (c *Client) DeleteEnv(ctx context.Context, envId Id, reqEditors ...RequestE
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetEnv(ctx context.Context, envId Id, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetEnvRequest(c.Server, envId)
+func (c *Client) UpdateAutoscalingWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAutoscalingRequestWithBody(c.Server, appId, envId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -333,8 +1243,8 @@ func (c *Client) GetEnv(ctx context.Context, envId Id, reqEditors ...RequestEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateEnvWithBody(ctx context.Context, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateEnvRequestWithBody(c.Server, envId, contentType, body)
+func (c *Client) UpdateAutoscaling(ctx context.Context, appId Id, envId Id, body UpdateAutoscalingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAutoscalingRequest(c.Server, appId, envId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -345,8 +1255,8 @@ func (c *Client) CreateEnvWithBody(ctx context.Context, envId Id, contentType st
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateEnv(ctx context.Context, envId Id, body CreateEnvJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateEnvRequest(c.Server, envId, body)
+func (c *Client) AbortCanary(ctx context.Context, appId Id, envId Id, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAbortCanaryRequest(c.Server, appId, envId)
 	if err != nil {
 		return nil, err
 	}
@@ -357,8 +1267,8 @@ func (c *Client) CreateEnv(ctx context.Context, envId Id, body CreateEnvJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpRequestWithBody(c.Server, contentType, body)
+func (c *Client) PromoteCanaryWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPromoteCanaryRequestWithBody(c.Server, appId, envId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -369,8 +1279,8 @@ func (c *Client) UpWithBody(ctx context.Context, contentType string, body io.Rea
 	return c.Client.Do(req)
 }
 
-func (c *Client) WhoAmI(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWhoAmIRequest(c.Server)
+func (c *Client) PromoteCanary(ctx context.Context, appId Id, envId Id, body PromoteCanaryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPromoteCanaryRequest(c.Server, appId, envId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -381,2038 +1291,12062 @@ func (c *Client) WhoAmI(ctx context.Context, reqEditors ...RequestEditorFn) (*ht
 	return c.Client.Do(req)
 }
 
-// NewGetAppsRequest generates requests for GetApps
-func NewGetAppsRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CancelDeploymentWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelDeploymentRequestWithBody(c.Server, appId, envId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/apps")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CancelDeployment(ctx context.Context, appId Id, envId Id, body CancelDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelDeploymentRequest(c.Server, appId, envId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteAppRequest generates requests for DeleteApp
-func NewDeleteAppRequest(server string, appId Id) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+func (c *Client) UpdateCellsWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCellsRequestWithBody(c.Server, appId, envId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/apps/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateCells(ctx context.Context, appId Id, envId Id, body UpdateCellsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCellsRequest(c.Server, appId, envId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetAppRequest generates requests for GetApp
-func NewGetAppRequest(server string, appId Id) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+func (c *Client) GetCronJobs(ctx context.Context, appId Id, envId Id, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCronJobsRequest(c.Server, appId, envId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateCronJobWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCronJobRequestWithBody(c.Server, appId, envId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/apps/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateCronJob(ctx context.Context, appId Id, envId Id, body CreateCronJobJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCronJobRequest(c.Server, appId, envId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateAppRequest calls the generic CreateApp builder with application/json body
-func NewCreateAppRequest(server string, appId Id, body CreateAppJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DeleteCronJob(ctx context.Context, appId Id, envId Id, name LowercaseAlphaNumHyphen, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCronJobRequest(c.Server, appId, envId, name)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateAppRequestWithBody(server, appId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateAppRequestWithBody generates requests for CreateApp with any type of body
-func NewCreateAppRequestWithBody(server string, appId Id, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+func (c *Client) GetCronJobRuns(ctx context.Context, appId Id, envId Id, name LowercaseAlphaNumHyphen, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCronJobRunsRequest(c.Server, appId, envId, name)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetCronJobRunLogs(ctx context.Context, appId Id, envId Id, name LowercaseAlphaNumHyphen, runName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCronJobRunLogsRequest(c.Server, appId, envId, name, runName)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/apps/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateDependenciesWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDependenciesRequestWithBody(c.Server, appId, envId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetEnvsRequest generates requests for GetEnvs
-func NewGetEnvsRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateDependencies(ctx context.Context, appId Id, envId Id, body UpdateDependenciesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDependenciesRequest(c.Server, appId, envId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/envs")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeployImageWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeployImageRequestWithBody(c.Server, appId, envId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteEnvRequest generates requests for DeleteEnv
-func NewDeleteEnvRequest(server string, envId Id) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+func (c *Client) DeployImage(ctx context.Context, appId Id, envId Id, body DeployImageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeployImageRequest(c.Server, appId, envId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetDeploymentEvents(ctx context.Context, appId Id, envId Id, deploymentId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDeploymentEventsRequest(c.Server, appId, envId, deploymentId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/envs/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DiffDeploymentWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDiffDeploymentRequestWithBody(c.Server, appId, envId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) DiffDeployment(ctx context.Context, appId Id, envId Id, body DiffDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDiffDeploymentRequest(c.Server, appId, envId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetEnvRequest generates requests for GetEnv
-func NewGetEnvRequest(server string, envId Id) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+func (c *Client) GetDomains(ctx context.Context, appId Id, envId Id, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDomainsRequest(c.Server, appId, envId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateDomainWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDomainRequestWithBody(c.Server, appId, envId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/envs/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateDomain(ctx context.Context, appId Id, envId Id, body CreateDomainJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDomainRequest(c.Server, appId, envId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) DeleteDomain(ctx context.Context, appId Id, envId Id, hostname string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDomainRequest(c.Server, appId, envId, hostname)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateEnvRequest calls the generic CreateEnv builder with application/json body
-func NewCreateEnvRequest(server string, envId Id, body CreateEnvJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) VerifyDomain(ctx context.Context, appId Id, envId Id, hostname string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyDomainRequest(c.Server, appId, envId, hostname)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateEnvRequestWithBody(server, envId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateEnvRequestWithBody generates requests for CreateEnv with any type of body
-func NewCreateEnvRequestWithBody(server string, envId Id, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+func (c *Client) UpdateExternalPortsWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateExternalPortsRequestWithBody(c.Server, appId, envId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateExternalPorts(ctx context.Context, appId Id, envId Id, body UpdateExternalPortsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateExternalPortsRequest(c.Server, appId, envId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/envs/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateHealthCheckWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateHealthCheckRequestWithBody(c.Server, appId, envId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) UpdateHealthCheck(ctx context.Context, appId Id, envId Id, body UpdateHealthCheckJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateHealthCheckRequest(c.Server, appId, envId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpRequestWithBody generates requests for Up with any type of body
-func NewUpRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateProcessesWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProcessesRequestWithBody(c.Server, appId, envId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/up")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateProcesses(ctx context.Context, appId Id, envId Id, body UpdateProcessesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProcessesRequest(c.Server, appId, envId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) PromoteWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPromoteRequestWithBody(c.Server, appId, envId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewWhoAmIRequest generates requests for WhoAmI
-func NewWhoAmIRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) Promote(ctx context.Context, appId Id, envId Id, body PromoteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPromoteRequest(c.Server, appId, envId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/whoami")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RejectDeploymentWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRejectDeploymentRequestWithBody(c.Server, appId, envId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) RejectDeployment(ctx context.Context, appId Id, envId Id, body RejectDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRejectDeploymentRequest(c.Server, appId, envId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) UpdateReleaseCommandWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateReleaseCommandRequestWithBody(c.Server, appId, envId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) UpdateReleaseCommand(ctx context.Context, appId Id, envId Id, body UpdateReleaseCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateReleaseCommandRequest(c.Server, appId, envId, body)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *Client) Restart(ctx context.Context, appId Id, envId Id, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestartRequest(c.Server, appId, envId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) RollbackWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRollbackRequestWithBody(c.Server, appId, envId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+func (c *Client) Rollback(ctx context.Context, appId Id, envId Id, body RollbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRollbackRequest(c.Server, appId, envId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
+func (c *Client) ScaleWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewScaleRequestWithBody(c.Server, appId, envId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Scale(ctx context.Context, appId Id, envId Id, body ScaleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewScaleRequest(c.Server, appId, envId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateVolumesWithBody(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateVolumesRequestWithBody(c.Server, appId, envId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateVolumes(ctx context.Context, appId Id, envId Id, body UpdateVolumesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateVolumesRequest(c.Server, appId, envId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCells(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCellsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEnvs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEnvsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteEnv(ctx context.Context, envId Id, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteEnvRequest(c.Server, envId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEnv(ctx context.Context, envId Id, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEnvRequest(c.Server, envId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateEnvWithBody(ctx context.Context, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateEnvRequestWithBody(c.Server, envId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateEnv(ctx context.Context, envId Id, body UpdateEnvJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateEnvRequest(c.Server, envId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateEnvWithBody(ctx context.Context, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateEnvRequestWithBody(c.Server, envId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateEnv(ctx context.Context, envId Id, body CreateEnvJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateEnvRequest(c.Server, envId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSharedEnvVars(ctx context.Context, envId Id, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSharedEnvVarsRequest(c.Server, envId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSharedEnvVarsWithBody(ctx context.Context, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSharedEnvVarsRequestWithBody(c.Server, envId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSharedEnvVars(ctx context.Context, envId Id, body UpdateSharedEnvVarsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSharedEnvVarsRequest(c.Server, envId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UnlockEnv(ctx context.Context, envId Id, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnlockEnvRequest(c.Server, envId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LockEnvWithBody(ctx context.Context, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLockEnvRequestWithBody(c.Server, envId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LockEnv(ctx context.Context, envId Id, body LockEnvJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLockEnvRequest(c.Server, envId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WhoAmI(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWhoAmIRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetAppsRequest generates requests for GetApps
+func NewGetAppsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteAppRequest generates requests for DeleteApp
+func NewDeleteAppRequest(server string, appId Id, params *DeleteAppParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DeleteVolumes != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "delete_volumes", runtime.ParamLocationQuery, *params.DeleteVolumes); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAppRequest generates requests for GetApp
+func NewGetAppRequest(server string, appId Id) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateAppRequest calls the generic CreateApp builder with application/json body
+func NewCreateAppRequest(server string, appId Id, body CreateAppJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateAppRequestWithBody(server, appId, "application/json", bodyReader)
+}
+
+// NewCreateAppRequestWithBody generates requests for CreateApp with any type of body
+func NewCreateAppRequestWithBody(server string, appId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewApproveDeploymentRequest calls the generic ApproveDeployment builder with application/json body
+func NewApproveDeploymentRequest(server string, appId Id, envId Id, body ApproveDeploymentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApproveDeploymentRequestWithBody(server, appId, envId, "application/json", bodyReader)
+}
+
+// NewApproveDeploymentRequestWithBody generates requests for ApproveDeployment with any type of body
+func NewApproveDeploymentRequestWithBody(server string, appId Id, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/approve", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateAutoscalingRequest calls the generic UpdateAutoscaling builder with application/json body
+func NewUpdateAutoscalingRequest(server string, appId Id, envId Id, body UpdateAutoscalingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateAutoscalingRequestWithBody(server, appId, envId, "application/json", bodyReader)
+}
+
+// NewUpdateAutoscalingRequestWithBody generates requests for UpdateAutoscaling with any type of body
+func NewUpdateAutoscalingRequestWithBody(server string, appId Id, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/autoscaling", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAbortCanaryRequest generates requests for AbortCanary
+func NewAbortCanaryRequest(server string, appId Id, envId Id) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/canary/abort", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPromoteCanaryRequest calls the generic PromoteCanary builder with application/json body
+func NewPromoteCanaryRequest(server string, appId Id, envId Id, body PromoteCanaryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPromoteCanaryRequestWithBody(server, appId, envId, "application/json", bodyReader)
+}
+
+// NewPromoteCanaryRequestWithBody generates requests for PromoteCanary with any type of body
+func NewPromoteCanaryRequestWithBody(server string, appId Id, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/canary/promote", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCancelDeploymentRequest calls the generic CancelDeployment builder with application/json body
+func NewCancelDeploymentRequest(server string, appId Id, envId Id, body CancelDeploymentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCancelDeploymentRequestWithBody(server, appId, envId, "application/json", bodyReader)
+}
+
+// NewCancelDeploymentRequestWithBody generates requests for CancelDeployment with any type of body
+func NewCancelDeploymentRequestWithBody(server string, appId Id, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/cancel", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateCellsRequest calls the generic UpdateCells builder with application/json body
+func NewUpdateCellsRequest(server string, appId Id, envId Id, body UpdateCellsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateCellsRequestWithBody(server, appId, envId, "application/json", bodyReader)
+}
+
+// NewUpdateCellsRequestWithBody generates requests for UpdateCells with any type of body
+func NewUpdateCellsRequestWithBody(server string, appId Id, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/cells", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetCronJobsRequest generates requests for GetCronJobs
+func NewGetCronJobsRequest(server string, appId Id, envId Id) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/cron-jobs", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateCronJobRequest calls the generic CreateCronJob builder with application/json body
+func NewCreateCronJobRequest(server string, appId Id, envId Id, body CreateCronJobJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateCronJobRequestWithBody(server, appId, envId, "application/json", bodyReader)
+}
+
+// NewCreateCronJobRequestWithBody generates requests for CreateCronJob with any type of body
+func NewCreateCronJobRequestWithBody(server string, appId Id, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/cron-jobs", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteCronJobRequest generates requests for DeleteCronJob
+func NewDeleteCronJobRequest(server string, appId Id, envId Id, name LowercaseAlphaNumHyphen) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/cron-jobs/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCronJobRunsRequest generates requests for GetCronJobRuns
+func NewGetCronJobRunsRequest(server string, appId Id, envId Id, name LowercaseAlphaNumHyphen) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/cron-jobs/%s/runs", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCronJobRunLogsRequest generates requests for GetCronJobRunLogs
+func NewGetCronJobRunLogsRequest(server string, appId Id, envId Id, name LowercaseAlphaNumHyphen, runName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "runName", runtime.ParamLocationPath, runName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/cron-jobs/%s/runs/%s/logs", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateDependenciesRequest calls the generic UpdateDependencies builder with application/json body
+func NewUpdateDependenciesRequest(server string, appId Id, envId Id, body UpdateDependenciesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDependenciesRequestWithBody(server, appId, envId, "application/json", bodyReader)
+}
+
+// NewUpdateDependenciesRequestWithBody generates requests for UpdateDependencies with any type of body
+func NewUpdateDependenciesRequestWithBody(server string, appId Id, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/dependencies", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeployImageRequest calls the generic DeployImage builder with application/json body
+func NewDeployImageRequest(server string, appId Id, envId Id, body DeployImageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeployImageRequestWithBody(server, appId, envId, "application/json", bodyReader)
+}
+
+// NewDeployImageRequestWithBody generates requests for DeployImage with any type of body
+func NewDeployImageRequestWithBody(server string, appId Id, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/deploy", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetDeploymentEventsRequest generates requests for GetDeploymentEvents
+func NewGetDeploymentEventsRequest(server string, appId Id, envId Id, deploymentId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "deploymentId", runtime.ParamLocationPath, deploymentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/deployments/%s/events", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDiffDeploymentRequest calls the generic DiffDeployment builder with application/json body
+func NewDiffDeploymentRequest(server string, appId Id, envId Id, body DiffDeploymentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDiffDeploymentRequestWithBody(server, appId, envId, "application/json", bodyReader)
+}
+
+// NewDiffDeploymentRequestWithBody generates requests for DiffDeployment with any type of body
+func NewDiffDeploymentRequestWithBody(server string, appId Id, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/diff", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetDomainsRequest generates requests for GetDomains
+func NewGetDomainsRequest(server string, appId Id, envId Id) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/domains", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateDomainRequest calls the generic CreateDomain builder with application/json body
+func NewCreateDomainRequest(server string, appId Id, envId Id, body CreateDomainJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDomainRequestWithBody(server, appId, envId, "application/json", bodyReader)
+}
+
+// NewCreateDomainRequestWithBody generates requests for CreateDomain with any type of body
+func NewCreateDomainRequestWithBody(server string, appId Id, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/domains", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteDomainRequest generates requests for DeleteDomain
+func NewDeleteDomainRequest(server string, appId Id, envId Id, hostname string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "hostname", runtime.ParamLocationPath, hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/domains/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewVerifyDomainRequest generates requests for VerifyDomain
+func NewVerifyDomainRequest(server string, appId Id, envId Id, hostname string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "hostname", runtime.ParamLocationPath, hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/domains/%s/verify", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateExternalPortsRequest calls the generic UpdateExternalPorts builder with application/json body
+func NewUpdateExternalPortsRequest(server string, appId Id, envId Id, body UpdateExternalPortsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateExternalPortsRequestWithBody(server, appId, envId, "application/json", bodyReader)
+}
+
+// NewUpdateExternalPortsRequestWithBody generates requests for UpdateExternalPorts with any type of body
+func NewUpdateExternalPortsRequestWithBody(server string, appId Id, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/external-ports", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateHealthCheckRequest calls the generic UpdateHealthCheck builder with application/json body
+func NewUpdateHealthCheckRequest(server string, appId Id, envId Id, body UpdateHealthCheckJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateHealthCheckRequestWithBody(server, appId, envId, "application/json", bodyReader)
+}
+
+// NewUpdateHealthCheckRequestWithBody generates requests for UpdateHealthCheck with any type of body
+func NewUpdateHealthCheckRequestWithBody(server string, appId Id, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/health-check", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateProcessesRequest calls the generic UpdateProcesses builder with application/json body
+func NewUpdateProcessesRequest(server string, appId Id, envId Id, body UpdateProcessesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProcessesRequestWithBody(server, appId, envId, "application/json", bodyReader)
+}
+
+// NewUpdateProcessesRequestWithBody generates requests for UpdateProcesses with any type of body
+func NewUpdateProcessesRequestWithBody(server string, appId Id, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/processes", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPromoteRequest calls the generic Promote builder with application/json body
+func NewPromoteRequest(server string, appId Id, envId Id, body PromoteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPromoteRequestWithBody(server, appId, envId, "application/json", bodyReader)
+}
+
+// NewPromoteRequestWithBody generates requests for Promote with any type of body
+func NewPromoteRequestWithBody(server string, appId Id, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/promote", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRejectDeploymentRequest calls the generic RejectDeployment builder with application/json body
+func NewRejectDeploymentRequest(server string, appId Id, envId Id, body RejectDeploymentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRejectDeploymentRequestWithBody(server, appId, envId, "application/json", bodyReader)
+}
+
+// NewRejectDeploymentRequestWithBody generates requests for RejectDeployment with any type of body
+func NewRejectDeploymentRequestWithBody(server string, appId Id, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/reject", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateReleaseCommandRequest calls the generic UpdateReleaseCommand builder with application/json body
+func NewUpdateReleaseCommandRequest(server string, appId Id, envId Id, body UpdateReleaseCommandJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateReleaseCommandRequestWithBody(server, appId, envId, "application/json", bodyReader)
+}
+
+// NewUpdateReleaseCommandRequestWithBody generates requests for UpdateReleaseCommand with any type of body
+func NewUpdateReleaseCommandRequestWithBody(server string, appId Id, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/release-command", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRestartRequest generates requests for Restart
+func NewRestartRequest(server string, appId Id, envId Id) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/restart", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRollbackRequest calls the generic Rollback builder with application/json body
+func NewRollbackRequest(server string, appId Id, envId Id, body RollbackJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRollbackRequestWithBody(server, appId, envId, "application/json", bodyReader)
+}
+
+// NewRollbackRequestWithBody generates requests for Rollback with any type of body
+func NewRollbackRequestWithBody(server string, appId Id, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/rollback", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewScaleRequest calls the generic Scale builder with application/json body
+func NewScaleRequest(server string, appId Id, envId Id, body ScaleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewScaleRequestWithBody(server, appId, envId, "application/json", bodyReader)
+}
+
+// NewScaleRequestWithBody generates requests for Scale with any type of body
+func NewScaleRequestWithBody(server string, appId Id, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/scale", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateVolumesRequest calls the generic UpdateVolumes builder with application/json body
+func NewUpdateVolumesRequest(server string, appId Id, envId Id, body UpdateVolumesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateVolumesRequestWithBody(server, appId, envId, "application/json", bodyReader)
+}
+
+// NewUpdateVolumesRequestWithBody generates requests for UpdateVolumes with any type of body
+func NewUpdateVolumesRequestWithBody(server string, appId Id, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/apps/%s/envs/%s/volumes", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetCellsRequest generates requests for GetCells
+func NewGetCellsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/cells")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetEnvsRequest generates requests for GetEnvs
+func NewGetEnvsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/envs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteEnvRequest generates requests for DeleteEnv
+func NewDeleteEnvRequest(server string, envId Id) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/envs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetEnvRequest generates requests for GetEnv
+func NewGetEnvRequest(server string, envId Id) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/envs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateEnvRequest calls the generic UpdateEnv builder with application/json body
+func NewUpdateEnvRequest(server string, envId Id, body UpdateEnvJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateEnvRequestWithBody(server, envId, "application/json", bodyReader)
+}
+
+// NewUpdateEnvRequestWithBody generates requests for UpdateEnv with any type of body
+func NewUpdateEnvRequestWithBody(server string, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/envs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateEnvRequest calls the generic CreateEnv builder with application/json body
+func NewCreateEnvRequest(server string, envId Id, body CreateEnvJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateEnvRequestWithBody(server, envId, "application/json", bodyReader)
+}
+
+// NewCreateEnvRequestWithBody generates requests for CreateEnv with any type of body
+func NewCreateEnvRequestWithBody(server string, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/envs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSharedEnvVarsRequest generates requests for GetSharedEnvVars
+func NewGetSharedEnvVarsRequest(server string, envId Id) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/envs/%s/env-vars", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateSharedEnvVarsRequest calls the generic UpdateSharedEnvVars builder with application/json body
+func NewUpdateSharedEnvVarsRequest(server string, envId Id, body UpdateSharedEnvVarsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSharedEnvVarsRequestWithBody(server, envId, "application/json", bodyReader)
+}
+
+// NewUpdateSharedEnvVarsRequestWithBody generates requests for UpdateSharedEnvVars with any type of body
+func NewUpdateSharedEnvVarsRequestWithBody(server string, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/envs/%s/env-vars", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUnlockEnvRequest generates requests for UnlockEnv
+func NewUnlockEnvRequest(server string, envId Id) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/envs/%s/lock", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLockEnvRequest calls the generic LockEnv builder with application/json body
+func NewLockEnvRequest(server string, envId Id, body LockEnvJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLockEnvRequestWithBody(server, envId, "application/json", bodyReader)
+}
+
+// NewLockEnvRequestWithBody generates requests for LockEnv with any type of body
+func NewLockEnvRequestWithBody(server string, envId Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "envId", runtime.ParamLocationPath, envId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/envs/%s/lock", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpRequestWithBody generates requests for Up with any type of body
+func NewUpRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/up")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWhoAmIRequest generates requests for WhoAmI
+func NewWhoAmIRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/whoami")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
 	// GetAppsWithResponse request
 	GetAppsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAppsResponse, error)
 
-	// DeleteAppWithResponse request
-	DeleteAppWithResponse(ctx context.Context, appId Id, reqEditors ...RequestEditorFn) (*DeleteAppResponse, error)
+	// DeleteAppWithResponse request
+	DeleteAppWithResponse(ctx context.Context, appId Id, params *DeleteAppParams, reqEditors ...RequestEditorFn) (*DeleteAppResponse, error)
+
+	// GetAppWithResponse request
+	GetAppWithResponse(ctx context.Context, appId Id, reqEditors ...RequestEditorFn) (*GetAppResponse, error)
+
+	// CreateAppWithBodyWithResponse request with any body
+	CreateAppWithBodyWithResponse(ctx context.Context, appId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppResponse, error)
+
+	CreateAppWithResponse(ctx context.Context, appId Id, body CreateAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppResponse, error)
+
+	// ApproveDeploymentWithBodyWithResponse request with any body
+	ApproveDeploymentWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveDeploymentResponse, error)
+
+	ApproveDeploymentWithResponse(ctx context.Context, appId Id, envId Id, body ApproveDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveDeploymentResponse, error)
+
+	// UpdateAutoscalingWithBodyWithResponse request with any body
+	UpdateAutoscalingWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAutoscalingResponse, error)
+
+	UpdateAutoscalingWithResponse(ctx context.Context, appId Id, envId Id, body UpdateAutoscalingJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAutoscalingResponse, error)
+
+	// AbortCanaryWithResponse request
+	AbortCanaryWithResponse(ctx context.Context, appId Id, envId Id, reqEditors ...RequestEditorFn) (*AbortCanaryResponse, error)
+
+	// PromoteCanaryWithBodyWithResponse request with any body
+	PromoteCanaryWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PromoteCanaryResponse, error)
+
+	PromoteCanaryWithResponse(ctx context.Context, appId Id, envId Id, body PromoteCanaryJSONRequestBody, reqEditors ...RequestEditorFn) (*PromoteCanaryResponse, error)
+
+	// CancelDeploymentWithBodyWithResponse request with any body
+	CancelDeploymentWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CancelDeploymentResponse, error)
+
+	CancelDeploymentWithResponse(ctx context.Context, appId Id, envId Id, body CancelDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*CancelDeploymentResponse, error)
+
+	// UpdateCellsWithBodyWithResponse request with any body
+	UpdateCellsWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCellsResponse, error)
+
+	UpdateCellsWithResponse(ctx context.Context, appId Id, envId Id, body UpdateCellsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCellsResponse, error)
+
+	// GetCronJobsWithResponse request
+	GetCronJobsWithResponse(ctx context.Context, appId Id, envId Id, reqEditors ...RequestEditorFn) (*GetCronJobsResponse, error)
+
+	// CreateCronJobWithBodyWithResponse request with any body
+	CreateCronJobWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCronJobResponse, error)
+
+	CreateCronJobWithResponse(ctx context.Context, appId Id, envId Id, body CreateCronJobJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCronJobResponse, error)
+
+	// DeleteCronJobWithResponse request
+	DeleteCronJobWithResponse(ctx context.Context, appId Id, envId Id, name LowercaseAlphaNumHyphen, reqEditors ...RequestEditorFn) (*DeleteCronJobResponse, error)
+
+	// GetCronJobRunsWithResponse request
+	GetCronJobRunsWithResponse(ctx context.Context, appId Id, envId Id, name LowercaseAlphaNumHyphen, reqEditors ...RequestEditorFn) (*GetCronJobRunsResponse, error)
+
+	// GetCronJobRunLogsWithResponse request
+	GetCronJobRunLogsWithResponse(ctx context.Context, appId Id, envId Id, name LowercaseAlphaNumHyphen, runName string, reqEditors ...RequestEditorFn) (*GetCronJobRunLogsResponse, error)
+
+	// UpdateDependenciesWithBodyWithResponse request with any body
+	UpdateDependenciesWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDependenciesResponse, error)
+
+	UpdateDependenciesWithResponse(ctx context.Context, appId Id, envId Id, body UpdateDependenciesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDependenciesResponse, error)
+
+	// DeployImageWithBodyWithResponse request with any body
+	DeployImageWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeployImageResponse, error)
+
+	DeployImageWithResponse(ctx context.Context, appId Id, envId Id, body DeployImageJSONRequestBody, reqEditors ...RequestEditorFn) (*DeployImageResponse, error)
+
+	// GetDeploymentEventsWithResponse request
+	GetDeploymentEventsWithResponse(ctx context.Context, appId Id, envId Id, deploymentId int, reqEditors ...RequestEditorFn) (*GetDeploymentEventsResponse, error)
+
+	// DiffDeploymentWithBodyWithResponse request with any body
+	DiffDeploymentWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DiffDeploymentResponse, error)
+
+	DiffDeploymentWithResponse(ctx context.Context, appId Id, envId Id, body DiffDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*DiffDeploymentResponse, error)
+
+	// GetDomainsWithResponse request
+	GetDomainsWithResponse(ctx context.Context, appId Id, envId Id, reqEditors ...RequestEditorFn) (*GetDomainsResponse, error)
+
+	// CreateDomainWithBodyWithResponse request with any body
+	CreateDomainWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDomainResponse, error)
+
+	CreateDomainWithResponse(ctx context.Context, appId Id, envId Id, body CreateDomainJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDomainResponse, error)
+
+	// DeleteDomainWithResponse request
+	DeleteDomainWithResponse(ctx context.Context, appId Id, envId Id, hostname string, reqEditors ...RequestEditorFn) (*DeleteDomainResponse, error)
+
+	// VerifyDomainWithResponse request
+	VerifyDomainWithResponse(ctx context.Context, appId Id, envId Id, hostname string, reqEditors ...RequestEditorFn) (*VerifyDomainResponse, error)
+
+	// UpdateExternalPortsWithBodyWithResponse request with any body
+	UpdateExternalPortsWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateExternalPortsResponse, error)
+
+	UpdateExternalPortsWithResponse(ctx context.Context, appId Id, envId Id, body UpdateExternalPortsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateExternalPortsResponse, error)
+
+	// UpdateHealthCheckWithBodyWithResponse request with any body
+	UpdateHealthCheckWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateHealthCheckResponse, error)
+
+	UpdateHealthCheckWithResponse(ctx context.Context, appId Id, envId Id, body UpdateHealthCheckJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateHealthCheckResponse, error)
+
+	// UpdateProcessesWithBodyWithResponse request with any body
+	UpdateProcessesWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProcessesResponse, error)
+
+	UpdateProcessesWithResponse(ctx context.Context, appId Id, envId Id, body UpdateProcessesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProcessesResponse, error)
+
+	// PromoteWithBodyWithResponse request with any body
+	PromoteWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PromoteResponse, error)
+
+	PromoteWithResponse(ctx context.Context, appId Id, envId Id, body PromoteJSONRequestBody, reqEditors ...RequestEditorFn) (*PromoteResponse, error)
+
+	// RejectDeploymentWithBodyWithResponse request with any body
+	RejectDeploymentWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RejectDeploymentResponse, error)
+
+	RejectDeploymentWithResponse(ctx context.Context, appId Id, envId Id, body RejectDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*RejectDeploymentResponse, error)
+
+	// UpdateReleaseCommandWithBodyWithResponse request with any body
+	UpdateReleaseCommandWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateReleaseCommandResponse, error)
+
+	UpdateReleaseCommandWithResponse(ctx context.Context, appId Id, envId Id, body UpdateReleaseCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateReleaseCommandResponse, error)
+
+	// RestartWithResponse request
+	RestartWithResponse(ctx context.Context, appId Id, envId Id, reqEditors ...RequestEditorFn) (*RestartResponse, error)
+
+	// RollbackWithBodyWithResponse request with any body
+	RollbackWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RollbackResponse, error)
+
+	RollbackWithResponse(ctx context.Context, appId Id, envId Id, body RollbackJSONRequestBody, reqEditors ...RequestEditorFn) (*RollbackResponse, error)
+
+	// ScaleWithBodyWithResponse request with any body
+	ScaleWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ScaleResponse, error)
+
+	ScaleWithResponse(ctx context.Context, appId Id, envId Id, body ScaleJSONRequestBody, reqEditors ...RequestEditorFn) (*ScaleResponse, error)
+
+	// UpdateVolumesWithBodyWithResponse request with any body
+	UpdateVolumesWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateVolumesResponse, error)
+
+	UpdateVolumesWithResponse(ctx context.Context, appId Id, envId Id, body UpdateVolumesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateVolumesResponse, error)
+
+	// GetCellsWithResponse request
+	GetCellsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCellsResponse, error)
+
+	// GetEnvsWithResponse request
+	GetEnvsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEnvsResponse, error)
+
+	// DeleteEnvWithResponse request
+	DeleteEnvWithResponse(ctx context.Context, envId Id, reqEditors ...RequestEditorFn) (*DeleteEnvResponse, error)
+
+	// GetEnvWithResponse request
+	GetEnvWithResponse(ctx context.Context, envId Id, reqEditors ...RequestEditorFn) (*GetEnvResponse, error)
+
+	// UpdateEnvWithBodyWithResponse request with any body
+	UpdateEnvWithBodyWithResponse(ctx context.Context, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEnvResponse, error)
+
+	UpdateEnvWithResponse(ctx context.Context, envId Id, body UpdateEnvJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEnvResponse, error)
+
+	// CreateEnvWithBodyWithResponse request with any body
+	CreateEnvWithBodyWithResponse(ctx context.Context, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnvResponse, error)
+
+	CreateEnvWithResponse(ctx context.Context, envId Id, body CreateEnvJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnvResponse, error)
+
+	// GetSharedEnvVarsWithResponse request
+	GetSharedEnvVarsWithResponse(ctx context.Context, envId Id, reqEditors ...RequestEditorFn) (*GetSharedEnvVarsResponse, error)
+
+	// UpdateSharedEnvVarsWithBodyWithResponse request with any body
+	UpdateSharedEnvVarsWithBodyWithResponse(ctx context.Context, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSharedEnvVarsResponse, error)
+
+	UpdateSharedEnvVarsWithResponse(ctx context.Context, envId Id, body UpdateSharedEnvVarsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSharedEnvVarsResponse, error)
+
+	// UnlockEnvWithResponse request
+	UnlockEnvWithResponse(ctx context.Context, envId Id, reqEditors ...RequestEditorFn) (*UnlockEnvResponse, error)
+
+	// LockEnvWithBodyWithResponse request with any body
+	LockEnvWithBodyWithResponse(ctx context.Context, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LockEnvResponse, error)
+
+	LockEnvWithResponse(ctx context.Context, envId Id, body LockEnvJSONRequestBody, reqEditors ...RequestEditorFn) (*LockEnvResponse, error)
+
+	// UpWithBodyWithResponse request with any body
+	UpWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpResponse, error)
+
+	// WhoAmIWithResponse request
+	WhoAmIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WhoAmIResponse, error)
+}
+
+type GetAppsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Apps
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAppResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAppResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAppResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *App
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAppResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *App
+	JSON400      *BadRequest
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAppResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAppResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApproveDeploymentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Deployment
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ApproveDeploymentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApproveDeploymentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAutoscalingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Deployment
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAutoscalingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAutoscalingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AbortCanaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Deployment
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r AbortCanaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AbortCanaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PromoteCanaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Deployment
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PromoteCanaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PromoteCanaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelDeploymentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Deployment
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelDeploymentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelDeploymentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCellsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Deployment
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCellsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCellsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCronJobsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CronJobs
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCronJobsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCronJobsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCronJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CronJob
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCronJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCronJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCronJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCronJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCronJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCronJobRunsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CronJobRuns
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCronJobRunsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCronJobRunsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCronJobRunLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LogEntries
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCronJobRunLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCronJobRunLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDependenciesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Deployment
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDependenciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDependenciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeployImageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeployImageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeployImageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDeploymentEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DeploymentEvent
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDeploymentEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDeploymentEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DiffDeploymentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentDiff
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DiffDeploymentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DiffDeploymentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDomainsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Domains
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDomainsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDomainsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDomainResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Domain
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDomainResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDomainResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDomainResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDomainResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDomainResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyDomainResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Domain
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyDomainResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyDomainResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateExternalPortsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Deployment
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateExternalPortsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateExternalPortsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateHealthCheckResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Deployment
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateHealthCheckResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateHealthCheckResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateProcessesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Deployment
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProcessesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProcessesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PromoteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Deployment
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PromoteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PromoteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RejectDeploymentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Deployment
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r RejectDeploymentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RejectDeploymentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateReleaseCommandResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Deployment
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateReleaseCommandResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateReleaseCommandResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestartResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Deployment
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r RestartResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestartResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RollbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Deployment
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r RollbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RollbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ScaleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Deployment
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ScaleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ScaleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateVolumesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Deployment
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateVolumesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateVolumesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCellsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Cells
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCellsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCellsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEnvsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Envs
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEnvsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEnvsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteEnvResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteEnvResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteEnvResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEnvResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Env
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEnvResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEnvResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateEnvResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Env
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateEnvResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateEnvResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateEnvResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Env
+	JSON400      *BadRequest
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateEnvResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateEnvResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSharedEnvVarsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SharedEnvVars
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSharedEnvVarsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSharedEnvVarsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSharedEnvVarsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SharedEnvVars
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSharedEnvVarsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSharedEnvVarsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnlockEnvResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Env
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UnlockEnvResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnlockEnvResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LockEnvResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Env
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r LockEnvResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LockEnvResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WhoAmIResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WhoAmI
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r WhoAmIResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WhoAmIResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAppsWithResponse request returning *GetAppsResponse
+func (c *ClientWithResponses) GetAppsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAppsResponse, error) {
+	rsp, err := c.GetApps(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppsResponse(rsp)
+}
+
+// DeleteAppWithResponse request returning *DeleteAppResponse
+func (c *ClientWithResponses) DeleteAppWithResponse(ctx context.Context, appId Id, params *DeleteAppParams, reqEditors ...RequestEditorFn) (*DeleteAppResponse, error) {
+	rsp, err := c.DeleteApp(ctx, appId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAppResponse(rsp)
+}
+
+// GetAppWithResponse request returning *GetAppResponse
+func (c *ClientWithResponses) GetAppWithResponse(ctx context.Context, appId Id, reqEditors ...RequestEditorFn) (*GetAppResponse, error) {
+	rsp, err := c.GetApp(ctx, appId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppResponse(rsp)
+}
+
+// CreateAppWithBodyWithResponse request with arbitrary body returning *CreateAppResponse
+func (c *ClientWithResponses) CreateAppWithBodyWithResponse(ctx context.Context, appId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppResponse, error) {
+	rsp, err := c.CreateAppWithBody(ctx, appId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAppResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateAppWithResponse(ctx context.Context, appId Id, body CreateAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppResponse, error) {
+	rsp, err := c.CreateApp(ctx, appId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAppResponse(rsp)
+}
+
+// ApproveDeploymentWithBodyWithResponse request with arbitrary body returning *ApproveDeploymentResponse
+func (c *ClientWithResponses) ApproveDeploymentWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveDeploymentResponse, error) {
+	rsp, err := c.ApproveDeploymentWithBody(ctx, appId, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveDeploymentResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApproveDeploymentWithResponse(ctx context.Context, appId Id, envId Id, body ApproveDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveDeploymentResponse, error) {
+	rsp, err := c.ApproveDeployment(ctx, appId, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveDeploymentResponse(rsp)
+}
+
+// UpdateAutoscalingWithBodyWithResponse request with arbitrary body returning *UpdateAutoscalingResponse
+func (c *ClientWithResponses) UpdateAutoscalingWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAutoscalingResponse, error) {
+	rsp, err := c.UpdateAutoscalingWithBody(ctx, appId, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAutoscalingResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAutoscalingWithResponse(ctx context.Context, appId Id, envId Id, body UpdateAutoscalingJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAutoscalingResponse, error) {
+	rsp, err := c.UpdateAutoscaling(ctx, appId, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAutoscalingResponse(rsp)
+}
+
+// AbortCanaryWithResponse request returning *AbortCanaryResponse
+func (c *ClientWithResponses) AbortCanaryWithResponse(ctx context.Context, appId Id, envId Id, reqEditors ...RequestEditorFn) (*AbortCanaryResponse, error) {
+	rsp, err := c.AbortCanary(ctx, appId, envId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAbortCanaryResponse(rsp)
+}
+
+// PromoteCanaryWithBodyWithResponse request with arbitrary body returning *PromoteCanaryResponse
+func (c *ClientWithResponses) PromoteCanaryWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PromoteCanaryResponse, error) {
+	rsp, err := c.PromoteCanaryWithBody(ctx, appId, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePromoteCanaryResponse(rsp)
+}
+
+func (c *ClientWithResponses) PromoteCanaryWithResponse(ctx context.Context, appId Id, envId Id, body PromoteCanaryJSONRequestBody, reqEditors ...RequestEditorFn) (*PromoteCanaryResponse, error) {
+	rsp, err := c.PromoteCanary(ctx, appId, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePromoteCanaryResponse(rsp)
+}
+
+// CancelDeploymentWithBodyWithResponse request with arbitrary body returning *CancelDeploymentResponse
+func (c *ClientWithResponses) CancelDeploymentWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CancelDeploymentResponse, error) {
+	rsp, err := c.CancelDeploymentWithBody(ctx, appId, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelDeploymentResponse(rsp)
+}
+
+func (c *ClientWithResponses) CancelDeploymentWithResponse(ctx context.Context, appId Id, envId Id, body CancelDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*CancelDeploymentResponse, error) {
+	rsp, err := c.CancelDeployment(ctx, appId, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelDeploymentResponse(rsp)
+}
+
+// UpdateCellsWithBodyWithResponse request with arbitrary body returning *UpdateCellsResponse
+func (c *ClientWithResponses) UpdateCellsWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCellsResponse, error) {
+	rsp, err := c.UpdateCellsWithBody(ctx, appId, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCellsResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCellsWithResponse(ctx context.Context, appId Id, envId Id, body UpdateCellsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCellsResponse, error) {
+	rsp, err := c.UpdateCells(ctx, appId, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCellsResponse(rsp)
+}
+
+// GetCronJobsWithResponse request returning *GetCronJobsResponse
+func (c *ClientWithResponses) GetCronJobsWithResponse(ctx context.Context, appId Id, envId Id, reqEditors ...RequestEditorFn) (*GetCronJobsResponse, error) {
+	rsp, err := c.GetCronJobs(ctx, appId, envId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCronJobsResponse(rsp)
+}
+
+// CreateCronJobWithBodyWithResponse request with arbitrary body returning *CreateCronJobResponse
+func (c *ClientWithResponses) CreateCronJobWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCronJobResponse, error) {
+	rsp, err := c.CreateCronJobWithBody(ctx, appId, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCronJobResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCronJobWithResponse(ctx context.Context, appId Id, envId Id, body CreateCronJobJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCronJobResponse, error) {
+	rsp, err := c.CreateCronJob(ctx, appId, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCronJobResponse(rsp)
+}
+
+// DeleteCronJobWithResponse request returning *DeleteCronJobResponse
+func (c *ClientWithResponses) DeleteCronJobWithResponse(ctx context.Context, appId Id, envId Id, name LowercaseAlphaNumHyphen, reqEditors ...RequestEditorFn) (*DeleteCronJobResponse, error) {
+	rsp, err := c.DeleteCronJob(ctx, appId, envId, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCronJobResponse(rsp)
+}
+
+// GetCronJobRunsWithResponse request returning *GetCronJobRunsResponse
+func (c *ClientWithResponses) GetCronJobRunsWithResponse(ctx context.Context, appId Id, envId Id, name LowercaseAlphaNumHyphen, reqEditors ...RequestEditorFn) (*GetCronJobRunsResponse, error) {
+	rsp, err := c.GetCronJobRuns(ctx, appId, envId, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCronJobRunsResponse(rsp)
+}
+
+// GetCronJobRunLogsWithResponse request returning *GetCronJobRunLogsResponse
+func (c *ClientWithResponses) GetCronJobRunLogsWithResponse(ctx context.Context, appId Id, envId Id, name LowercaseAlphaNumHyphen, runName string, reqEditors ...RequestEditorFn) (*GetCronJobRunLogsResponse, error) {
+	rsp, err := c.GetCronJobRunLogs(ctx, appId, envId, name, runName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCronJobRunLogsResponse(rsp)
+}
+
+// UpdateDependenciesWithBodyWithResponse request with arbitrary body returning *UpdateDependenciesResponse
+func (c *ClientWithResponses) UpdateDependenciesWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDependenciesResponse, error) {
+	rsp, err := c.UpdateDependenciesWithBody(ctx, appId, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDependenciesResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDependenciesWithResponse(ctx context.Context, appId Id, envId Id, body UpdateDependenciesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDependenciesResponse, error) {
+	rsp, err := c.UpdateDependencies(ctx, appId, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDependenciesResponse(rsp)
+}
+
+// DeployImageWithBodyWithResponse request with arbitrary body returning *DeployImageResponse
+func (c *ClientWithResponses) DeployImageWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeployImageResponse, error) {
+	rsp, err := c.DeployImageWithBody(ctx, appId, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeployImageResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeployImageWithResponse(ctx context.Context, appId Id, envId Id, body DeployImageJSONRequestBody, reqEditors ...RequestEditorFn) (*DeployImageResponse, error) {
+	rsp, err := c.DeployImage(ctx, appId, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeployImageResponse(rsp)
+}
+
+// GetDeploymentEventsWithResponse request returning *GetDeploymentEventsResponse
+func (c *ClientWithResponses) GetDeploymentEventsWithResponse(ctx context.Context, appId Id, envId Id, deploymentId int, reqEditors ...RequestEditorFn) (*GetDeploymentEventsResponse, error) {
+	rsp, err := c.GetDeploymentEvents(ctx, appId, envId, deploymentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeploymentEventsResponse(rsp)
+}
+
+// DiffDeploymentWithBodyWithResponse request with arbitrary body returning *DiffDeploymentResponse
+func (c *ClientWithResponses) DiffDeploymentWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DiffDeploymentResponse, error) {
+	rsp, err := c.DiffDeploymentWithBody(ctx, appId, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDiffDeploymentResponse(rsp)
+}
+
+func (c *ClientWithResponses) DiffDeploymentWithResponse(ctx context.Context, appId Id, envId Id, body DiffDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*DiffDeploymentResponse, error) {
+	rsp, err := c.DiffDeployment(ctx, appId, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDiffDeploymentResponse(rsp)
+}
+
+// GetDomainsWithResponse request returning *GetDomainsResponse
+func (c *ClientWithResponses) GetDomainsWithResponse(ctx context.Context, appId Id, envId Id, reqEditors ...RequestEditorFn) (*GetDomainsResponse, error) {
+	rsp, err := c.GetDomains(ctx, appId, envId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDomainsResponse(rsp)
+}
+
+// CreateDomainWithBodyWithResponse request with arbitrary body returning *CreateDomainResponse
+func (c *ClientWithResponses) CreateDomainWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDomainResponse, error) {
+	rsp, err := c.CreateDomainWithBody(ctx, appId, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDomainResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDomainWithResponse(ctx context.Context, appId Id, envId Id, body CreateDomainJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDomainResponse, error) {
+	rsp, err := c.CreateDomain(ctx, appId, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDomainResponse(rsp)
+}
+
+// DeleteDomainWithResponse request returning *DeleteDomainResponse
+func (c *ClientWithResponses) DeleteDomainWithResponse(ctx context.Context, appId Id, envId Id, hostname string, reqEditors ...RequestEditorFn) (*DeleteDomainResponse, error) {
+	rsp, err := c.DeleteDomain(ctx, appId, envId, hostname, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDomainResponse(rsp)
+}
+
+// VerifyDomainWithResponse request returning *VerifyDomainResponse
+func (c *ClientWithResponses) VerifyDomainWithResponse(ctx context.Context, appId Id, envId Id, hostname string, reqEditors ...RequestEditorFn) (*VerifyDomainResponse, error) {
+	rsp, err := c.VerifyDomain(ctx, appId, envId, hostname, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyDomainResponse(rsp)
+}
+
+// UpdateExternalPortsWithBodyWithResponse request with arbitrary body returning *UpdateExternalPortsResponse
+func (c *ClientWithResponses) UpdateExternalPortsWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateExternalPortsResponse, error) {
+	rsp, err := c.UpdateExternalPortsWithBody(ctx, appId, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateExternalPortsResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateExternalPortsWithResponse(ctx context.Context, appId Id, envId Id, body UpdateExternalPortsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateExternalPortsResponse, error) {
+	rsp, err := c.UpdateExternalPorts(ctx, appId, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateExternalPortsResponse(rsp)
+}
+
+// UpdateHealthCheckWithBodyWithResponse request with arbitrary body returning *UpdateHealthCheckResponse
+func (c *ClientWithResponses) UpdateHealthCheckWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateHealthCheckResponse, error) {
+	rsp, err := c.UpdateHealthCheckWithBody(ctx, appId, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateHealthCheckResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateHealthCheckWithResponse(ctx context.Context, appId Id, envId Id, body UpdateHealthCheckJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateHealthCheckResponse, error) {
+	rsp, err := c.UpdateHealthCheck(ctx, appId, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateHealthCheckResponse(rsp)
+}
+
+// UpdateProcessesWithBodyWithResponse request with arbitrary body returning *UpdateProcessesResponse
+func (c *ClientWithResponses) UpdateProcessesWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProcessesResponse, error) {
+	rsp, err := c.UpdateProcessesWithBody(ctx, appId, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProcessesResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProcessesWithResponse(ctx context.Context, appId Id, envId Id, body UpdateProcessesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProcessesResponse, error) {
+	rsp, err := c.UpdateProcesses(ctx, appId, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProcessesResponse(rsp)
+}
+
+// PromoteWithBodyWithResponse request with arbitrary body returning *PromoteResponse
+func (c *ClientWithResponses) PromoteWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PromoteResponse, error) {
+	rsp, err := c.PromoteWithBody(ctx, appId, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePromoteResponse(rsp)
+}
+
+func (c *ClientWithResponses) PromoteWithResponse(ctx context.Context, appId Id, envId Id, body PromoteJSONRequestBody, reqEditors ...RequestEditorFn) (*PromoteResponse, error) {
+	rsp, err := c.Promote(ctx, appId, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePromoteResponse(rsp)
+}
+
+// RejectDeploymentWithBodyWithResponse request with arbitrary body returning *RejectDeploymentResponse
+func (c *ClientWithResponses) RejectDeploymentWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RejectDeploymentResponse, error) {
+	rsp, err := c.RejectDeploymentWithBody(ctx, appId, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRejectDeploymentResponse(rsp)
+}
+
+func (c *ClientWithResponses) RejectDeploymentWithResponse(ctx context.Context, appId Id, envId Id, body RejectDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*RejectDeploymentResponse, error) {
+	rsp, err := c.RejectDeployment(ctx, appId, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRejectDeploymentResponse(rsp)
+}
+
+// UpdateReleaseCommandWithBodyWithResponse request with arbitrary body returning *UpdateReleaseCommandResponse
+func (c *ClientWithResponses) UpdateReleaseCommandWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateReleaseCommandResponse, error) {
+	rsp, err := c.UpdateReleaseCommandWithBody(ctx, appId, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateReleaseCommandResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateReleaseCommandWithResponse(ctx context.Context, appId Id, envId Id, body UpdateReleaseCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateReleaseCommandResponse, error) {
+	rsp, err := c.UpdateReleaseCommand(ctx, appId, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateReleaseCommandResponse(rsp)
+}
+
+// RestartWithResponse request returning *RestartResponse
+func (c *ClientWithResponses) RestartWithResponse(ctx context.Context, appId Id, envId Id, reqEditors ...RequestEditorFn) (*RestartResponse, error) {
+	rsp, err := c.Restart(ctx, appId, envId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestartResponse(rsp)
+}
+
+// RollbackWithBodyWithResponse request with arbitrary body returning *RollbackResponse
+func (c *ClientWithResponses) RollbackWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RollbackResponse, error) {
+	rsp, err := c.RollbackWithBody(ctx, appId, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRollbackResponse(rsp)
+}
+
+func (c *ClientWithResponses) RollbackWithResponse(ctx context.Context, appId Id, envId Id, body RollbackJSONRequestBody, reqEditors ...RequestEditorFn) (*RollbackResponse, error) {
+	rsp, err := c.Rollback(ctx, appId, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRollbackResponse(rsp)
+}
+
+// ScaleWithBodyWithResponse request with arbitrary body returning *ScaleResponse
+func (c *ClientWithResponses) ScaleWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ScaleResponse, error) {
+	rsp, err := c.ScaleWithBody(ctx, appId, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseScaleResponse(rsp)
+}
+
+func (c *ClientWithResponses) ScaleWithResponse(ctx context.Context, appId Id, envId Id, body ScaleJSONRequestBody, reqEditors ...RequestEditorFn) (*ScaleResponse, error) {
+	rsp, err := c.Scale(ctx, appId, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseScaleResponse(rsp)
+}
+
+// UpdateVolumesWithBodyWithResponse request with arbitrary body returning *UpdateVolumesResponse
+func (c *ClientWithResponses) UpdateVolumesWithBodyWithResponse(ctx context.Context, appId Id, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateVolumesResponse, error) {
+	rsp, err := c.UpdateVolumesWithBody(ctx, appId, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateVolumesResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateVolumesWithResponse(ctx context.Context, appId Id, envId Id, body UpdateVolumesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateVolumesResponse, error) {
+	rsp, err := c.UpdateVolumes(ctx, appId, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateVolumesResponse(rsp)
+}
+
+// GetCellsWithResponse request returning *GetCellsResponse
+func (c *ClientWithResponses) GetCellsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCellsResponse, error) {
+	rsp, err := c.GetCells(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCellsResponse(rsp)
+}
+
+// GetEnvsWithResponse request returning *GetEnvsResponse
+func (c *ClientWithResponses) GetEnvsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEnvsResponse, error) {
+	rsp, err := c.GetEnvs(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEnvsResponse(rsp)
+}
+
+// DeleteEnvWithResponse request returning *DeleteEnvResponse
+func (c *ClientWithResponses) DeleteEnvWithResponse(ctx context.Context, envId Id, reqEditors ...RequestEditorFn) (*DeleteEnvResponse, error) {
+	rsp, err := c.DeleteEnv(ctx, envId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteEnvResponse(rsp)
+}
+
+// GetEnvWithResponse request returning *GetEnvResponse
+func (c *ClientWithResponses) GetEnvWithResponse(ctx context.Context, envId Id, reqEditors ...RequestEditorFn) (*GetEnvResponse, error) {
+	rsp, err := c.GetEnv(ctx, envId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEnvResponse(rsp)
+}
+
+// UpdateEnvWithBodyWithResponse request with arbitrary body returning *UpdateEnvResponse
+func (c *ClientWithResponses) UpdateEnvWithBodyWithResponse(ctx context.Context, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEnvResponse, error) {
+	rsp, err := c.UpdateEnvWithBody(ctx, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateEnvResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateEnvWithResponse(ctx context.Context, envId Id, body UpdateEnvJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEnvResponse, error) {
+	rsp, err := c.UpdateEnv(ctx, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateEnvResponse(rsp)
+}
+
+// CreateEnvWithBodyWithResponse request with arbitrary body returning *CreateEnvResponse
+func (c *ClientWithResponses) CreateEnvWithBodyWithResponse(ctx context.Context, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnvResponse, error) {
+	rsp, err := c.CreateEnvWithBody(ctx, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEnvResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateEnvWithResponse(ctx context.Context, envId Id, body CreateEnvJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnvResponse, error) {
+	rsp, err := c.CreateEnv(ctx, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEnvResponse(rsp)
+}
+
+// GetSharedEnvVarsWithResponse request returning *GetSharedEnvVarsResponse
+func (c *ClientWithResponses) GetSharedEnvVarsWithResponse(ctx context.Context, envId Id, reqEditors ...RequestEditorFn) (*GetSharedEnvVarsResponse, error) {
+	rsp, err := c.GetSharedEnvVars(ctx, envId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSharedEnvVarsResponse(rsp)
+}
+
+// UpdateSharedEnvVarsWithBodyWithResponse request with arbitrary body returning *UpdateSharedEnvVarsResponse
+func (c *ClientWithResponses) UpdateSharedEnvVarsWithBodyWithResponse(ctx context.Context, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSharedEnvVarsResponse, error) {
+	rsp, err := c.UpdateSharedEnvVarsWithBody(ctx, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSharedEnvVarsResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSharedEnvVarsWithResponse(ctx context.Context, envId Id, body UpdateSharedEnvVarsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSharedEnvVarsResponse, error) {
+	rsp, err := c.UpdateSharedEnvVars(ctx, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSharedEnvVarsResponse(rsp)
+}
+
+// UnlockEnvWithResponse request returning *UnlockEnvResponse
+func (c *ClientWithResponses) UnlockEnvWithResponse(ctx context.Context, envId Id, reqEditors ...RequestEditorFn) (*UnlockEnvResponse, error) {
+	rsp, err := c.UnlockEnv(ctx, envId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnlockEnvResponse(rsp)
+}
+
+// LockEnvWithBodyWithResponse request with arbitrary body returning *LockEnvResponse
+func (c *ClientWithResponses) LockEnvWithBodyWithResponse(ctx context.Context, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LockEnvResponse, error) {
+	rsp, err := c.LockEnvWithBody(ctx, envId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLockEnvResponse(rsp)
+}
+
+func (c *ClientWithResponses) LockEnvWithResponse(ctx context.Context, envId Id, body LockEnvJSONRequestBody, reqEditors ...RequestEditorFn) (*LockEnvResponse, error) {
+	rsp, err := c.LockEnv(ctx, envId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLockEnvResponse(rsp)
+}
+
+// UpWithBodyWithResponse request with arbitrary body returning *UpResponse
+func (c *ClientWithResponses) UpWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpResponse, error) {
+	rsp, err := c.UpWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpResponse(rsp)
+}
+
+// WhoAmIWithResponse request returning *WhoAmIResponse
+func (c *ClientWithResponses) WhoAmIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WhoAmIResponse, error) {
+	rsp, err := c.WhoAmI(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWhoAmIResponse(rsp)
+}
+
+// ParseGetAppsResponse parses an HTTP response from a GetAppsWithResponse call
+func ParseGetAppsResponse(rsp *http.Response) (*GetAppsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAppsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Apps
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAppResponse parses an HTTP response from a DeleteAppWithResponse call
+func ParseDeleteAppResponse(rsp *http.Response) (*DeleteAppResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAppResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAppResponse parses an HTTP response from a GetAppWithResponse call
+func ParseGetAppResponse(rsp *http.Response) (*GetAppResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAppResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest App
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateAppResponse parses an HTTP response from a CreateAppWithResponse call
+func ParseCreateAppResponse(rsp *http.Response) (*CreateAppResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateAppResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest App
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApproveDeploymentResponse parses an HTTP response from a ApproveDeploymentWithResponse call
+func ParseApproveDeploymentResponse(rsp *http.Response) (*ApproveDeploymentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApproveDeploymentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Deployment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateAutoscalingResponse parses an HTTP response from a UpdateAutoscalingWithResponse call
+func ParseUpdateAutoscalingResponse(rsp *http.Response) (*UpdateAutoscalingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateAutoscalingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Deployment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAbortCanaryResponse parses an HTTP response from a AbortCanaryWithResponse call
+func ParseAbortCanaryResponse(rsp *http.Response) (*AbortCanaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AbortCanaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Deployment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePromoteCanaryResponse parses an HTTP response from a PromoteCanaryWithResponse call
+func ParsePromoteCanaryResponse(rsp *http.Response) (*PromoteCanaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PromoteCanaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Deployment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelDeploymentResponse parses an HTTP response from a CancelDeploymentWithResponse call
+func ParseCancelDeploymentResponse(rsp *http.Response) (*CancelDeploymentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelDeploymentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Deployment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateCellsResponse parses an HTTP response from a UpdateCellsWithResponse call
+func ParseUpdateCellsResponse(rsp *http.Response) (*UpdateCellsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateCellsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Deployment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCronJobsResponse parses an HTTP response from a GetCronJobsWithResponse call
+func ParseGetCronJobsResponse(rsp *http.Response) (*GetCronJobsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCronJobsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CronJobs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateCronJobResponse parses an HTTP response from a CreateCronJobWithResponse call
+func ParseCreateCronJobResponse(rsp *http.Response) (*CreateCronJobResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateCronJobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CronJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCronJobResponse parses an HTTP response from a DeleteCronJobWithResponse call
+func ParseDeleteCronJobResponse(rsp *http.Response) (*DeleteCronJobResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCronJobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCronJobRunsResponse parses an HTTP response from a GetCronJobRunsWithResponse call
+func ParseGetCronJobRunsResponse(rsp *http.Response) (*GetCronJobRunsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCronJobRunsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CronJobRuns
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCronJobRunLogsResponse parses an HTTP response from a GetCronJobRunLogsWithResponse call
+func ParseGetCronJobRunLogsResponse(rsp *http.Response) (*GetCronJobRunLogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCronJobRunLogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LogEntries
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateDependenciesResponse parses an HTTP response from a UpdateDependenciesWithResponse call
+func ParseUpdateDependenciesResponse(rsp *http.Response) (*UpdateDependenciesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateDependenciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Deployment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeployImageResponse parses an HTTP response from a DeployImageWithResponse call
+func ParseDeployImageResponse(rsp *http.Response) (*DeployImageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeployImageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDeploymentEventsResponse parses an HTTP response from a GetDeploymentEventsWithResponse call
+func ParseGetDeploymentEventsResponse(rsp *http.Response) (*GetDeploymentEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDeploymentEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DeploymentEvent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDiffDeploymentResponse parses an HTTP response from a DiffDeploymentWithResponse call
+func ParseDiffDeploymentResponse(rsp *http.Response) (*DiffDeploymentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DiffDeploymentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentDiff
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDomainsResponse parses an HTTP response from a GetDomainsWithResponse call
+func ParseGetDomainsResponse(rsp *http.Response) (*GetDomainsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDomainsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Domains
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateDomainResponse parses an HTTP response from a CreateDomainWithResponse call
+func ParseCreateDomainResponse(rsp *http.Response) (*CreateDomainResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDomainResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Domain
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteDomainResponse parses an HTTP response from a DeleteDomainWithResponse call
+func ParseDeleteDomainResponse(rsp *http.Response) (*DeleteDomainResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteDomainResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifyDomainResponse parses an HTTP response from a VerifyDomainWithResponse call
+func ParseVerifyDomainResponse(rsp *http.Response) (*VerifyDomainResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyDomainResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Domain
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateExternalPortsResponse parses an HTTP response from a UpdateExternalPortsWithResponse call
+func ParseUpdateExternalPortsResponse(rsp *http.Response) (*UpdateExternalPortsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateExternalPortsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Deployment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateHealthCheckResponse parses an HTTP response from a UpdateHealthCheckWithResponse call
+func ParseUpdateHealthCheckResponse(rsp *http.Response) (*UpdateHealthCheckResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateHealthCheckResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Deployment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateProcessesResponse parses an HTTP response from a UpdateProcessesWithResponse call
+func ParseUpdateProcessesResponse(rsp *http.Response) (*UpdateProcessesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateProcessesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Deployment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePromoteResponse parses an HTTP response from a PromoteWithResponse call
+func ParsePromoteResponse(rsp *http.Response) (*PromoteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PromoteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Deployment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRejectDeploymentResponse parses an HTTP response from a RejectDeploymentWithResponse call
+func ParseRejectDeploymentResponse(rsp *http.Response) (*RejectDeploymentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RejectDeploymentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Deployment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateReleaseCommandResponse parses an HTTP response from a UpdateReleaseCommandWithResponse call
+func ParseUpdateReleaseCommandResponse(rsp *http.Response) (*UpdateReleaseCommandResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateReleaseCommandResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Deployment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestartResponse parses an HTTP response from a RestartWithResponse call
+func ParseRestartResponse(rsp *http.Response) (*RestartResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Deployment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRollbackResponse parses an HTTP response from a RollbackWithResponse call
+func ParseRollbackResponse(rsp *http.Response) (*RollbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RollbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Deployment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseScaleResponse parses an HTTP response from a ScaleWithResponse call
+func ParseScaleResponse(rsp *http.Response) (*ScaleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ScaleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Deployment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateVolumesResponse parses an HTTP response from a UpdateVolumesWithResponse call
+func ParseUpdateVolumesResponse(rsp *http.Response) (*UpdateVolumesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateVolumesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Deployment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCellsResponse parses an HTTP response from a GetCellsWithResponse call
+func ParseGetCellsResponse(rsp *http.Response) (*GetCellsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCellsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Cells
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEnvsResponse parses an HTTP response from a GetEnvsWithResponse call
+func ParseGetEnvsResponse(rsp *http.Response) (*GetEnvsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEnvsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Envs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteEnvResponse parses an HTTP response from a DeleteEnvWithResponse call
+func ParseDeleteEnvResponse(rsp *http.Response) (*DeleteEnvResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteEnvResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEnvResponse parses an HTTP response from a GetEnvWithResponse call
+func ParseGetEnvResponse(rsp *http.Response) (*GetEnvResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEnvResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Env
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateEnvResponse parses an HTTP response from a UpdateEnvWithResponse call
+func ParseUpdateEnvResponse(rsp *http.Response) (*UpdateEnvResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateEnvResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Env
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateEnvResponse parses an HTTP response from a CreateEnvWithResponse call
+func ParseCreateEnvResponse(rsp *http.Response) (*CreateEnvResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateEnvResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Env
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSharedEnvVarsResponse parses an HTTP response from a GetSharedEnvVarsWithResponse call
+func ParseGetSharedEnvVarsResponse(rsp *http.Response) (*GetSharedEnvVarsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSharedEnvVarsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SharedEnvVars
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateSharedEnvVarsResponse parses an HTTP response from a UpdateSharedEnvVarsWithResponse call
+func ParseUpdateSharedEnvVarsResponse(rsp *http.Response) (*UpdateSharedEnvVarsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateSharedEnvVarsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SharedEnvVars
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUnlockEnvResponse parses an HTTP response from a UnlockEnvWithResponse call
+func ParseUnlockEnvResponse(rsp *http.Response) (*UnlockEnvResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UnlockEnvResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Env
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLockEnvResponse parses an HTTP response from a LockEnvWithResponse call
+func ParseLockEnvResponse(rsp *http.Response) (*LockEnvResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LockEnvResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Env
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpResponse parses an HTTP response from a UpWithResponse call
+func ParseUpResponse(rsp *http.Response) (*UpResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWhoAmIResponse parses an HTTP response from a WhoAmIWithResponse call
+func ParseWhoAmIResponse(rsp *http.Response) (*WhoAmIResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WhoAmIResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WhoAmI
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /api/apps)
+	GetApps(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /api/apps/{appId})
+	DeleteApp(w http.ResponseWriter, r *http.Request, appId Id, params DeleteAppParams)
+
+	// (GET /api/apps/{appId})
+	GetApp(w http.ResponseWriter, r *http.Request, appId Id)
+
+	// (PUT /api/apps/{appId})
+	CreateApp(w http.ResponseWriter, r *http.Request, appId Id)
+
+	// (POST /api/apps/{appId}/envs/{envId}/approve)
+	ApproveDeployment(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (PUT /api/apps/{appId}/envs/{envId}/autoscaling)
+	UpdateAutoscaling(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (POST /api/apps/{appId}/envs/{envId}/canary/abort)
+	AbortCanary(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (POST /api/apps/{appId}/envs/{envId}/canary/promote)
+	PromoteCanary(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (POST /api/apps/{appId}/envs/{envId}/cancel)
+	CancelDeployment(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (PUT /api/apps/{appId}/envs/{envId}/cells)
+	UpdateCells(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (GET /api/apps/{appId}/envs/{envId}/cron-jobs)
+	GetCronJobs(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (POST /api/apps/{appId}/envs/{envId}/cron-jobs)
+	CreateCronJob(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (DELETE /api/apps/{appId}/envs/{envId}/cron-jobs/{name})
+	DeleteCronJob(w http.ResponseWriter, r *http.Request, appId Id, envId Id, name LowercaseAlphaNumHyphen)
+
+	// (GET /api/apps/{appId}/envs/{envId}/cron-jobs/{name}/runs)
+	GetCronJobRuns(w http.ResponseWriter, r *http.Request, appId Id, envId Id, name LowercaseAlphaNumHyphen)
+
+	// (GET /api/apps/{appId}/envs/{envId}/cron-jobs/{name}/runs/{runName}/logs)
+	GetCronJobRunLogs(w http.ResponseWriter, r *http.Request, appId Id, envId Id, name LowercaseAlphaNumHyphen, runName string)
+
+	// (PUT /api/apps/{appId}/envs/{envId}/dependencies)
+	UpdateDependencies(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (POST /api/apps/{appId}/envs/{envId}/deploy)
+	DeployImage(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (GET /api/apps/{appId}/envs/{envId}/deployments/{deploymentId}/events)
+	GetDeploymentEvents(w http.ResponseWriter, r *http.Request, appId Id, envId Id, deploymentId int)
+
+	// (POST /api/apps/{appId}/envs/{envId}/diff)
+	DiffDeployment(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (GET /api/apps/{appId}/envs/{envId}/domains)
+	GetDomains(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (POST /api/apps/{appId}/envs/{envId}/domains)
+	CreateDomain(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (DELETE /api/apps/{appId}/envs/{envId}/domains/{hostname})
+	DeleteDomain(w http.ResponseWriter, r *http.Request, appId Id, envId Id, hostname string)
+
+	// (POST /api/apps/{appId}/envs/{envId}/domains/{hostname}/verify)
+	VerifyDomain(w http.ResponseWriter, r *http.Request, appId Id, envId Id, hostname string)
+
+	// (PUT /api/apps/{appId}/envs/{envId}/external-ports)
+	UpdateExternalPorts(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (PUT /api/apps/{appId}/envs/{envId}/health-check)
+	UpdateHealthCheck(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (PUT /api/apps/{appId}/envs/{envId}/processes)
+	UpdateProcesses(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (POST /api/apps/{appId}/envs/{envId}/promote)
+	Promote(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (POST /api/apps/{appId}/envs/{envId}/reject)
+	RejectDeployment(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (PUT /api/apps/{appId}/envs/{envId}/release-command)
+	UpdateReleaseCommand(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (POST /api/apps/{appId}/envs/{envId}/restart)
+	Restart(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (POST /api/apps/{appId}/envs/{envId}/rollback)
+	Rollback(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (POST /api/apps/{appId}/envs/{envId}/scale)
+	Scale(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (PUT /api/apps/{appId}/envs/{envId}/volumes)
+	UpdateVolumes(w http.ResponseWriter, r *http.Request, appId Id, envId Id)
+
+	// (GET /api/cells)
+	GetCells(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/envs)
+	GetEnvs(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /api/envs/{envId})
+	DeleteEnv(w http.ResponseWriter, r *http.Request, envId Id)
+
+	// (GET /api/envs/{envId})
+	GetEnv(w http.ResponseWriter, r *http.Request, envId Id)
+
+	// (PATCH /api/envs/{envId})
+	UpdateEnv(w http.ResponseWriter, r *http.Request, envId Id)
+
+	// (PUT /api/envs/{envId})
+	CreateEnv(w http.ResponseWriter, r *http.Request, envId Id)
+
+	// (GET /api/envs/{envId}/env-vars)
+	GetSharedEnvVars(w http.ResponseWriter, r *http.Request, envId Id)
+
+	// (PATCH /api/envs/{envId}/env-vars)
+	UpdateSharedEnvVars(w http.ResponseWriter, r *http.Request, envId Id)
+
+	// (DELETE /api/envs/{envId}/lock)
+	UnlockEnv(w http.ResponseWriter, r *http.Request, envId Id)
+
+	// (POST /api/envs/{envId}/lock)
+	LockEnv(w http.ResponseWriter, r *http.Request, envId Id)
+
+	// (POST /api/up)
+	Up(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/whoami)
+	WhoAmI(w http.ResponseWriter, r *http.Request)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (GET /api/apps)
+func (_ Unimplemented) GetApps(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /api/apps/{appId})
+func (_ Unimplemented) DeleteApp(w http.ResponseWriter, r *http.Request, appId Id, params DeleteAppParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/apps/{appId})
+func (_ Unimplemented) GetApp(w http.ResponseWriter, r *http.Request, appId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /api/apps/{appId})
+func (_ Unimplemented) CreateApp(w http.ResponseWriter, r *http.Request, appId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/apps/{appId}/envs/{envId}/approve)
+func (_ Unimplemented) ApproveDeployment(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /api/apps/{appId}/envs/{envId}/autoscaling)
+func (_ Unimplemented) UpdateAutoscaling(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/apps/{appId}/envs/{envId}/canary/abort)
+func (_ Unimplemented) AbortCanary(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/apps/{appId}/envs/{envId}/canary/promote)
+func (_ Unimplemented) PromoteCanary(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/apps/{appId}/envs/{envId}/cancel)
+func (_ Unimplemented) CancelDeployment(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /api/apps/{appId}/envs/{envId}/cells)
+func (_ Unimplemented) UpdateCells(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/apps/{appId}/envs/{envId}/cron-jobs)
+func (_ Unimplemented) GetCronJobs(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/apps/{appId}/envs/{envId}/cron-jobs)
+func (_ Unimplemented) CreateCronJob(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /api/apps/{appId}/envs/{envId}/cron-jobs/{name})
+func (_ Unimplemented) DeleteCronJob(w http.ResponseWriter, r *http.Request, appId Id, envId Id, name LowercaseAlphaNumHyphen) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/apps/{appId}/envs/{envId}/cron-jobs/{name}/runs)
+func (_ Unimplemented) GetCronJobRuns(w http.ResponseWriter, r *http.Request, appId Id, envId Id, name LowercaseAlphaNumHyphen) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/apps/{appId}/envs/{envId}/cron-jobs/{name}/runs/{runName}/logs)
+func (_ Unimplemented) GetCronJobRunLogs(w http.ResponseWriter, r *http.Request, appId Id, envId Id, name LowercaseAlphaNumHyphen, runName string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /api/apps/{appId}/envs/{envId}/dependencies)
+func (_ Unimplemented) UpdateDependencies(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/apps/{appId}/envs/{envId}/deploy)
+func (_ Unimplemented) DeployImage(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/apps/{appId}/envs/{envId}/deployments/{deploymentId}/events)
+func (_ Unimplemented) GetDeploymentEvents(w http.ResponseWriter, r *http.Request, appId Id, envId Id, deploymentId int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/apps/{appId}/envs/{envId}/diff)
+func (_ Unimplemented) DiffDeployment(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/apps/{appId}/envs/{envId}/domains)
+func (_ Unimplemented) GetDomains(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/apps/{appId}/envs/{envId}/domains)
+func (_ Unimplemented) CreateDomain(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /api/apps/{appId}/envs/{envId}/domains/{hostname})
+func (_ Unimplemented) DeleteDomain(w http.ResponseWriter, r *http.Request, appId Id, envId Id, hostname string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/apps/{appId}/envs/{envId}/domains/{hostname}/verify)
+func (_ Unimplemented) VerifyDomain(w http.ResponseWriter, r *http.Request, appId Id, envId Id, hostname string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /api/apps/{appId}/envs/{envId}/external-ports)
+func (_ Unimplemented) UpdateExternalPorts(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /api/apps/{appId}/envs/{envId}/health-check)
+func (_ Unimplemented) UpdateHealthCheck(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /api/apps/{appId}/envs/{envId}/processes)
+func (_ Unimplemented) UpdateProcesses(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/apps/{appId}/envs/{envId}/promote)
+func (_ Unimplemented) Promote(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/apps/{appId}/envs/{envId}/reject)
+func (_ Unimplemented) RejectDeployment(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /api/apps/{appId}/envs/{envId}/release-command)
+func (_ Unimplemented) UpdateReleaseCommand(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/apps/{appId}/envs/{envId}/restart)
+func (_ Unimplemented) Restart(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/apps/{appId}/envs/{envId}/rollback)
+func (_ Unimplemented) Rollback(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/apps/{appId}/envs/{envId}/scale)
+func (_ Unimplemented) Scale(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /api/apps/{appId}/envs/{envId}/volumes)
+func (_ Unimplemented) UpdateVolumes(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/cells)
+func (_ Unimplemented) GetCells(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/envs)
+func (_ Unimplemented) GetEnvs(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /api/envs/{envId})
+func (_ Unimplemented) DeleteEnv(w http.ResponseWriter, r *http.Request, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/envs/{envId})
+func (_ Unimplemented) GetEnv(w http.ResponseWriter, r *http.Request, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PATCH /api/envs/{envId})
+func (_ Unimplemented) UpdateEnv(w http.ResponseWriter, r *http.Request, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /api/envs/{envId})
+func (_ Unimplemented) CreateEnv(w http.ResponseWriter, r *http.Request, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/envs/{envId}/env-vars)
+func (_ Unimplemented) GetSharedEnvVars(w http.ResponseWriter, r *http.Request, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PATCH /api/envs/{envId}/env-vars)
+func (_ Unimplemented) UpdateSharedEnvVars(w http.ResponseWriter, r *http.Request, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /api/envs/{envId}/lock)
+func (_ Unimplemented) UnlockEnv(w http.ResponseWriter, r *http.Request, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/envs/{envId}/lock)
+func (_ Unimplemented) LockEnv(w http.ResponseWriter, r *http.Request, envId Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/up)
+func (_ Unimplemented) Up(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/whoami)
+func (_ Unimplemented) WhoAmI(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetApps operation middleware
+func (siw *ServerInterfaceWrapper) GetApps(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApps(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApp operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApp(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteAppParams
+
+	// ------------- Optional query parameter "delete_volumes" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "delete_volumes", r.URL.Query(), &params.DeleteVolumes)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "delete_volumes", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApp(w, r, appId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApp operation middleware
+func (siw *ServerInterfaceWrapper) GetApp(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApp(w, r, appId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateApp operation middleware
+func (siw *ServerInterfaceWrapper) CreateApp(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateApp(w, r, appId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApproveDeployment operation middleware
+func (siw *ServerInterfaceWrapper) ApproveDeployment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApproveDeployment(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateAutoscaling operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAutoscaling(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateAutoscaling(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AbortCanary operation middleware
+func (siw *ServerInterfaceWrapper) AbortCanary(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AbortCanary(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PromoteCanary operation middleware
+func (siw *ServerInterfaceWrapper) PromoteCanary(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PromoteCanary(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CancelDeployment operation middleware
+func (siw *ServerInterfaceWrapper) CancelDeployment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelDeployment(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateCells operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCells(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCells(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCronJobs operation middleware
+func (siw *ServerInterfaceWrapper) GetCronJobs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCronJobs(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateCronJob operation middleware
+func (siw *ServerInterfaceWrapper) CreateCronJob(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCronJob(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCronJob operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCronJob(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name LowercaseAlphaNumHyphen
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCronJob(w, r, appId, envId, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCronJobRuns operation middleware
+func (siw *ServerInterfaceWrapper) GetCronJobRuns(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name LowercaseAlphaNumHyphen
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCronJobRuns(w, r, appId, envId, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCronJobRunLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetCronJobRunLogs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name LowercaseAlphaNumHyphen
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "runName" -------------
+	var runName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runName", chi.URLParam(r, "runName"), &runName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runName", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCronJobRunLogs(w, r, appId, envId, name, runName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateDependencies operation middleware
+func (siw *ServerInterfaceWrapper) UpdateDependencies(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateDependencies(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeployImage operation middleware
+func (siw *ServerInterfaceWrapper) DeployImage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeployImage(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDeploymentEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetDeploymentEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "deploymentId" -------------
+	var deploymentId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deploymentId", chi.URLParam(r, "deploymentId"), &deploymentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deploymentId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDeploymentEvents(w, r, appId, envId, deploymentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DiffDeployment operation middleware
+func (siw *ServerInterfaceWrapper) DiffDeployment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DiffDeployment(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDomains operation middleware
+func (siw *ServerInterfaceWrapper) GetDomains(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDomains(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateDomain operation middleware
+func (siw *ServerInterfaceWrapper) CreateDomain(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDomain(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteDomain operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDomain(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "hostname" -------------
+	var hostname string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", chi.URLParam(r, "hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hostname", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteDomain(w, r, appId, envId, hostname)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// VerifyDomain operation middleware
+func (siw *ServerInterfaceWrapper) VerifyDomain(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "hostname" -------------
+	var hostname string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", chi.URLParam(r, "hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hostname", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyDomain(w, r, appId, envId, hostname)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateExternalPorts operation middleware
+func (siw *ServerInterfaceWrapper) UpdateExternalPorts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateExternalPorts(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateHealthCheck operation middleware
+func (siw *ServerInterfaceWrapper) UpdateHealthCheck(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateHealthCheck(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateProcesses operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProcesses(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProcesses(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Promote operation middleware
+func (siw *ServerInterfaceWrapper) Promote(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Promote(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RejectDeployment operation middleware
+func (siw *ServerInterfaceWrapper) RejectDeployment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RejectDeployment(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateReleaseCommand operation middleware
+func (siw *ServerInterfaceWrapper) UpdateReleaseCommand(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateReleaseCommand(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Restart operation middleware
+func (siw *ServerInterfaceWrapper) Restart(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Restart(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Rollback operation middleware
+func (siw *ServerInterfaceWrapper) Rollback(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Rollback(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Scale operation middleware
+func (siw *ServerInterfaceWrapper) Scale(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Scale(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateVolumes operation middleware
+func (siw *ServerInterfaceWrapper) UpdateVolumes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "appId" -------------
+	var appId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateVolumes(w, r, appId, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCells operation middleware
+func (siw *ServerInterfaceWrapper) GetCells(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCells(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEnvs operation middleware
+func (siw *ServerInterfaceWrapper) GetEnvs(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEnvs(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteEnv operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEnv(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteEnv(w, r, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEnv operation middleware
+func (siw *ServerInterfaceWrapper) GetEnv(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEnv(w, r, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateEnv operation middleware
+func (siw *ServerInterfaceWrapper) UpdateEnv(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateEnv(w, r, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateEnv operation middleware
+func (siw *ServerInterfaceWrapper) CreateEnv(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateEnv(w, r, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSharedEnvVars operation middleware
+func (siw *ServerInterfaceWrapper) GetSharedEnvVars(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSharedEnvVars(w, r, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateSharedEnvVars operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSharedEnvVars(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSharedEnvVars(w, r, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UnlockEnv operation middleware
+func (siw *ServerInterfaceWrapper) UnlockEnv(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnlockEnv(w, r, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// LockEnv operation middleware
+func (siw *ServerInterfaceWrapper) LockEnv(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "envId" -------------
+	var envId Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LockEnv(w, r, envId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Up operation middleware
+func (siw *ServerInterfaceWrapper) Up(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Up(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WhoAmI operation middleware
+func (siw *ServerInterfaceWrapper) WhoAmI(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WhoAmI(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/apps", wrapper.GetApps)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/apps/{appId}", wrapper.DeleteApp)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/apps/{appId}", wrapper.GetApp)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/apps/{appId}", wrapper.CreateApp)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/apps/{appId}/envs/{envId}/approve", wrapper.ApproveDeployment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/apps/{appId}/envs/{envId}/autoscaling", wrapper.UpdateAutoscaling)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/apps/{appId}/envs/{envId}/canary/abort", wrapper.AbortCanary)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/apps/{appId}/envs/{envId}/canary/promote", wrapper.PromoteCanary)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/apps/{appId}/envs/{envId}/cancel", wrapper.CancelDeployment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/apps/{appId}/envs/{envId}/cells", wrapper.UpdateCells)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/apps/{appId}/envs/{envId}/cron-jobs", wrapper.GetCronJobs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/apps/{appId}/envs/{envId}/cron-jobs", wrapper.CreateCronJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/apps/{appId}/envs/{envId}/cron-jobs/{name}", wrapper.DeleteCronJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/apps/{appId}/envs/{envId}/cron-jobs/{name}/runs", wrapper.GetCronJobRuns)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/apps/{appId}/envs/{envId}/cron-jobs/{name}/runs/{runName}/logs", wrapper.GetCronJobRunLogs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/apps/{appId}/envs/{envId}/dependencies", wrapper.UpdateDependencies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/apps/{appId}/envs/{envId}/deploy", wrapper.DeployImage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/apps/{appId}/envs/{envId}/deployments/{deploymentId}/events", wrapper.GetDeploymentEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/apps/{appId}/envs/{envId}/diff", wrapper.DiffDeployment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/apps/{appId}/envs/{envId}/domains", wrapper.GetDomains)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/apps/{appId}/envs/{envId}/domains", wrapper.CreateDomain)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/apps/{appId}/envs/{envId}/domains/{hostname}", wrapper.DeleteDomain)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/apps/{appId}/envs/{envId}/domains/{hostname}/verify", wrapper.VerifyDomain)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/apps/{appId}/envs/{envId}/external-ports", wrapper.UpdateExternalPorts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/apps/{appId}/envs/{envId}/health-check", wrapper.UpdateHealthCheck)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/apps/{appId}/envs/{envId}/processes", wrapper.UpdateProcesses)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/apps/{appId}/envs/{envId}/promote", wrapper.Promote)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/apps/{appId}/envs/{envId}/reject", wrapper.RejectDeployment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/apps/{appId}/envs/{envId}/release-command", wrapper.UpdateReleaseCommand)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/apps/{appId}/envs/{envId}/restart", wrapper.Restart)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/apps/{appId}/envs/{envId}/rollback", wrapper.Rollback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/apps/{appId}/envs/{envId}/scale", wrapper.Scale)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/apps/{appId}/envs/{envId}/volumes", wrapper.UpdateVolumes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/cells", wrapper.GetCells)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/envs", wrapper.GetEnvs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/envs/{envId}", wrapper.DeleteEnv)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/envs/{envId}", wrapper.GetEnv)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/api/envs/{envId}", wrapper.UpdateEnv)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/envs/{envId}", wrapper.CreateEnv)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/envs/{envId}/env-vars", wrapper.GetSharedEnvVars)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/api/envs/{envId}/env-vars", wrapper.UpdateSharedEnvVars)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/envs/{envId}/lock", wrapper.UnlockEnv)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/envs/{envId}/lock", wrapper.LockEnv)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/up", wrapper.Up)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/whoami", wrapper.WhoAmI)
+	})
+
+	return r
+}
+
+type BadRequestJSONResponse Error
+
+type InternalServerErrorJSONResponse Error
+
+type NotFoundJSONResponse Error
+
+type GetAppsRequestObject struct {
+}
+
+type GetAppsResponseObject interface {
+	VisitGetAppsResponse(w http.ResponseWriter) error
+}
+
+type GetApps200JSONResponse Apps
+
+func (response GetApps200JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApps500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetApps500JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAppRequestObject struct {
+	AppId  Id `json:"appId"`
+	Params DeleteAppParams
+}
+
+type DeleteAppResponseObject interface {
+	VisitDeleteAppResponse(w http.ResponseWriter) error
+}
+
+type DeleteApp204Response struct {
+}
+
+func (response DeleteApp204Response) VisitDeleteAppResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteApp400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteApp400JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApp404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteApp404JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApp500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteApp500JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAppRequestObject struct {
+	AppId Id `json:"appId"`
+}
+
+type GetAppResponseObject interface {
+	VisitGetAppResponse(w http.ResponseWriter) error
+}
+
+type GetApp200JSONResponse App
+
+func (response GetApp200JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApp404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetApp404JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApp500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetApp500JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAppRequestObject struct {
+	AppId Id `json:"appId"`
+	Body  *CreateAppJSONRequestBody
+}
+
+type CreateAppResponseObject interface {
+	VisitCreateAppResponse(w http.ResponseWriter) error
+}
+
+type CreateApp201JSONResponse App
+
+func (response CreateApp201JSONResponse) VisitCreateAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApp400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateApp400JSONResponse) VisitCreateAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApp500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateApp500JSONResponse) VisitCreateAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveDeploymentRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
+	Body  *ApproveDeploymentJSONRequestBody
+}
+
+type ApproveDeploymentResponseObject interface {
+	VisitApproveDeploymentResponse(w http.ResponseWriter) error
+}
+
+type ApproveDeployment200JSONResponse Deployment
+
+func (response ApproveDeployment200JSONResponse) VisitApproveDeploymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveDeployment400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ApproveDeployment400JSONResponse) VisitApproveDeploymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveDeployment404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ApproveDeployment404JSONResponse) VisitApproveDeploymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveDeployment500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ApproveDeployment500JSONResponse) VisitApproveDeploymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAutoscalingRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
+	Body  *UpdateAutoscalingJSONRequestBody
+}
+
+type UpdateAutoscalingResponseObject interface {
+	VisitUpdateAutoscalingResponse(w http.ResponseWriter) error
+}
+
+type UpdateAutoscaling201JSONResponse Deployment
+
+func (response UpdateAutoscaling201JSONResponse) VisitUpdateAutoscalingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAutoscaling400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateAutoscaling400JSONResponse) VisitUpdateAutoscalingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAutoscaling404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateAutoscaling404JSONResponse) VisitUpdateAutoscalingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAutoscaling500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateAutoscaling500JSONResponse) VisitUpdateAutoscalingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AbortCanaryRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
+}
+
+type AbortCanaryResponseObject interface {
+	VisitAbortCanaryResponse(w http.ResponseWriter) error
+}
+
+type AbortCanary200JSONResponse Deployment
+
+func (response AbortCanary200JSONResponse) VisitAbortCanaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AbortCanary400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AbortCanary400JSONResponse) VisitAbortCanaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AbortCanary404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response AbortCanary404JSONResponse) VisitAbortCanaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AbortCanary500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response AbortCanary500JSONResponse) VisitAbortCanaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PromoteCanaryRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
+	Body  *PromoteCanaryJSONRequestBody
+}
+
+type PromoteCanaryResponseObject interface {
+	VisitPromoteCanaryResponse(w http.ResponseWriter) error
+}
+
+type PromoteCanary200JSONResponse Deployment
+
+func (response PromoteCanary200JSONResponse) VisitPromoteCanaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PromoteCanary400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PromoteCanary400JSONResponse) VisitPromoteCanaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PromoteCanary404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PromoteCanary404JSONResponse) VisitPromoteCanaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PromoteCanary500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response PromoteCanary500JSONResponse) VisitPromoteCanaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelDeploymentRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
+	Body  *CancelDeploymentJSONRequestBody
+}
+
+type CancelDeploymentResponseObject interface {
+	VisitCancelDeploymentResponse(w http.ResponseWriter) error
+}
+
+type CancelDeployment200JSONResponse Deployment
+
+func (response CancelDeployment200JSONResponse) VisitCancelDeploymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelDeployment400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CancelDeployment400JSONResponse) VisitCancelDeploymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelDeployment404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CancelDeployment404JSONResponse) VisitCancelDeploymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelDeployment500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CancelDeployment500JSONResponse) VisitCancelDeploymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCellsRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
+	Body  *UpdateCellsJSONRequestBody
+}
+
+type UpdateCellsResponseObject interface {
+	VisitUpdateCellsResponse(w http.ResponseWriter) error
+}
+
+type UpdateCells201JSONResponse Deployment
+
+func (response UpdateCells201JSONResponse) VisitUpdateCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCells400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateCells400JSONResponse) VisitUpdateCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCells404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateCells404JSONResponse) VisitUpdateCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCells500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateCells500JSONResponse) VisitUpdateCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCronJobsRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
+}
+
+type GetCronJobsResponseObject interface {
+	VisitGetCronJobsResponse(w http.ResponseWriter) error
+}
+
+type GetCronJobs200JSONResponse CronJobs
+
+func (response GetCronJobs200JSONResponse) VisitGetCronJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCronJobs400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCronJobs400JSONResponse) VisitGetCronJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCronJobs404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetCronJobs404JSONResponse) VisitGetCronJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCronJobs500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetCronJobs500JSONResponse) VisitGetCronJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCronJobRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
+	Body  *CreateCronJobJSONRequestBody
+}
+
+type CreateCronJobResponseObject interface {
+	VisitCreateCronJobResponse(w http.ResponseWriter) error
+}
+
+type CreateCronJob201JSONResponse CronJob
+
+func (response CreateCronJob201JSONResponse) VisitCreateCronJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCronJob400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateCronJob400JSONResponse) VisitCreateCronJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCronJob404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateCronJob404JSONResponse) VisitCreateCronJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCronJob500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateCronJob500JSONResponse) VisitCreateCronJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCronJobRequestObject struct {
+	AppId Id                      `json:"appId"`
+	EnvId Id                      `json:"envId"`
+	Name  LowercaseAlphaNumHyphen `json:"name"`
+}
+
+type DeleteCronJobResponseObject interface {
+	VisitDeleteCronJobResponse(w http.ResponseWriter) error
+}
+
+type DeleteCronJob204Response struct {
+}
+
+func (response DeleteCronJob204Response) VisitDeleteCronJobResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteCronJob400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteCronJob400JSONResponse) VisitDeleteCronJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCronJob404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteCronJob404JSONResponse) VisitDeleteCronJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCronJob500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteCronJob500JSONResponse) VisitDeleteCronJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCronJobRunsRequestObject struct {
+	AppId Id                      `json:"appId"`
+	EnvId Id                      `json:"envId"`
+	Name  LowercaseAlphaNumHyphen `json:"name"`
+}
+
+type GetCronJobRunsResponseObject interface {
+	VisitGetCronJobRunsResponse(w http.ResponseWriter) error
+}
+
+type GetCronJobRuns200JSONResponse CronJobRuns
+
+func (response GetCronJobRuns200JSONResponse) VisitGetCronJobRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCronJobRuns400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCronJobRuns400JSONResponse) VisitGetCronJobRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCronJobRuns404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetCronJobRuns404JSONResponse) VisitGetCronJobRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCronJobRuns500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetCronJobRuns500JSONResponse) VisitGetCronJobRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCronJobRunLogsRequestObject struct {
+	AppId   Id                      `json:"appId"`
+	EnvId   Id                      `json:"envId"`
+	Name    LowercaseAlphaNumHyphen `json:"name"`
+	RunName string                  `json:"runName"`
+}
+
+type GetCronJobRunLogsResponseObject interface {
+	VisitGetCronJobRunLogsResponse(w http.ResponseWriter) error
+}
+
+type GetCronJobRunLogs200JSONResponse LogEntries
+
+func (response GetCronJobRunLogs200JSONResponse) VisitGetCronJobRunLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCronJobRunLogs400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCronJobRunLogs400JSONResponse) VisitGetCronJobRunLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCronJobRunLogs404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetCronJobRunLogs404JSONResponse) VisitGetCronJobRunLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCronJobRunLogs500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetCronJobRunLogs500JSONResponse) VisitGetCronJobRunLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDependenciesRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
+	Body  *UpdateDependenciesJSONRequestBody
+}
+
+type UpdateDependenciesResponseObject interface {
+	VisitUpdateDependenciesResponse(w http.ResponseWriter) error
+}
+
+type UpdateDependencies201JSONResponse Deployment
+
+func (response UpdateDependencies201JSONResponse) VisitUpdateDependenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDependencies400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateDependencies400JSONResponse) VisitUpdateDependenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDependencies404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateDependencies404JSONResponse) VisitUpdateDependenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDependencies500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateDependencies500JSONResponse) VisitUpdateDependenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeployImageRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
+	Body  *DeployImageJSONRequestBody
+}
+
+type DeployImageResponseObject interface {
+	VisitDeployImageResponse(w http.ResponseWriter) error
+}
+
+type DeployImage200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response DeployImage200TexteventStreamResponse) VisitDeployImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type DeployImage400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeployImage400JSONResponse) VisitDeployImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeployImage404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeployImage404JSONResponse) VisitDeployImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeployImage500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeployImage500JSONResponse) VisitDeployImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeploymentEventsRequestObject struct {
+	AppId        Id  `json:"appId"`
+	EnvId        Id  `json:"envId"`
+	DeploymentId int `json:"deploymentId"`
+}
+
+type GetDeploymentEventsResponseObject interface {
+	VisitGetDeploymentEventsResponse(w http.ResponseWriter) error
+}
+
+type GetDeploymentEvents200JSONResponse []DeploymentEvent
+
+func (response GetDeploymentEvents200JSONResponse) VisitGetDeploymentEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeploymentEvents404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetDeploymentEvents404JSONResponse) VisitGetDeploymentEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeploymentEvents500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetDeploymentEvents500JSONResponse) VisitGetDeploymentEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffDeploymentRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
+	Body  *DiffDeploymentJSONRequestBody
+}
+
+type DiffDeploymentResponseObject interface {
+	VisitDiffDeploymentResponse(w http.ResponseWriter) error
+}
+
+type DiffDeployment200JSONResponse DeploymentDiff
+
+func (response DiffDeployment200JSONResponse) VisitDiffDeploymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffDeployment400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DiffDeployment400JSONResponse) VisitDiffDeploymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffDeployment404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DiffDeployment404JSONResponse) VisitDiffDeploymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffDeployment500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DiffDeployment500JSONResponse) VisitDiffDeploymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDomainsRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
+}
+
+type GetDomainsResponseObject interface {
+	VisitGetDomainsResponse(w http.ResponseWriter) error
+}
+
+type GetDomains200JSONResponse Domains
+
+func (response GetDomains200JSONResponse) VisitGetDomainsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDomains400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetDomains400JSONResponse) VisitGetDomainsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDomains404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetDomains404JSONResponse) VisitGetDomainsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDomains500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetDomains500JSONResponse) VisitGetDomainsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDomainRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
+	Body  *CreateDomainJSONRequestBody
+}
+
+type CreateDomainResponseObject interface {
+	VisitCreateDomainResponse(w http.ResponseWriter) error
+}
+
+type CreateDomain201JSONResponse Domain
+
+func (response CreateDomain201JSONResponse) VisitCreateDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDomain400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateDomain400JSONResponse) VisitCreateDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDomain404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateDomain404JSONResponse) VisitCreateDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDomain500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateDomain500JSONResponse) VisitCreateDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDomainRequestObject struct {
+	AppId    Id     `json:"appId"`
+	EnvId    Id     `json:"envId"`
+	Hostname string `json:"hostname"`
+}
+
+type DeleteDomainResponseObject interface {
+	VisitDeleteDomainResponse(w http.ResponseWriter) error
+}
+
+type DeleteDomain204Response struct {
+}
+
+func (response DeleteDomain204Response) VisitDeleteDomainResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteDomain400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteDomain400JSONResponse) VisitDeleteDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDomain404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteDomain404JSONResponse) VisitDeleteDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDomain500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteDomain500JSONResponse) VisitDeleteDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyDomainRequestObject struct {
+	AppId    Id     `json:"appId"`
+	EnvId    Id     `json:"envId"`
+	Hostname string `json:"hostname"`
+}
+
+type VerifyDomainResponseObject interface {
+	VisitVerifyDomainResponse(w http.ResponseWriter) error
+}
+
+type VerifyDomain200JSONResponse Domain
+
+func (response VerifyDomain200JSONResponse) VisitVerifyDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyDomain400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response VerifyDomain400JSONResponse) VisitVerifyDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyDomain404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response VerifyDomain404JSONResponse) VisitVerifyDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyDomain500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response VerifyDomain500JSONResponse) VisitVerifyDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateExternalPortsRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
+	Body  *UpdateExternalPortsJSONRequestBody
+}
+
+type UpdateExternalPortsResponseObject interface {
+	VisitUpdateExternalPortsResponse(w http.ResponseWriter) error
+}
+
+type UpdateExternalPorts201JSONResponse Deployment
+
+func (response UpdateExternalPorts201JSONResponse) VisitUpdateExternalPortsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateExternalPorts400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateExternalPorts400JSONResponse) VisitUpdateExternalPortsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateExternalPorts404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateExternalPorts404JSONResponse) VisitUpdateExternalPortsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateExternalPorts500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateExternalPorts500JSONResponse) VisitUpdateExternalPortsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateHealthCheckRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
+	Body  *UpdateHealthCheckJSONRequestBody
+}
+
+type UpdateHealthCheckResponseObject interface {
+	VisitUpdateHealthCheckResponse(w http.ResponseWriter) error
+}
+
+type UpdateHealthCheck201JSONResponse Deployment
+
+func (response UpdateHealthCheck201JSONResponse) VisitUpdateHealthCheckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateHealthCheck400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateHealthCheck400JSONResponse) VisitUpdateHealthCheckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateHealthCheck404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateHealthCheck404JSONResponse) VisitUpdateHealthCheckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateHealthCheck500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateHealthCheck500JSONResponse) VisitUpdateHealthCheckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProcessesRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
+	Body  *UpdateProcessesJSONRequestBody
+}
+
+type UpdateProcessesResponseObject interface {
+	VisitUpdateProcessesResponse(w http.ResponseWriter) error
+}
+
+type UpdateProcesses201JSONResponse Deployment
+
+func (response UpdateProcesses201JSONResponse) VisitUpdateProcessesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProcesses400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateProcesses400JSONResponse) VisitUpdateProcessesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProcesses404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateProcesses404JSONResponse) VisitUpdateProcessesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProcesses500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateProcesses500JSONResponse) VisitUpdateProcessesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PromoteRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
+	Body  *PromoteJSONRequestBody
+}
+
+type PromoteResponseObject interface {
+	VisitPromoteResponse(w http.ResponseWriter) error
+}
+
+type Promote201JSONResponse Deployment
+
+func (response Promote201JSONResponse) VisitPromoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Promote400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response Promote400JSONResponse) VisitPromoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Promote404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response Promote404JSONResponse) VisitPromoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Promote500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response Promote500JSONResponse) VisitPromoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectDeploymentRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
+	Body  *RejectDeploymentJSONRequestBody
+}
+
+type RejectDeploymentResponseObject interface {
+	VisitRejectDeploymentResponse(w http.ResponseWriter) error
+}
+
+type RejectDeployment200JSONResponse Deployment
+
+func (response RejectDeployment200JSONResponse) VisitRejectDeploymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectDeployment400JSONResponse struct{ BadRequestJSONResponse }
 
-	// GetAppWithResponse request
-	GetAppWithResponse(ctx context.Context, appId Id, reqEditors ...RequestEditorFn) (*GetAppResponse, error)
+func (response RejectDeployment400JSONResponse) VisitRejectDeploymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	// CreateAppWithBodyWithResponse request with any body
-	CreateAppWithBodyWithResponse(ctx context.Context, appId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppResponse, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	CreateAppWithResponse(ctx context.Context, appId Id, body CreateAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppResponse, error)
+type RejectDeployment404JSONResponse struct{ NotFoundJSONResponse }
 
-	// GetEnvsWithResponse request
-	GetEnvsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEnvsResponse, error)
+func (response RejectDeployment404JSONResponse) VisitRejectDeploymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	// DeleteEnvWithResponse request
-	DeleteEnvWithResponse(ctx context.Context, envId Id, reqEditors ...RequestEditorFn) (*DeleteEnvResponse, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// GetEnvWithResponse request
-	GetEnvWithResponse(ctx context.Context, envId Id, reqEditors ...RequestEditorFn) (*GetEnvResponse, error)
+type RejectDeployment500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
 
-	// CreateEnvWithBodyWithResponse request with any body
-	CreateEnvWithBodyWithResponse(ctx context.Context, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnvResponse, error)
+func (response RejectDeployment500JSONResponse) VisitRejectDeploymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	CreateEnvWithResponse(ctx context.Context, envId Id, body CreateEnvJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnvResponse, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// UpWithBodyWithResponse request with any body
-	UpWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpResponse, error)
+type UpdateReleaseCommandRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
+	Body  *UpdateReleaseCommandJSONRequestBody
+}
 
-	// WhoAmIWithResponse request
-	WhoAmIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WhoAmIResponse, error)
+type UpdateReleaseCommandResponseObject interface {
+	VisitUpdateReleaseCommandResponse(w http.ResponseWriter) error
 }
 
-type GetAppsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Apps
-	JSON500      *InternalServerError
+type UpdateReleaseCommand201JSONResponse Deployment
+
+func (response UpdateReleaseCommand201JSONResponse) VisitUpdateReleaseCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAppsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type UpdateReleaseCommand400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateReleaseCommand400JSONResponse) VisitUpdateReleaseCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAppsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type UpdateReleaseCommand404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateReleaseCommand404JSONResponse) VisitUpdateReleaseCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteAppResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *NotFound
-	JSON500      *InternalServerError
+type UpdateReleaseCommand500JSONResponse struct {
+	InternalServerErrorJSONResponse
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteAppResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+func (response UpdateReleaseCommand500JSONResponse) VisitUpdateReleaseCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAppResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type RestartRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
 }
 
-type GetAppResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *App
-	JSON404      *NotFound
-	JSON500      *InternalServerError
+type RestartResponseObject interface {
+	VisitRestartResponse(w http.ResponseWriter) error
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAppResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type Restart201JSONResponse Deployment
+
+func (response Restart201JSONResponse) VisitRestartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAppResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type Restart400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response Restart400JSONResponse) VisitRestartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateAppResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *App
-	JSON400      *BadRequest
-	JSON500      *InternalServerError
+type Restart404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response Restart404JSONResponse) VisitRestartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateAppResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type Restart500JSONResponse struct {
+	InternalServerErrorJSONResponse
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateAppResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+func (response Restart500JSONResponse) VisitRestartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetEnvsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Envs
-	JSON500      *InternalServerError
+type RollbackRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
+	Body  *RollbackJSONRequestBody
 }
 
-// Status returns HTTPResponse.Status
-func (r GetEnvsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type RollbackResponseObject interface {
+	VisitRollbackResponse(w http.ResponseWriter) error
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetEnvsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type Rollback201JSONResponse Deployment
+
+func (response Rollback201JSONResponse) VisitRollbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteEnvResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *NotFound
-	JSON500      *InternalServerError
+type Rollback400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response Rollback400JSONResponse) VisitRollbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteEnvResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type Rollback404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response Rollback404JSONResponse) VisitRollbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteEnvResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type Rollback500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response Rollback500JSONResponse) VisitRollbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScaleRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
+	Body  *ScaleJSONRequestBody
+}
+
+type ScaleResponseObject interface {
+	VisitScaleResponse(w http.ResponseWriter) error
+}
+
+type Scale201JSONResponse Deployment
+
+func (response Scale201JSONResponse) VisitScaleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Scale400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response Scale400JSONResponse) VisitScaleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Scale404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response Scale404JSONResponse) VisitScaleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Scale500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response Scale500JSONResponse) VisitScaleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVolumesRequestObject struct {
+	AppId Id `json:"appId"`
+	EnvId Id `json:"envId"`
+	Body  *UpdateVolumesJSONRequestBody
+}
+
+type UpdateVolumesResponseObject interface {
+	VisitUpdateVolumesResponse(w http.ResponseWriter) error
+}
+
+type UpdateVolumes201JSONResponse Deployment
+
+func (response UpdateVolumes201JSONResponse) VisitUpdateVolumesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetEnvResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Env
-	JSON404      *NotFound
-	JSON500      *InternalServerError
+type UpdateVolumes400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateVolumes400JSONResponse) VisitUpdateVolumesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetEnvResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type UpdateVolumes404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateVolumes404JSONResponse) VisitUpdateVolumesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetEnvResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type UpdateVolumes500JSONResponse struct {
+	InternalServerErrorJSONResponse
 }
 
-type CreateEnvResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Env
-	JSON400      *BadRequest
-	JSON500      *InternalServerError
+func (response UpdateVolumes500JSONResponse) VisitUpdateVolumesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateEnvResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type GetCellsRequestObject struct {
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateEnvResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type GetCellsResponseObject interface {
+	VisitGetCellsResponse(w http.ResponseWriter) error
 }
 
-type UpResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequest
-	JSON500      *InternalServerError
+type GetCells200JSONResponse Cells
+
+func (response GetCells200JSONResponse) VisitGetCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type GetCells500JSONResponse struct {
+	InternalServerErrorJSONResponse
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+func (response GetCells500JSONResponse) VisitGetCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WhoAmIResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WhoAmI
-	JSON500      *InternalServerError
+type GetEnvsRequestObject struct {
 }
 
-// Status returns HTTPResponse.Status
-func (r WhoAmIResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type GetEnvsResponseObject interface {
+	VisitGetEnvsResponse(w http.ResponseWriter) error
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WhoAmIResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type GetEnvs200JSONResponse Envs
+
+func (response GetEnvs200JSONResponse) VisitGetEnvsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetAppsWithResponse request returning *GetAppsResponse
-func (c *ClientWithResponses) GetAppsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAppsResponse, error) {
-	rsp, err := c.GetApps(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetAppsResponse(rsp)
+type GetEnvs500JSONResponse struct {
+	InternalServerErrorJSONResponse
 }
 
-// DeleteAppWithResponse request returning *DeleteAppResponse
-func (c *ClientWithResponses) DeleteAppWithResponse(ctx context.Context, appId Id, reqEditors ...RequestEditorFn) (*DeleteAppResponse, error) {
-	rsp, err := c.DeleteApp(ctx, appId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteAppResponse(rsp)
+func (response GetEnvs500JSONResponse) VisitGetEnvsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetAppWithResponse request returning *GetAppResponse
-func (c *ClientWithResponses) GetAppWithResponse(ctx context.Context, appId Id, reqEditors ...RequestEditorFn) (*GetAppResponse, error) {
-	rsp, err := c.GetApp(ctx, appId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetAppResponse(rsp)
+type DeleteEnvRequestObject struct {
+	EnvId Id `json:"envId"`
 }
 
-// CreateAppWithBodyWithResponse request with arbitrary body returning *CreateAppResponse
-func (c *ClientWithResponses) CreateAppWithBodyWithResponse(ctx context.Context, appId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppResponse, error) {
-	rsp, err := c.CreateAppWithBody(ctx, appId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateAppResponse(rsp)
+type DeleteEnvResponseObject interface {
+	VisitDeleteEnvResponse(w http.ResponseWriter) error
 }
 
-func (c *ClientWithResponses) CreateAppWithResponse(ctx context.Context, appId Id, body CreateAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppResponse, error) {
-	rsp, err := c.CreateApp(ctx, appId, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateAppResponse(rsp)
+type DeleteEnv204Response struct {
 }
 
-// GetEnvsWithResponse request returning *GetEnvsResponse
-func (c *ClientWithResponses) GetEnvsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEnvsResponse, error) {
-	rsp, err := c.GetEnvs(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetEnvsResponse(rsp)
+func (response DeleteEnv204Response) VisitDeleteEnvResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-// DeleteEnvWithResponse request returning *DeleteEnvResponse
-func (c *ClientWithResponses) DeleteEnvWithResponse(ctx context.Context, envId Id, reqEditors ...RequestEditorFn) (*DeleteEnvResponse, error) {
-	rsp, err := c.DeleteEnv(ctx, envId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteEnvResponse(rsp)
+type DeleteEnv404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteEnv404JSONResponse) VisitDeleteEnvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetEnvWithResponse request returning *GetEnvResponse
-func (c *ClientWithResponses) GetEnvWithResponse(ctx context.Context, envId Id, reqEditors ...RequestEditorFn) (*GetEnvResponse, error) {
-	rsp, err := c.GetEnv(ctx, envId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetEnvResponse(rsp)
+type DeleteEnv500JSONResponse struct {
+	InternalServerErrorJSONResponse
 }
 
-// CreateEnvWithBodyWithResponse request with arbitrary body returning *CreateEnvResponse
-func (c *ClientWithResponses) CreateEnvWithBodyWithResponse(ctx context.Context, envId Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnvResponse, error) {
-	rsp, err := c.CreateEnvWithBody(ctx, envId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateEnvResponse(rsp)
+func (response DeleteEnv500JSONResponse) VisitDeleteEnvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (c *ClientWithResponses) CreateEnvWithResponse(ctx context.Context, envId Id, body CreateEnvJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnvResponse, error) {
-	rsp, err := c.CreateEnv(ctx, envId, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateEnvResponse(rsp)
+type GetEnvRequestObject struct {
+	EnvId Id `json:"envId"`
 }
 
-// UpWithBodyWithResponse request with arbitrary body returning *UpResponse
-func (c *ClientWithResponses) UpWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpResponse, error) {
-	rsp, err := c.UpWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpResponse(rsp)
+type GetEnvResponseObject interface {
+	VisitGetEnvResponse(w http.ResponseWriter) error
 }
 
-// WhoAmIWithResponse request returning *WhoAmIResponse
-func (c *ClientWithResponses) WhoAmIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WhoAmIResponse, error) {
-	rsp, err := c.WhoAmI(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseWhoAmIResponse(rsp)
+type GetEnv200JSONResponse Env
+
+func (response GetEnv200JSONResponse) VisitGetEnvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParseGetAppsResponse parses an HTTP response from a GetAppsWithResponse call
-func ParseGetAppsResponse(rsp *http.Response) (*GetAppsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type GetEnv404JSONResponse struct{ NotFoundJSONResponse }
 
-	response := &GetAppsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response GetEnv404JSONResponse) VisitGetEnvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Apps
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEnv500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetEnv500JSONResponse) VisitGetEnvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	}
+type UpdateEnvRequestObject struct {
+	EnvId Id `json:"envId"`
+	Body  *UpdateEnvJSONRequestBody
+}
 
-	return response, nil
+type UpdateEnvResponseObject interface {
+	VisitUpdateEnvResponse(w http.ResponseWriter) error
 }
 
-// ParseDeleteAppResponse parses an HTTP response from a DeleteAppWithResponse call
-func ParseDeleteAppResponse(rsp *http.Response) (*DeleteAppResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type UpdateEnv200JSONResponse Env
 
-	response := &DeleteAppResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response UpdateEnv200JSONResponse) VisitUpdateEnvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type UpdateEnv400JSONResponse struct{ BadRequestJSONResponse }
 
-	}
+func (response UpdateEnv400JSONResponse) VisitUpdateEnvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	return response, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParseGetAppResponse parses an HTTP response from a GetAppWithResponse call
-func ParseGetAppResponse(rsp *http.Response) (*GetAppResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type UpdateEnv404JSONResponse struct{ NotFoundJSONResponse }
 
-	response := &GetAppResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response UpdateEnv404JSONResponse) VisitUpdateEnvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest App
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+type UpdateEnv500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+func (response UpdateEnv500JSONResponse) VisitUpdateEnvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	return response, nil
+type CreateEnvRequestObject struct {
+	EnvId Id `json:"envId"`
+	Body  *CreateEnvJSONRequestBody
 }
 
-// ParseCreateAppResponse parses an HTTP response from a CreateAppWithResponse call
-func ParseCreateAppResponse(rsp *http.Response) (*CreateAppResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type CreateEnvResponseObject interface {
+	VisitCreateEnvResponse(w http.ResponseWriter) error
+}
 
-	response := &CreateAppResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+type CreateEnv201JSONResponse Env
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest App
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+func (response CreateEnv201JSONResponse) VisitCreateEnvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type CreateEnv400JSONResponse struct{ BadRequestJSONResponse }
 
-	}
+func (response CreateEnv400JSONResponse) VisitCreateEnvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	return response, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParseGetEnvsResponse parses an HTTP response from a GetEnvsWithResponse call
-func ParseGetEnvsResponse(rsp *http.Response) (*GetEnvsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type CreateEnv500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
 
-	response := &GetEnvsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response CreateEnv500JSONResponse) VisitCreateEnvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Envs
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type GetSharedEnvVarsRequestObject struct {
+	EnvId Id `json:"envId"`
+}
 
-	}
+type GetSharedEnvVarsResponseObject interface {
+	VisitGetSharedEnvVarsResponse(w http.ResponseWriter) error
+}
 
-	return response, nil
+type GetSharedEnvVars200JSONResponse SharedEnvVars
+
+func (response GetSharedEnvVars200JSONResponse) VisitGetSharedEnvVarsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParseDeleteEnvResponse parses an HTTP response from a DeleteEnvWithResponse call
-func ParseDeleteEnvResponse(rsp *http.Response) (*DeleteEnvResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type GetSharedEnvVars404JSONResponse struct{ NotFoundJSONResponse }
 
-	response := &DeleteEnvResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response GetSharedEnvVars404JSONResponse) VisitGetSharedEnvVarsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type GetSharedEnvVars500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
 
-	}
+func (response GetSharedEnvVars500JSONResponse) VisitGetSharedEnvVarsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	return response, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParseGetEnvResponse parses an HTTP response from a GetEnvWithResponse call
-func ParseGetEnvResponse(rsp *http.Response) (*GetEnvResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type UpdateSharedEnvVarsRequestObject struct {
+	EnvId Id `json:"envId"`
+	Body  *UpdateSharedEnvVarsJSONRequestBody
+}
 
-	response := &GetEnvResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+type UpdateSharedEnvVarsResponseObject interface {
+	VisitUpdateSharedEnvVarsResponse(w http.ResponseWriter) error
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Env
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+type UpdateSharedEnvVars200JSONResponse SharedEnvVars
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+func (response UpdateSharedEnvVars200JSONResponse) VisitUpdateSharedEnvVarsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	}
+type UpdateSharedEnvVars400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateSharedEnvVars400JSONResponse) VisitUpdateSharedEnvVarsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	return response, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParseCreateEnvResponse parses an HTTP response from a CreateEnvWithResponse call
-func ParseCreateEnvResponse(rsp *http.Response) (*CreateEnvResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type UpdateSharedEnvVars404JSONResponse struct{ NotFoundJSONResponse }
 
-	response := &CreateEnvResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response UpdateSharedEnvVars404JSONResponse) VisitUpdateSharedEnvVarsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Env
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type UpdateSharedEnvVars500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+func (response UpdateSharedEnvVars500JSONResponse) VisitUpdateSharedEnvVarsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	return response, nil
+type UnlockEnvRequestObject struct {
+	EnvId Id `json:"envId"`
 }
 
-// ParseUpResponse parses an HTTP response from a UpWithResponse call
-func ParseUpResponse(rsp *http.Response) (*UpResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type UnlockEnvResponseObject interface {
+	VisitUnlockEnvResponse(w http.ResponseWriter) error
+}
 
-	response := &UpResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+type UnlockEnv200JSONResponse Env
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+func (response UnlockEnv200JSONResponse) VisitUnlockEnvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	}
+type UnlockEnv400JSONResponse struct{ BadRequestJSONResponse }
 
-	return response, nil
+func (response UnlockEnv400JSONResponse) VisitUnlockEnvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParseWhoAmIResponse parses an HTTP response from a WhoAmIWithResponse call
-func ParseWhoAmIResponse(rsp *http.Response) (*WhoAmIResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type UnlockEnv404JSONResponse struct{ NotFoundJSONResponse }
 
-	response := &WhoAmIResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response UnlockEnv404JSONResponse) VisitUnlockEnvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WhoAmI
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type UnlockEnv500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
 
-	}
+func (response UnlockEnv500JSONResponse) VisitUnlockEnvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	return response, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
+type LockEnvRequestObject struct {
+	EnvId Id `json:"envId"`
+	Body  *LockEnvJSONRequestBody
+}
 
-	// (GET /api/apps)
-	GetApps(w http.ResponseWriter, r *http.Request)
+type LockEnvResponseObject interface {
+	VisitLockEnvResponse(w http.ResponseWriter) error
+}
 
-	// (DELETE /api/apps/{appId})
-	DeleteApp(w http.ResponseWriter, r *http.Request, appId Id)
+type LockEnv200JSONResponse Env
 
-	// (GET /api/apps/{appId})
-	GetApp(w http.ResponseWriter, r *http.Request, appId Id)
+func (response LockEnv200JSONResponse) VisitLockEnvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	// (PUT /api/apps/{appId})
-	CreateApp(w http.ResponseWriter, r *http.Request, appId Id)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// (GET /api/envs)
-	GetEnvs(w http.ResponseWriter, r *http.Request)
+type LockEnv400JSONResponse struct{ BadRequestJSONResponse }
 
-	// (DELETE /api/envs/{envId})
-	DeleteEnv(w http.ResponseWriter, r *http.Request, envId Id)
+func (response LockEnv400JSONResponse) VisitLockEnvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	// (GET /api/envs/{envId})
-	GetEnv(w http.ResponseWriter, r *http.Request, envId Id)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// (PUT /api/envs/{envId})
-	CreateEnv(w http.ResponseWriter, r *http.Request, envId Id)
+type LockEnv404JSONResponse struct{ NotFoundJSONResponse }
 
-	// (POST /api/up)
-	Up(w http.ResponseWriter, r *http.Request)
+func (response LockEnv404JSONResponse) VisitLockEnvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	// (GET /api/whoami)
-	WhoAmI(w http.ResponseWriter, r *http.Request)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+type LockEnv500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
 
-type Unimplemented struct{}
+func (response LockEnv500JSONResponse) VisitLockEnvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-// (GET /api/apps)
-func (_ Unimplemented) GetApps(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// (DELETE /api/apps/{appId})
-func (_ Unimplemented) DeleteApp(w http.ResponseWriter, r *http.Request, appId Id) {
-	w.WriteHeader(http.StatusNotImplemented)
+type UpRequestObject struct {
+	Body *multipart.Reader
 }
 
-// (GET /api/apps/{appId})
-func (_ Unimplemented) GetApp(w http.ResponseWriter, r *http.Request, appId Id) {
-	w.WriteHeader(http.StatusNotImplemented)
+type UpResponseObject interface {
+	VisitUpResponse(w http.ResponseWriter) error
 }
 
-// (PUT /api/apps/{appId})
-func (_ Unimplemented) CreateApp(w http.ResponseWriter, r *http.Request, appId Id) {
-	w.WriteHeader(http.StatusNotImplemented)
+type Up200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
 }
 
-// (GET /api/envs)
-func (_ Unimplemented) GetEnvs(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+func (response Up200TexteventStreamResponse) VisitUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-// (DELETE /api/envs/{envId})
-func (_ Unimplemented) DeleteEnv(w http.ResponseWriter, r *http.Request, envId Id) {
-	w.WriteHeader(http.StatusNotImplemented)
+type Up400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response Up400JSONResponse) VisitUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// (GET /api/envs/{envId})
-func (_ Unimplemented) GetEnv(w http.ResponseWriter, r *http.Request, envId Id) {
-	w.WriteHeader(http.StatusNotImplemented)
+type Up500JSONResponse struct {
+	InternalServerErrorJSONResponse
 }
 
-// (PUT /api/envs/{envId})
-func (_ Unimplemented) CreateEnv(w http.ResponseWriter, r *http.Request, envId Id) {
-	w.WriteHeader(http.StatusNotImplemented)
+func (response Up500JSONResponse) VisitUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// (POST /api/up)
-func (_ Unimplemented) Up(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+type WhoAmIRequestObject struct {
 }
 
-// (GET /api/whoami)
-func (_ Unimplemented) WhoAmI(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+type WhoAmIResponseObject interface {
+	VisitWhoAmIResponse(w http.ResponseWriter) error
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+type WhoAmI200JSONResponse WhoAmI
+
+func (response WhoAmI200JSONResponse) VisitWhoAmIResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+type WhoAmI500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response WhoAmI500JSONResponse) VisitWhoAmIResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (GET /api/apps)
+	GetApps(ctx context.Context, request GetAppsRequestObject) (GetAppsResponseObject, error)
+
+	// (DELETE /api/apps/{appId})
+	DeleteApp(ctx context.Context, request DeleteAppRequestObject) (DeleteAppResponseObject, error)
+
+	// (GET /api/apps/{appId})
+	GetApp(ctx context.Context, request GetAppRequestObject) (GetAppResponseObject, error)
 
-// GetApps operation middleware
-func (siw *ServerInterfaceWrapper) GetApps(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// (PUT /api/apps/{appId})
+	CreateApp(ctx context.Context, request CreateAppRequestObject) (CreateAppResponseObject, error)
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// (POST /api/apps/{appId}/envs/{envId}/approve)
+	ApproveDeployment(ctx context.Context, request ApproveDeploymentRequestObject) (ApproveDeploymentResponseObject, error)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApps(w, r)
-	}))
+	// (PUT /api/apps/{appId}/envs/{envId}/autoscaling)
+	UpdateAutoscaling(ctx context.Context, request UpdateAutoscalingRequestObject) (UpdateAutoscalingResponseObject, error)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// (POST /api/apps/{appId}/envs/{envId}/canary/abort)
+	AbortCanary(ctx context.Context, request AbortCanaryRequestObject) (AbortCanaryResponseObject, error)
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// (POST /api/apps/{appId}/envs/{envId}/canary/promote)
+	PromoteCanary(ctx context.Context, request PromoteCanaryRequestObject) (PromoteCanaryResponseObject, error)
 
-// DeleteApp operation middleware
-func (siw *ServerInterfaceWrapper) DeleteApp(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// (POST /api/apps/{appId}/envs/{envId}/cancel)
+	CancelDeployment(ctx context.Context, request CancelDeploymentRequestObject) (CancelDeploymentResponseObject, error)
 
-	var err error
+	// (PUT /api/apps/{appId}/envs/{envId}/cells)
+	UpdateCells(ctx context.Context, request UpdateCellsRequestObject) (UpdateCellsResponseObject, error)
 
-	// ------------- Path parameter "appId" -------------
-	var appId Id
+	// (GET /api/apps/{appId}/envs/{envId}/cron-jobs)
+	GetCronJobs(ctx context.Context, request GetCronJobsRequestObject) (GetCronJobsResponseObject, error)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
-		return
-	}
+	// (POST /api/apps/{appId}/envs/{envId}/cron-jobs)
+	CreateCronJob(ctx context.Context, request CreateCronJobRequestObject) (CreateCronJobResponseObject, error)
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// (DELETE /api/apps/{appId}/envs/{envId}/cron-jobs/{name})
+	DeleteCronJob(ctx context.Context, request DeleteCronJobRequestObject) (DeleteCronJobResponseObject, error)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteApp(w, r, appId)
-	}))
+	// (GET /api/apps/{appId}/envs/{envId}/cron-jobs/{name}/runs)
+	GetCronJobRuns(ctx context.Context, request GetCronJobRunsRequestObject) (GetCronJobRunsResponseObject, error)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// (GET /api/apps/{appId}/envs/{envId}/cron-jobs/{name}/runs/{runName}/logs)
+	GetCronJobRunLogs(ctx context.Context, request GetCronJobRunLogsRequestObject) (GetCronJobRunLogsResponseObject, error)
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// (PUT /api/apps/{appId}/envs/{envId}/dependencies)
+	UpdateDependencies(ctx context.Context, request UpdateDependenciesRequestObject) (UpdateDependenciesResponseObject, error)
 
-// GetApp operation middleware
-func (siw *ServerInterfaceWrapper) GetApp(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// (POST /api/apps/{appId}/envs/{envId}/deploy)
+	DeployImage(ctx context.Context, request DeployImageRequestObject) (DeployImageResponseObject, error)
 
-	var err error
+	// (GET /api/apps/{appId}/envs/{envId}/deployments/{deploymentId}/events)
+	GetDeploymentEvents(ctx context.Context, request GetDeploymentEventsRequestObject) (GetDeploymentEventsResponseObject, error)
 
-	// ------------- Path parameter "appId" -------------
-	var appId Id
+	// (POST /api/apps/{appId}/envs/{envId}/diff)
+	DiffDeployment(ctx context.Context, request DiffDeploymentRequestObject) (DiffDeploymentResponseObject, error)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
-		return
-	}
+	// (GET /api/apps/{appId}/envs/{envId}/domains)
+	GetDomains(ctx context.Context, request GetDomainsRequestObject) (GetDomainsResponseObject, error)
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// (POST /api/apps/{appId}/envs/{envId}/domains)
+	CreateDomain(ctx context.Context, request CreateDomainRequestObject) (CreateDomainResponseObject, error)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApp(w, r, appId)
-	}))
+	// (DELETE /api/apps/{appId}/envs/{envId}/domains/{hostname})
+	DeleteDomain(ctx context.Context, request DeleteDomainRequestObject) (DeleteDomainResponseObject, error)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// (POST /api/apps/{appId}/envs/{envId}/domains/{hostname}/verify)
+	VerifyDomain(ctx context.Context, request VerifyDomainRequestObject) (VerifyDomainResponseObject, error)
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// (PUT /api/apps/{appId}/envs/{envId}/external-ports)
+	UpdateExternalPorts(ctx context.Context, request UpdateExternalPortsRequestObject) (UpdateExternalPortsResponseObject, error)
 
-// CreateApp operation middleware
-func (siw *ServerInterfaceWrapper) CreateApp(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// (PUT /api/apps/{appId}/envs/{envId}/health-check)
+	UpdateHealthCheck(ctx context.Context, request UpdateHealthCheckRequestObject) (UpdateHealthCheckResponseObject, error)
 
-	var err error
+	// (PUT /api/apps/{appId}/envs/{envId}/processes)
+	UpdateProcesses(ctx context.Context, request UpdateProcessesRequestObject) (UpdateProcessesResponseObject, error)
 
-	// ------------- Path parameter "appId" -------------
-	var appId Id
+	// (POST /api/apps/{appId}/envs/{envId}/promote)
+	Promote(ctx context.Context, request PromoteRequestObject) (PromoteResponseObject, error)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "appId", chi.URLParam(r, "appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "appId", Err: err})
-		return
-	}
+	// (POST /api/apps/{appId}/envs/{envId}/reject)
+	RejectDeployment(ctx context.Context, request RejectDeploymentRequestObject) (RejectDeploymentResponseObject, error)
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// (PUT /api/apps/{appId}/envs/{envId}/release-command)
+	UpdateReleaseCommand(ctx context.Context, request UpdateReleaseCommandRequestObject) (UpdateReleaseCommandResponseObject, error)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateApp(w, r, appId)
-	}))
+	// (POST /api/apps/{appId}/envs/{envId}/restart)
+	Restart(ctx context.Context, request RestartRequestObject) (RestartResponseObject, error)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// (POST /api/apps/{appId}/envs/{envId}/rollback)
+	Rollback(ctx context.Context, request RollbackRequestObject) (RollbackResponseObject, error)
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// (POST /api/apps/{appId}/envs/{envId}/scale)
+	Scale(ctx context.Context, request ScaleRequestObject) (ScaleResponseObject, error)
 
-// GetEnvs operation middleware
-func (siw *ServerInterfaceWrapper) GetEnvs(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// (PUT /api/apps/{appId}/envs/{envId}/volumes)
+	UpdateVolumes(ctx context.Context, request UpdateVolumesRequestObject) (UpdateVolumesResponseObject, error)
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// (GET /api/cells)
+	GetCells(ctx context.Context, request GetCellsRequestObject) (GetCellsResponseObject, error)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetEnvs(w, r)
-	}))
+	// (GET /api/envs)
+	GetEnvs(ctx context.Context, request GetEnvsRequestObject) (GetEnvsResponseObject, error)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// (DELETE /api/envs/{envId})
+	DeleteEnv(ctx context.Context, request DeleteEnvRequestObject) (DeleteEnvResponseObject, error)
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// (GET /api/envs/{envId})
+	GetEnv(ctx context.Context, request GetEnvRequestObject) (GetEnvResponseObject, error)
 
-// DeleteEnv operation middleware
-func (siw *ServerInterfaceWrapper) DeleteEnv(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// (PATCH /api/envs/{envId})
+	UpdateEnv(ctx context.Context, request UpdateEnvRequestObject) (UpdateEnvResponseObject, error)
 
-	var err error
+	// (PUT /api/envs/{envId})
+	CreateEnv(ctx context.Context, request CreateEnvRequestObject) (CreateEnvResponseObject, error)
 
-	// ------------- Path parameter "envId" -------------
-	var envId Id
+	// (GET /api/envs/{envId}/env-vars)
+	GetSharedEnvVars(ctx context.Context, request GetSharedEnvVarsRequestObject) (GetSharedEnvVarsResponseObject, error)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
-		return
-	}
+	// (PATCH /api/envs/{envId}/env-vars)
+	UpdateSharedEnvVars(ctx context.Context, request UpdateSharedEnvVarsRequestObject) (UpdateSharedEnvVarsResponseObject, error)
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// (DELETE /api/envs/{envId}/lock)
+	UnlockEnv(ctx context.Context, request UnlockEnvRequestObject) (UnlockEnvResponseObject, error)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteEnv(w, r, envId)
-	}))
+	// (POST /api/envs/{envId}/lock)
+	LockEnv(ctx context.Context, request LockEnvRequestObject) (LockEnvResponseObject, error)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// (POST /api/up)
+	Up(ctx context.Context, request UpRequestObject) (UpResponseObject, error)
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	// (GET /api/whoami)
+	WhoAmI(ctx context.Context, request WhoAmIRequestObject) (WhoAmIResponseObject, error)
 }
 
-// GetEnv operation middleware
-func (siw *ServerInterfaceWrapper) GetEnv(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
 
-	var err error
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
 
-	// ------------- Path parameter "envId" -------------
-	var envId Id
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
-		return
-	}
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetEnv(w, r, envId)
-	}))
+// GetApps operation middleware
+func (sh *strictHandler) GetApps(w http.ResponseWriter, r *http.Request) {
+	var request GetAppsRequestObject
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApps(ctx, request.(GetAppsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApps")
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAppsResponseObject); ok {
+		if err := validResponse.VisitGetAppsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// CreateEnv operation middleware
-func (siw *ServerInterfaceWrapper) CreateEnv(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// DeleteApp operation middleware
+func (sh *strictHandler) DeleteApp(w http.ResponseWriter, r *http.Request, appId Id, params DeleteAppParams) {
+	var request DeleteAppRequestObject
 
-	var err error
+	request.AppId = appId
+	request.Params = params
 
-	// ------------- Path parameter "envId" -------------
-	var envId Id
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApp(ctx, request.(DeleteAppRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApp")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "envId", chi.URLParam(r, "envId"), &envId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envId", Err: err})
-		return
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAppResponseObject); ok {
+		if err := validResponse.VisitDeleteAppResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// GetApp operation middleware
+func (sh *strictHandler) GetApp(w http.ResponseWriter, r *http.Request, appId Id) {
+	var request GetAppRequestObject
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateEnv(w, r, envId)
-	}))
+	request.AppId = appId
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApp(ctx, request.(GetAppRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApp")
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAppResponseObject); ok {
+		if err := validResponse.VisitGetAppResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// Up operation middleware
-func (siw *ServerInterfaceWrapper) Up(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// CreateApp operation middleware
+func (sh *strictHandler) CreateApp(w http.ResponseWriter, r *http.Request, appId Id) {
+	var request CreateAppRequestObject
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	request.AppId = appId
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Up(w, r)
-	}))
+	var body CreateAppJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateApp(ctx, request.(CreateAppRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateApp")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAppResponseObject); ok {
+		if err := validResponse.VisitCreateAppResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-
-	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// WhoAmI operation middleware
-func (siw *ServerInterfaceWrapper) WhoAmI(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// ApproveDeployment operation middleware
+func (sh *strictHandler) ApproveDeployment(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request ApproveDeploymentRequestObject
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.WhoAmI(w, r)
-	}))
+	request.AppId = appId
+	request.EnvId = envId
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	var body ApproveDeploymentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
 	}
+	request.Body = &body
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApproveDeployment(ctx, request.(ApproveDeploymentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApproveDeployment")
+	}
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApproveDeploymentResponseObject); ok {
+		if err := validResponse.VisitApproveDeploymentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+// UpdateAutoscaling operation middleware
+func (sh *strictHandler) UpdateAutoscaling(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request UpdateAutoscalingRequestObject
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	request.AppId = appId
+	request.EnvId = envId
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	var body UpdateAutoscalingJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateAutoscaling(ctx, request.(UpdateAutoscalingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateAutoscaling")
+	}
 
-type RequiredParamError struct {
-	ParamName string
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateAutoscalingResponseObject); ok {
+		if err := validResponse.VisitUpdateAutoscalingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+// AbortCanary operation middleware
+func (sh *strictHandler) AbortCanary(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request AbortCanaryRequestObject
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	request.AppId = appId
+	request.EnvId = envId
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AbortCanary(ctx, request.(AbortCanaryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AbortCanary")
+	}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AbortCanaryResponseObject); ok {
+		if err := validResponse.VisitAbortCanaryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+// PromoteCanary operation middleware
+func (sh *strictHandler) PromoteCanary(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request PromoteCanaryRequestObject
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	request.AppId = appId
+	request.EnvId = envId
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	var body PromoteCanaryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PromoteCanary(ctx, request.(PromoteCanaryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PromoteCanary")
+	}
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PromoteCanaryResponseObject); ok {
+		if err := validResponse.VisitPromoteCanaryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+// CancelDeployment operation middleware
+func (sh *strictHandler) CancelDeployment(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request CancelDeploymentRequestObject
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+	request.AppId = appId
+	request.EnvId = envId
 
-	if r == nil {
-		r = chi.NewRouter()
+	var body CancelDeploymentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelDeployment(ctx, request.(CancelDeploymentRequestObject))
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelDeployment")
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/apps", wrapper.GetApps)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/apps/{appId}", wrapper.DeleteApp)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/apps/{appId}", wrapper.GetApp)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/apps/{appId}", wrapper.CreateApp)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/envs", wrapper.GetEnvs)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/envs/{envId}", wrapper.DeleteEnv)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/envs/{envId}", wrapper.GetEnv)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/envs/{envId}", wrapper.CreateEnv)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/up", wrapper.Up)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/whoami", wrapper.WhoAmI)
-	})
+	response, err := handler(r.Context(), w, r, request)
 
-	return r
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelDeploymentResponseObject); ok {
+		if err := validResponse.VisitCancelDeploymentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type BadRequestJSONResponse Error
-
-type InternalServerErrorJSONResponse Error
-
-type NotFoundJSONResponse Error
-
-type GetAppsRequestObject struct {
-}
+// UpdateCells operation middleware
+func (sh *strictHandler) UpdateCells(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request UpdateCellsRequestObject
 
-type GetAppsResponseObject interface {
-	VisitGetAppsResponse(w http.ResponseWriter) error
-}
+	request.AppId = appId
+	request.EnvId = envId
 
-type GetApps200JSONResponse Apps
+	var body UpdateCellsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response GetApps200JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCells(ctx, request.(UpdateCellsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCells")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetApps500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCellsResponseObject); ok {
+		if err := validResponse.VisitUpdateCellsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetApps500JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetCronJobs operation middleware
+func (sh *strictHandler) GetCronJobs(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request GetCronJobsRequestObject
 
-type DeleteAppRequestObject struct {
-	AppId Id `json:"appId"`
-}
+	request.AppId = appId
+	request.EnvId = envId
 
-type DeleteAppResponseObject interface {
-	VisitDeleteAppResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCronJobs(ctx, request.(GetCronJobsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCronJobs")
+	}
 
-type DeleteApp204Response struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (response DeleteApp204Response) VisitDeleteAppResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCronJobsResponseObject); ok {
+		if err := validResponse.VisitGetCronJobsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteApp404JSONResponse struct{ NotFoundJSONResponse }
+// CreateCronJob operation middleware
+func (sh *strictHandler) CreateCronJob(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request CreateCronJobRequestObject
 
-func (response DeleteApp404JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.AppId = appId
+	request.EnvId = envId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateCronJobJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type DeleteApp500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCronJob(ctx, request.(CreateCronJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCronJob")
+	}
 
-func (response DeleteApp500JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCronJobResponseObject); ok {
+		if err := validResponse.VisitCreateCronJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAppRequestObject struct {
-	AppId Id `json:"appId"`
-}
+// DeleteCronJob operation middleware
+func (sh *strictHandler) DeleteCronJob(w http.ResponseWriter, r *http.Request, appId Id, envId Id, name LowercaseAlphaNumHyphen) {
+	var request DeleteCronJobRequestObject
 
-type GetAppResponseObject interface {
-	VisitGetAppResponse(w http.ResponseWriter) error
-}
+	request.AppId = appId
+	request.EnvId = envId
+	request.Name = name
 
-type GetApp200JSONResponse App
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCronJob(ctx, request.(DeleteCronJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCronJob")
+	}
 
-func (response GetApp200JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCronJobResponseObject); ok {
+		if err := validResponse.VisitDeleteCronJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApp404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response GetApp404JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetCronJobRuns operation middleware
+func (sh *strictHandler) GetCronJobRuns(w http.ResponseWriter, r *http.Request, appId Id, envId Id, name LowercaseAlphaNumHyphen) {
+	var request GetCronJobRunsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.AppId = appId
+	request.EnvId = envId
+	request.Name = name
 
-type GetApp500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCronJobRuns(ctx, request.(GetCronJobRunsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCronJobRuns")
+	}
 
-func (response GetApp500JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCronJobRunsResponseObject); ok {
+		if err := validResponse.VisitGetCronJobRunsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateAppRequestObject struct {
-	AppId Id `json:"appId"`
-	Body  *CreateAppJSONRequestBody
-}
+// GetCronJobRunLogs operation middleware
+func (sh *strictHandler) GetCronJobRunLogs(w http.ResponseWriter, r *http.Request, appId Id, envId Id, name LowercaseAlphaNumHyphen, runName string) {
+	var request GetCronJobRunLogsRequestObject
 
-type CreateAppResponseObject interface {
-	VisitCreateAppResponse(w http.ResponseWriter) error
-}
+	request.AppId = appId
+	request.EnvId = envId
+	request.Name = name
+	request.RunName = runName
 
-type CreateApp201JSONResponse App
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCronJobRunLogs(ctx, request.(GetCronJobRunLogsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCronJobRunLogs")
+	}
 
-func (response CreateApp201JSONResponse) VisitCreateAppResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCronJobRunLogsResponseObject); ok {
+		if err := validResponse.VisitGetCronJobRunLogsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateApp400JSONResponse struct{ BadRequestJSONResponse }
+// UpdateDependencies operation middleware
+func (sh *strictHandler) UpdateDependencies(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request UpdateDependenciesRequestObject
 
-func (response CreateApp400JSONResponse) VisitCreateAppResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.AppId = appId
+	request.EnvId = envId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body UpdateDependenciesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type CreateApp500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateDependencies(ctx, request.(UpdateDependenciesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateDependencies")
+	}
 
-func (response CreateApp500JSONResponse) VisitCreateAppResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateDependenciesResponseObject); ok {
+		if err := validResponse.VisitUpdateDependenciesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetEnvsRequestObject struct {
-}
+// DeployImage operation middleware
+func (sh *strictHandler) DeployImage(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request DeployImageRequestObject
 
-type GetEnvsResponseObject interface {
-	VisitGetEnvsResponse(w http.ResponseWriter) error
-}
+	request.AppId = appId
+	request.EnvId = envId
 
-type GetEnvs200JSONResponse Envs
+	var body DeployImageJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response GetEnvs200JSONResponse) VisitGetEnvsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeployImage(ctx, request.(DeployImageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeployImage")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetEnvs500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeployImageResponseObject); ok {
+		if err := validResponse.VisitDeployImageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetEnvs500JSONResponse) VisitGetEnvsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetDeploymentEvents operation middleware
+func (sh *strictHandler) GetDeploymentEvents(w http.ResponseWriter, r *http.Request, appId Id, envId Id, deploymentId int) {
+	var request GetDeploymentEventsRequestObject
 
-type DeleteEnvRequestObject struct {
-	EnvId Id `json:"envId"`
-}
+	request.AppId = appId
+	request.EnvId = envId
+	request.DeploymentId = deploymentId
 
-type DeleteEnvResponseObject interface {
-	VisitDeleteEnvResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDeploymentEvents(ctx, request.(GetDeploymentEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDeploymentEvents")
+	}
 
-type DeleteEnv204Response struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (response DeleteEnv204Response) VisitDeleteEnvResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDeploymentEventsResponseObject); ok {
+		if err := validResponse.VisitGetDeploymentEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteEnv404JSONResponse struct{ NotFoundJSONResponse }
+// DiffDeployment operation middleware
+func (sh *strictHandler) DiffDeployment(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request DiffDeploymentRequestObject
 
-func (response DeleteEnv404JSONResponse) VisitDeleteEnvResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.AppId = appId
+	request.EnvId = envId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body DiffDeploymentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type DeleteEnv500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DiffDeployment(ctx, request.(DiffDeploymentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DiffDeployment")
+	}
 
-func (response DeleteEnv500JSONResponse) VisitDeleteEnvResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DiffDeploymentResponseObject); ok {
+		if err := validResponse.VisitDiffDeploymentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetEnvRequestObject struct {
-	EnvId Id `json:"envId"`
-}
+// GetDomains operation middleware
+func (sh *strictHandler) GetDomains(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request GetDomainsRequestObject
 
-type GetEnvResponseObject interface {
-	VisitGetEnvResponse(w http.ResponseWriter) error
-}
+	request.AppId = appId
+	request.EnvId = envId
 
-type GetEnv200JSONResponse Env
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDomains(ctx, request.(GetDomainsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDomains")
+	}
 
-func (response GetEnv200JSONResponse) VisitGetEnvResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDomainsResponseObject); ok {
+		if err := validResponse.VisitGetDomainsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetEnv404JSONResponse struct{ NotFoundJSONResponse }
+// CreateDomain operation middleware
+func (sh *strictHandler) CreateDomain(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request CreateDomainRequestObject
 
-func (response GetEnv404JSONResponse) VisitGetEnvResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.AppId = appId
+	request.EnvId = envId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateDomainJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetEnv500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateDomain(ctx, request.(CreateDomainRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateDomain")
+	}
 
-func (response GetEnv500JSONResponse) VisitGetEnvResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateDomainResponseObject); ok {
+		if err := validResponse.VisitCreateDomainResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateEnvRequestObject struct {
-	EnvId Id `json:"envId"`
-	Body  *CreateEnvJSONRequestBody
-}
+// DeleteDomain operation middleware
+func (sh *strictHandler) DeleteDomain(w http.ResponseWriter, r *http.Request, appId Id, envId Id, hostname string) {
+	var request DeleteDomainRequestObject
 
-type CreateEnvResponseObject interface {
-	VisitCreateEnvResponse(w http.ResponseWriter) error
-}
+	request.AppId = appId
+	request.EnvId = envId
+	request.Hostname = hostname
 
-type CreateEnv201JSONResponse Env
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteDomain(ctx, request.(DeleteDomainRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteDomain")
+	}
 
-func (response CreateEnv201JSONResponse) VisitCreateEnvResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteDomainResponseObject); ok {
+		if err := validResponse.VisitDeleteDomainResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateEnv400JSONResponse struct{ BadRequestJSONResponse }
-
-func (response CreateEnv400JSONResponse) VisitCreateEnvResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// VerifyDomain operation middleware
+func (sh *strictHandler) VerifyDomain(w http.ResponseWriter, r *http.Request, appId Id, envId Id, hostname string) {
+	var request VerifyDomainRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.AppId = appId
+	request.EnvId = envId
+	request.Hostname = hostname
 
-type CreateEnv500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifyDomain(ctx, request.(VerifyDomainRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifyDomain")
+	}
 
-func (response CreateEnv500JSONResponse) VisitCreateEnvResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(VerifyDomainResponseObject); ok {
+		if err := validResponse.VisitVerifyDomainResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpRequestObject struct {
-	Body *multipart.Reader
-}
+// UpdateExternalPorts operation middleware
+func (sh *strictHandler) UpdateExternalPorts(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request UpdateExternalPortsRequestObject
 
-type UpResponseObject interface {
-	VisitUpResponse(w http.ResponseWriter) error
-}
+	request.AppId = appId
+	request.EnvId = envId
 
-type Up200TexteventStreamResponse struct {
-	Body          io.Reader
-	ContentLength int64
-}
+	var body UpdateExternalPortsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response Up200TexteventStreamResponse) VisitUpResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/event-stream")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateExternalPorts(ctx, request.(UpdateExternalPortsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateExternalPorts")
 	}
-	w.WriteHeader(200)
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateExternalPortsResponseObject); ok {
+		if err := validResponse.VisitUpdateExternalPortsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	_, err := io.Copy(w, response.Body)
-	return err
 }
 
-type Up400JSONResponse struct{ BadRequestJSONResponse }
+// UpdateHealthCheck operation middleware
+func (sh *strictHandler) UpdateHealthCheck(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request UpdateHealthCheckRequestObject
 
-func (response Up400JSONResponse) VisitUpResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.AppId = appId
+	request.EnvId = envId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body UpdateHealthCheckJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type Up500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateHealthCheck(ctx, request.(UpdateHealthCheckRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateHealthCheck")
+	}
 
-func (response Up500JSONResponse) VisitUpResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateHealthCheckResponseObject); ok {
+		if err := validResponse.VisitUpdateHealthCheckResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type WhoAmIRequestObject struct {
-}
+// UpdateProcesses operation middleware
+func (sh *strictHandler) UpdateProcesses(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request UpdateProcessesRequestObject
 
-type WhoAmIResponseObject interface {
-	VisitWhoAmIResponse(w http.ResponseWriter) error
-}
+	request.AppId = appId
+	request.EnvId = envId
 
-type WhoAmI200JSONResponse WhoAmI
+	var body UpdateProcessesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response WhoAmI200JSONResponse) VisitWhoAmIResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateProcesses(ctx, request.(UpdateProcessesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateProcesses")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type WhoAmI500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateProcessesResponseObject); ok {
+		if err := validResponse.VisitUpdateProcessesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response WhoAmI500JSONResponse) VisitWhoAmIResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// Promote operation middleware
+func (sh *strictHandler) Promote(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request PromoteRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.AppId = appId
+	request.EnvId = envId
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
+	var body PromoteJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	// (GET /api/apps)
-	GetApps(ctx context.Context, request GetAppsRequestObject) (GetAppsResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Promote(ctx, request.(PromoteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Promote")
+	}
 
-	// (DELETE /api/apps/{appId})
-	DeleteApp(ctx context.Context, request DeleteAppRequestObject) (DeleteAppResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PromoteResponseObject); ok {
+		if err := validResponse.VisitPromoteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (GET /api/apps/{appId})
-	GetApp(ctx context.Context, request GetAppRequestObject) (GetAppResponseObject, error)
+// RejectDeployment operation middleware
+func (sh *strictHandler) RejectDeployment(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request RejectDeploymentRequestObject
 
-	// (PUT /api/apps/{appId})
-	CreateApp(ctx context.Context, request CreateAppRequestObject) (CreateAppResponseObject, error)
+	request.AppId = appId
+	request.EnvId = envId
 
-	// (GET /api/envs)
-	GetEnvs(ctx context.Context, request GetEnvsRequestObject) (GetEnvsResponseObject, error)
+	var body RejectDeploymentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	// (DELETE /api/envs/{envId})
-	DeleteEnv(ctx context.Context, request DeleteEnvRequestObject) (DeleteEnvResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RejectDeployment(ctx, request.(RejectDeploymentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RejectDeployment")
+	}
 
-	// (GET /api/envs/{envId})
-	GetEnv(ctx context.Context, request GetEnvRequestObject) (GetEnvResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (PUT /api/envs/{envId})
-	CreateEnv(ctx context.Context, request CreateEnvRequestObject) (CreateEnvResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RejectDeploymentResponseObject); ok {
+		if err := validResponse.VisitRejectDeploymentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (POST /api/up)
-	Up(ctx context.Context, request UpRequestObject) (UpResponseObject, error)
+// UpdateReleaseCommand operation middleware
+func (sh *strictHandler) UpdateReleaseCommand(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request UpdateReleaseCommandRequestObject
 
-	// (GET /api/whoami)
-	WhoAmI(ctx context.Context, request WhoAmIRequestObject) (WhoAmIResponseObject, error)
-}
+	request.AppId = appId
+	request.EnvId = envId
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+	var body UpdateReleaseCommandJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateReleaseCommand(ctx, request.(UpdateReleaseCommandRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateReleaseCommand")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateReleaseCommandResponseObject); ok {
+		if err := validResponse.VisitUpdateReleaseCommandResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
-}
+// Restart operation middleware
+func (sh *strictHandler) Restart(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request RestartRequestObject
 
-// GetApps operation middleware
-func (sh *strictHandler) GetApps(w http.ResponseWriter, r *http.Request) {
-	var request GetAppsRequestObject
+	request.AppId = appId
+	request.EnvId = envId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetApps(ctx, request.(GetAppsRequestObject))
+		return sh.ssi.Restart(ctx, request.(RestartRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetApps")
+		handler = middleware(handler, "Restart")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAppsResponseObject); ok {
-		if err := validResponse.VisitGetAppsResponse(w); err != nil {
+	} else if validResponse, ok := response.(RestartResponseObject); ok {
+		if err := validResponse.VisitRestartResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2420,25 +13354,33 @@ func (sh *strictHandler) GetApps(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteApp operation middleware
-func (sh *strictHandler) DeleteApp(w http.ResponseWriter, r *http.Request, appId Id) {
-	var request DeleteAppRequestObject
+// Rollback operation middleware
+func (sh *strictHandler) Rollback(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request RollbackRequestObject
 
 	request.AppId = appId
+	request.EnvId = envId
+
+	var body RollbackJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteApp(ctx, request.(DeleteAppRequestObject))
+		return sh.ssi.Rollback(ctx, request.(RollbackRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteApp")
+		handler = middleware(handler, "Rollback")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteAppResponseObject); ok {
-		if err := validResponse.VisitDeleteAppResponse(w); err != nil {
+	} else if validResponse, ok := response.(RollbackResponseObject); ok {
+		if err := validResponse.VisitRollbackResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2446,25 +13388,33 @@ func (sh *strictHandler) DeleteApp(w http.ResponseWriter, r *http.Request, appId
 	}
 }
 
-// GetApp operation middleware
-func (sh *strictHandler) GetApp(w http.ResponseWriter, r *http.Request, appId Id) {
-	var request GetAppRequestObject
+// Scale operation middleware
+func (sh *strictHandler) Scale(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request ScaleRequestObject
 
 	request.AppId = appId
+	request.EnvId = envId
+
+	var body ScaleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetApp(ctx, request.(GetAppRequestObject))
+		return sh.ssi.Scale(ctx, request.(ScaleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetApp")
+		handler = middleware(handler, "Scale")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAppResponseObject); ok {
-		if err := validResponse.VisitGetAppResponse(w); err != nil {
+	} else if validResponse, ok := response.(ScaleResponseObject); ok {
+		if err := validResponse.VisitScaleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2472,13 +13422,14 @@ func (sh *strictHandler) GetApp(w http.ResponseWriter, r *http.Request, appId Id
 	}
 }
 
-// CreateApp operation middleware
-func (sh *strictHandler) CreateApp(w http.ResponseWriter, r *http.Request, appId Id) {
-	var request CreateAppRequestObject
+// UpdateVolumes operation middleware
+func (sh *strictHandler) UpdateVolumes(w http.ResponseWriter, r *http.Request, appId Id, envId Id) {
+	var request UpdateVolumesRequestObject
 
 	request.AppId = appId
+	request.EnvId = envId
 
-	var body CreateAppJSONRequestBody
+	var body UpdateVolumesJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2486,18 +13437,42 @@ func (sh *strictHandler) CreateApp(w http.ResponseWriter, r *http.Request, appId
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateApp(ctx, request.(CreateAppRequestObject))
+		return sh.ssi.UpdateVolumes(ctx, request.(UpdateVolumesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateApp")
+		handler = middleware(handler, "UpdateVolumes")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateAppResponseObject); ok {
-		if err := validResponse.VisitCreateAppResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateVolumesResponseObject); ok {
+		if err := validResponse.VisitUpdateVolumesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCells operation middleware
+func (sh *strictHandler) GetCells(w http.ResponseWriter, r *http.Request) {
+	var request GetCellsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCells(ctx, request.(GetCellsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCells")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCellsResponseObject); ok {
+		if err := validResponse.VisitGetCellsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2581,6 +13556,39 @@ func (sh *strictHandler) GetEnv(w http.ResponseWriter, r *http.Request, envId Id
 	}
 }
 
+// UpdateEnv operation middleware
+func (sh *strictHandler) UpdateEnv(w http.ResponseWriter, r *http.Request, envId Id) {
+	var request UpdateEnvRequestObject
+
+	request.EnvId = envId
+
+	var body UpdateEnvJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateEnv(ctx, request.(UpdateEnvRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateEnv")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateEnvResponseObject); ok {
+		if err := validResponse.VisitUpdateEnvResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // CreateEnv operation middleware
 func (sh *strictHandler) CreateEnv(w http.ResponseWriter, r *http.Request, envId Id) {
 	var request CreateEnvRequestObject
@@ -2614,6 +13622,124 @@ func (sh *strictHandler) CreateEnv(w http.ResponseWriter, r *http.Request, envId
 	}
 }
 
+// GetSharedEnvVars operation middleware
+func (sh *strictHandler) GetSharedEnvVars(w http.ResponseWriter, r *http.Request, envId Id) {
+	var request GetSharedEnvVarsRequestObject
+
+	request.EnvId = envId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSharedEnvVars(ctx, request.(GetSharedEnvVarsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSharedEnvVars")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSharedEnvVarsResponseObject); ok {
+		if err := validResponse.VisitGetSharedEnvVarsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateSharedEnvVars operation middleware
+func (sh *strictHandler) UpdateSharedEnvVars(w http.ResponseWriter, r *http.Request, envId Id) {
+	var request UpdateSharedEnvVarsRequestObject
+
+	request.EnvId = envId
+
+	var body UpdateSharedEnvVarsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateSharedEnvVars(ctx, request.(UpdateSharedEnvVarsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateSharedEnvVars")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateSharedEnvVarsResponseObject); ok {
+		if err := validResponse.VisitUpdateSharedEnvVarsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UnlockEnv operation middleware
+func (sh *strictHandler) UnlockEnv(w http.ResponseWriter, r *http.Request, envId Id) {
+	var request UnlockEnvRequestObject
+
+	request.EnvId = envId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UnlockEnv(ctx, request.(UnlockEnvRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UnlockEnv")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UnlockEnvResponseObject); ok {
+		if err := validResponse.VisitUnlockEnvResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// LockEnv operation middleware
+func (sh *strictHandler) LockEnv(w http.ResponseWriter, r *http.Request, envId Id) {
+	var request LockEnvRequestObject
+
+	request.EnvId = envId
+
+	var body LockEnvJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.LockEnv(ctx, request.(LockEnvRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LockEnv")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LockEnvResponseObject); ok {
+		if err := validResponse.VisitLockEnvResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Up operation middleware
 func (sh *strictHandler) Up(w http.ResponseWriter, r *http.Request) {
 	var request UpRequestObject
@@ -2672,27 +13798,152 @@ func (sh *strictHandler) WhoAmI(w http.ResponseWriter, r *http.Request) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xY32/bNhD+VwiuDxsmW0qaFp2elrTZYKArirbBgAVecBHPFluJZElKrhvofx9I+pds",
-	"xVG3OG2BPdmSjnfH7z7eR/KGZrJUUqCwhqY3VKNRUhj0D2fA3uDHCo11T5kUFoX/C0oVPAPLpYjfGync",
-	"O5PlWIL790jjhKb0h3jtOg5fTXyutdS0aZqIMjSZ5so5oamLRZbBmoiOhEUtoHiLukYdRh08h2VQEqKS",
-	"hWFEX0n7m6wEO3wKr6QlIZT7tjB33k6Vcj9KS4Xa8lCgTCNYZFfg05lIXbp/lIHFgeUl0ojauUKaUmM1",
-	"F1M3Fz9G6ivO7kpyxJx9XzsBJTrLnYAWoewdrVLsC2fURFTjx4prZDS9dOlGm7i0XK6TaeGwSH688i2v",
-	"32PmeXiqlEeaWyzNXVNwNWpWTkBrmLvnc1HfT+n+cykOje6tMJ6Luj+MDq8uGJdNYAtIyboni0v7/VMK",
-	"Zl1Jjzzc7fV5SoIbMuM2J0CUxgn/FJFKMNQmkxojAoKR46cECpWDqErUPCNZDhoyi9qQH10gMnrxE40o",
-	"foJSFS5sZVBfJUcnH6ZPnrIswdnEnLBpPXn/TF1/Nq4yCqxrTzSlf1/C4PP45yv3kwx+Gd8cP20edRHm",
-	"Qr2UUzeJVZwbWqIxMHV2ZxUvGDEWtEVXV8+AlB4nx48HR0eD4+TdUZI+TtIk+cs5a8O+crON0PPQH4mc",
-	"EJsjKeR02JVaCLY9+B0v0Vgo1dbwnlTdKeGfuTwtR/ez+r6oj3nj21ui/ICip68tuq6GbvaydbTW6twl",
-	"tdMUzCrN7fytixHQuEbQqE8rm+9W5Mx/Iz4sXSiS8xjGrHHKrVVB0LiYyKVQQuYBxhJ44RColJLa/ipF",
-	"iRaKIcOw0rn1a+AP95Kcvh7RiNaoTcggGSbDI2cmFQpQnKb08TAZJmFJ5H4GMSgew6JXT9EHdfX22uzW",
-	"Mf0dre/lUXuLc5wk9ybq3n+Hpr9BqznWSKAoyIZ74yb1JCTQ5XeVaNy1G9qsJU0v21W8HDdjZ7DCJb4B",
-	"pUasCQUu0OIuRC/8eydiDlkNJbp+5X1zNw+H9rLJp9T7o5vktLrCqCdWntnjnWKcdHRcpYipsgyNmVRF",
-	"MSchfb/KTsKA/eCtNm+HRTvax7uvh+i90ns/u8Umub+98qiqozzPfbd86Ar5Y86ZZPMvKk5bwpbishKv",
-	"Qs5QZ2BwufHI5yr3TXv/BuiWTVsT7FpkOjo0mUI5OqnUgxkb59WH6ay42NbetvL9tveAS9L7v0txUNRc",
-	"O8V1R/yHwyW+QVH3Uhy33++z+ry/B1Cc8zVg37nyfFVk75XmdynPBsm/K+V56Ar9rzy3kGmtPDtU+haV",
-	"pwq3gdJ0MOtC0X2lLqvCcgXaxq6AAwYW9lUblOp96gWd5bxuk+OaC9DzrgM1ivrfnYEXA6NlbuvA/ci0",
-	"3ZksfrIx1ijswFiNUPZnU7hh6eDThSokMKIxQ14jawsJCEau/e1LTBiqQs6X1zDDb5dys1xCyW/d7iyu",
-	"Wg4oA4sI+5SAi8A7LgWBa1lZf4kElc1RWBcV2eIq48CY3WXhPjuHoeG3Z/NaS1Zlfg4Xb17SiFa6WNyw",
-	"mDSOZ7PZsH2Bsu3gBdZYSOU3MNse0jguZAZFLo1NnyXPEtqMm38CAAD//8TQ/PYGGgAA",
+	"H4sIAAAAAAAC/+x9a3Mbt7LgX0HN3qrs7qVI+nkSfbqKrZx4j+O4JDves4mXB5xpkjiaASYAhjTj8n+/",
+	"1Q1gHhzwIVvyQ1L5gyUNno1+d6PxPklVUSoJ0prk+H2iwZRKGqBffuTZGfxZgbH4W6qkBUk/8rLMRcqt",
+	"UHL0b6Mk/s2kCyg4/vQfGmbJcfI/Rs3QI/fVjE61Vjr58OHDIMnApFqUOEhyjHOxMNmHQfJMWtCS5+eg",
+	"l6Bdr2tfQ5iUuVmZbzhIXij7k6pkdv1LeKEsc1PhN98cRzspS/yv1KoEbYU7oFQDt5BNOC1npnSBPyUZ",
+	"t3BkRQHJILHrEpLjxFgt5Bz3Qn2Unohs3yKfZdj+0HaSF4AtexNa4MXBs1VldskdfRgkGv6shIYsOf4d",
+	"lztow6UzZLOYDhz84t/WY6vpvyElPDwpS4K0sFCYfVvAM/pQD8K15msao7LKpDzH5R6/3zjw52ANswtg",
+	"KeQ5w2bAOCu1SsEYNgW7ApCsEHKigbDNMC4zVvB3zR+sYhcAJRPWML4EzefAKity8RfhJpPANc1huZ6D",
+	"NUP2uvVVGKYh51YsAUfCdhqMqnQKbmVhMdqRpxmyE8ty4MYyJcOgbhh3DsNksIGo7eXS70KKoiqS43s1",
+	"uIS0MAcit/Zu97d280/Sspq0Nj0pQaeeTrsQP/EQevLydQdKVjEuCjZTesBgOB+yv42H7NdCWKY0qwyw",
+	"MTaRyvpTUhKHSAbN8sY7lldAofT6cit0fbYsct/aXOc9y9sgng7gB91Ti1HHE8jzyPLZXKuqZGrGDDFS",
+	"RCNuGS9Lw7gGlkGZqzVkzKoepnw6u1mXsQ8xNkFj+B7btnduua1MhPVCnh/M1Uw9yK62TwksBUjrJ617",
+	"TjRwL192byusqp5yc4Rt2zycyWHrGJd7opX8P2rahxQvy4MBlaqi4DLr49QT9wGxXFeSrYRdsNFUyJFZ",
+	"sKM0KueUTCutQabrSalyka737szt4EnT8aXr92HwUZIW5PLapOyuls/VCnTKDZzk5YK/qIqf1+UCZOIV",
+	"iqzKoQ/gn8QSjmYC8oylWkkWWnpe+EcyZg/Y/8Z/fySxzSIQ/lIyMvKzkxcnDD8z/I5cAYXKxvgnBWiR",
+	"8tELWE3+qfRFbIqr0Q08OtbHU7OBGjYNGra2FUWo7ZpGlM624VcPZG+QXVrFMsVWC5CME9ILw7IK2Goh",
+	"cvCCGZZCVSZ8NVbkOZsrIedDxvNcrfALCfGCGZEBm67p/wEKkKnImLkQJX1nEqjxgCHD5ykwY1VpetMQ",
+	"3FCY/J7QBMkgcUMlg8T3TN72zqHe+1klI6xUFWUOl6WurQLAWK4vO1jDoMPudCUlfhwkpkpTgAxwizMu",
+	"csiSt1uHOJhTB6SLs+nOLnbg0lklL8G56z47+Pelh4uN1UiyTxQHXHK9nhgLpelTyUunP/E5GOIqms9m",
+	"ImWcuW5srkiB1aqaL9gUZkoDE05RVXkOGVOVZbMqz9dOl7KQIV0wDfMq55pl9S7wgGqYRFS8je37Za9A",
+	"zBd217qjy3bMweZrpiEFsQSTxPTKNEjt7uBOdQhcttkCqoTA00X4Qt09OFr62JA9s8hIaJAGUlXJZloV",
+	"2LNow2KfotAoMj0YfRaB2oXNL0oqq6RImcgiEEKlQkjGJWqqI5BLlqpiKiRp3tEzaBspsa9LAavDtb4z",
+	"1x57qjyf8vRiomYReVqv3XGj9hbsQpj27ziQYTgU45VVBbci5T2Ebzp4TX0FGuR3lvlDQrHBZTMCC+uL",
+	"AuX6lN1L+hKCJXDYMl5h62vUMhrPA3XfwfpbFthl1ItmK08WXM4hZpgZsFbIuTvlTMxmoBsnA6JUzi0Y",
+	"20Yh0rpRC8i5lMg0JQwdM/D6ONfAlMcm4ZU7P40wiEUGiPvQnKh9DNmpXLIl12zJ8wqcTcjzFV8bVnBz",
+	"EXMgkGba3xHpjqLgcxiwALZBcFigWNAM5HL49OTVyY8n56eT12fPY4wFtxPHN7Vfkrul7T6Rp2I2iyg+",
+	"dE6HS9zeCUf4qpv98DFfumP9tV50d8BN+9KvuJln975Pl3EHB0o9aQT+isyMtxDuuyB+ejjAU6t0f7A3",
+	"C8VSXhlweNqMjFZLAZbnfyTE5povhtHfWcEzYMIayGcDpuwC9EoYp1tDwUXu1lYZ0IhJ+GfJC5LaXLKT",
+	"l8+YVRcgt3pZLyneGhh4FtfnrRffmwksg7O+C4d/VFPQEiwY5po4ioN3Zc6F3ICNN7tWizV9KFVm3Gb7",
+	"MiUTGVGx5doeKvv/8b1xRx/B0B3s/WNFxwaWdgHZ4rQ1i3Wo1Dmm3Yh8VsvyLtBfLQC1Ba2WPGekO2Lf",
+	"CFp7wcpFELp1L1Q5kGVZSPEbyKXQSmKfPgVQH2gjx1SpHLgMzhNPbj3IOl3kkgjpO0Vo7jRQB2EV8ILx",
+	"rBCSrRaKhUU24PCU2QBjrwytN9paRLPB7nZ2H9x5z7IrQWbOstOQAzfuZ7c4//fa9vP2HqKQKkv6yWnp",
+	"7mgKZV07PlXa/5hymULe/pm6+VmPwrHT9A44UXNyQzFprd+tNGm0RFween1pSEem0RFVwYWMMeO0MlYV",
+	"bKGMJf5G6ElaMHP6MMpQ9gzVycqCCVEC/K5kCmwJWswEZAPnl+MsRZSdYWgMmDCm8igvohh9uDXYjLqX",
+	"RdBen7Q6fAaTI8APW8M7jk6N5DhZrVZD/9swVUVskkMncHB2AcfDIPBbu8eBimq9jY0Juwewn3f2jqCn",
+	"/8C7Umgwn+ipaegZUa356YodNpdwqEdg39f9EMQTH5CLEGVOoWgMyuVORGc0KNrkgdzQLJsC6tmcPXlx",
+	"8sspEmbjYjUQ8VpsxkkasbwLsGHGTwPoILHv7ERDqnQ2CYSyqcuBpigkzzLG2av/+4q59vg3WkUbGMlg",
+	"5xRkXfTn+A3/HGRXewYU0H6nZs8sASAfbyVutf42YRTZ0mADe7bj4CVsC7fXCGKcymXEi1dZNakFUOwc",
+	"7QJ0VJ9svErYNwiTvqZkmJfDje8uGcTUno9StWe8yu2kFcXbiDdhNL4btZxWIidDFu3elsvMuWKENtZF",
+	"OewC1pthTmrT0uyG7LQo7TrYy60vbMENk4r5FcatVYC/YLISMlOrw0/4J+r2hnrFzvlQKZSr9GJfy1O5",
+	"fI7Ndvnpy8P8Y6dy+VLXnrFSq7kGYyYZ8CwXEiYGUiWzmCfUfehq4QVHvzDpKej9DcO13MOIsGbIxqwA",
+	"7mInrGVZ+WNB9vF4PI76v2pVfjtZYLCl7XRDswBVrU0lkVXSipzxtopNZiqiJmnbsEQqMxdew6JIj+vt",
+	"eFgRJZnrzrLxjKvLJHYdXp8ie2jeBmyM4QWUi/oHEGch86psTWpIyKvFesiets4ChU9o32msoTFnKpkj",
+	"1nAZDmUJWovMyw3s3dN03ZCX4lK+y3S9xaQ7THFpRmkZwM1qtsDypd5h8U41l+mCzFZq1QEUcu7gOEac",
+	"JJvA+Vuqkh0d+T5D9rLf2XFaq7RkmVpJZ14QQ50rJrIcJtbmAWtqKuZd07ILd7fWKAQ3B4z5XDaA6UeL",
+	"9N0Cx9+47kvPrTyxVlkOCh661lsmPlwwoICPyf2QdLkZrc3ia4fQfvfaXbPoot+59MuXSkd8hqfvSmUA",
+	"2XmqpOVCgmal0hajeBTbDlGtIVtYWxJx4w+GWjnEonSkzDmjyX79ztRW74B6GOfPnusydf0GRNvs1fNz",
+	"7Pbw4YMhs2nZGhNoXTSoW87MsYWM5EpYFOPGiLk0Awzfpwv6e8bNYqq4zphZqJVxyO4icq51zBH+iQkg",
+	"ZRS2CHFkfG4vuAYl2/tMOXoAKwPs+zG6dR4+fODSw1xi2eNHjx48GuxJ08ORtmj9L7xTlYDVPdx6Ub1T",
+	"leAYOv0Z2w5owbxeNrWlg+TNecbYLMoV1TZ8cMhkQP9R7DXF33CIiNkTp8xms+7nJMwSw/uOYhZxz6wA",
+	"LvI1c4KQZRVO7NEo2xBccqvIGjBuUN0ULrrnJVwPvSCWfPWUr8Px4FoIsKTqqhnLuLPI/OpAZqZOGfpF",
+	"STb+4Xg8jqcM7XYEa/tJC6ERmqX8pAW793jrUg7OXnJBLBybJgeZEZRFcKifVgjO0Y+gcyFRsSCthg7n",
+	"9asnh1iFhCx4DDFU+Rl4bhdPFhBTdOjPrDJe7k6VXfhsXp4J6fSVjOViCfRLqdUUTOBXxAtr4jPO3VdJ",
+	"w3wuFOIOAkAYZsAO2a91tERYZvDY2d9PX9U5wrjhktsFssWaQt0CVAk0rJTOU46Mr+E1PYz8yJRA5kMe",
+	"DT8R0ljg2ZChBpMSrEpOUUJHFvBOWMPGUYuLi7zSMLELDWah8uhqpIG0oixq37y2KtoiS9DWUWBp0iMX",
+	"dKLrLqo82JtVLKSwgueTDHK+3m8DWeVsDD6zoDvrcXQSVkpgocit3bsEPN6IKMFDt4pQYhMjPI2M3J7/",
+	"GrJfZb4mXG1QJMqgQQuV7d9lCGTTLkwXpPfG+zf0sRKK5iOK4blRrBTpRTdznriGMK4hZHvZQLOSGBN4",
+	"lkVD+9bJBeeBLzXMxLsBq2QG2qRKe/3m/mPGUSmQFSVcsnTBNU8taMP+J07Enj39X8mg5b6uDOjJ+N7D",
+	"i/mjx1k6htXMPMzmy9m/vy+nfxmSb9xa0LiI//87P/rr7X9O8L/x0Q9v399//OE/Ygdahwcj+9gMYzI+",
+	"RR3f+zU3IsUhCt1nGlUnENY65ZwbOzEA8nA7rABj+Dyu9fpj6UdjBYoGmdURY8QH15h+dHsTxm3PU8ZL",
+	"lY2KNS/LoxVMjx5lP8yO3t1/97c/d0vPSErEjzy9+HU2Q13tJ3LAnbvkVufO3Zozv3kXSRcuqvmGaxnt",
+	"uoG39LVlZXrgNBAc+JNpH0MMxZ+r+am0WlwiOcJ3WcdMmfpbz5rZdbSEDh/nH/GNwujxLcaV9D2krZBl",
+	"5qHvVmImPZkGNB1yLtboXDoKqmmXdMdHPxy9/c8oxXbTROIJy3UIla1UlWeYvdwJSLRJ2xNuYx+FRGWK",
+	"KuJOY+kfPpIS9HTnZaidT+RFysH9RbpUlXikIvPpON1NvJYurIJfuxT7nWH/PPnl+cDlPa1ws/XCF+4C",
+	"Fv1RWL/1BV/G05x8flPj/m0WGlnnhZDbkp4al9WAriuKFJBSf3716uWZquxlUqY3cJcmbXx4DuoxDI7b",
+	"6ScbAvK6LNi441Vtwc2u4DYl8AsT/AA44tCZiCijc+DawjtLsBzdd2YlMmq5tgvEbshJ7m1Yix9pJe60",
+	"DF86/SEGZPw0EzkcGbvOW5rGugxXKlYwRYxYKX0BeshO8ryj6zdJcrpycDAoqSiZzrGa+m/g8Nj0vRG8",
+	"e7Vx5+XIVtOPv+njb735j3bhF/xd45kHZPSlEtLuIoFPQD4TW3Mb441XbBsI0yb6LiOvqxlPvrhrxDmf",
+	"ZnForhWRYTTPKnKDchxPY/bXPfdNdVY33ILRukldbQaNYfYZOGmxxcnYpIX0ThA/bfWfHuiDrJMe6sEG",
+	"O/ySYdcnBeousbuAZTVJlQbT1RZUNc1bfFhWxdTB298FLcT0AI9zM3in466FRpaYi0LYg8837NQvBcxH",
+	"dN2MQrgFtAaM7wAjAu20u7r8QN8rEDUg3viMxm7AOZaTNvCB/8YhvCtBLZKeueGbaiZE7uRjHG0TtJVf",
+	"rSSEYB9rZYRFUKEHovMF15C5yEKEFXmdwzBD7Sh1P89dFFvIDQ+hlxTnpy9enf1z8vT8xZDhhXc2B+tC",
+	"iRLloFu60AwjMkEWBEf2SkhUY8jZZMA2QgPJash+a1QfSbFKDbbSMuJ5xPZdbX9vyor2TGRCtBsTk2Xp",
+	"je8U1TJ0Yk+BhW7gfUESVvW2aKWGI6qAoEjriq8HGBagKJSmKzSUXONA6jBHaAepDgIdxLy7fHDHHmEn",
+	"yjURN8Q9RbkLwLxm2WxOVeE03eppT3w2g/TgBTdz7k0WdycaI/PX5XM176TJtSyx5MdK5BnzF+CSYIcl",
+	"98f3Hxzdu3d0f/zq3vj4wfh4PP5/yYdNNGoZdH0ZTfegZj5GOx9u8wRHgp6iAGN5UW50P9A07AHgN5VX",
+	"sXlegjbC0DqNVa4IAPJTyJiQjTHVV+KGzA3pEPgMePZGCwu/yhQC9obGGM1xZiSqUJxhAmwO4foEkYTQ",
+	"LOOWM1PppViCqUnGGZbCuP7O2soYz1UwTz1qRSpA4DYmcZ/hydSovLLgPMZWuT3TWEvaFeOBVY1wXdeh",
+	"2G3TtF8GxTqyKjySLotfwTS2OCP+gslcTPvDn4u/cBz2d/Fjc4R4QHOtVmxaWVfUYaGFvEh2h9jiGlkL",
+	"7q11xIjyzUKdFM+upsjM5e5pYePt9RzwisWBY22AoO7auX1Vz7YnhRXPDdJKC7tG75mXTlPgGvRJFcPj",
+	"H+lbfSmEVkaZN/T3Bk5kMVLxHyFnKhQV4s6xQhdPEAJViRbDf6GktjwfZrBMeuWCzmrneuOAiQQCg//Z",
+	"Bf0GqAUJGZiJsIa5XBsfOzODbrKLN1MejsfDP+QJ5bvQFh2i+km5XK/4mi6Ye70GCeVfv+Daj3716TFH",
+	"mKFzzKyu4F9sATwDPfxDEou35J6i5nizxiWdGrfL8XA8vIebVyVIXorkOHkwHA/HzoO1oHMZ8VKMuK/W",
+	"MwcCJWIxpd+ixzz5O1jUB5JBt8jV/fH4yso60fiRqk5nYLWAJQRVLAxPGvaj8XjbuPVCR7F6WG0MTY5/",
+	"7+Lm728/vMUGNVxG73lZPss+OLQlN1lEocjJO+eT/8kMB1QcKBkHEaUVcB6yZ7PaY4desKVnX7YRIHQb",
+	"1QkJq1TQGb3LbEoumZnQRUAy13biB0Ih0j1Ctz4ss4Qnr3kBFrShvQtcv2dzjpcktN+kzRIQ8QYHnqXj",
+	"JxEtAhfsCc5lceA+2xklAQ5mQb7AKcQEpbBDduYXxkQUkMnA7erPCvS62VYXSEl7P5uZfh/e9pD9YVRL",
+	"ZlTkwBi6DR+Wi8j58BDkbNWJoy4P93epK6pdLwEMdrGCz4VEb6+X4+xmOLLNb76+4ymryPE8IbH8uU+I",
+	"EPhHla0vdThx33atJdWRmhCocUGZ5CAPcUQnce06yHTvupHJHUcUlS7NHD6zsBuBXJrRe5BL/MV7gejY",
+	"lLFRTugyp/s3N4XpOWx23NwMks5nV6BF7rIq0AT37uEmp9vsTepuLyd1B4ELJSlS30M2jKeWcR9Gq+9j",
+	"1p4Blw3eJTW/46ed/NnPJFkjA9NBfTFa3u2oiXsmoyR5dfy97W7pU2bztfZw3gChfQAhd8NOXohsikCq",
+	"RuWpoekQ8580nlHSeRtvB9LXG6q31dTkDGOBIY3Y+00YJTYgm6jK4GNz2cgUsGovwCrMZg+XnzibiXeQ",
+	"dcfpq76vKcTdDqLdYkK9qiDkIV6f+rjj+noGMyE9KqCRVHvkDnEEXq84P5h31J479IS3MTWIDiSKPyuo",
+	"bgl7cVf8R3Spf7uycE5Jr2jRhxJe7fuMddG8zVI6ZE9jCzcLmdZ9sYxTP6krDXz7dP5FxKMDIKNzvIX4",
+	"6wpUwD4MbnDxO+PCWMZCiXkjdtEuUGcVE5Z8h66qmLBNAT2XGIU8MOfGDdDD6ZduOTcJq69CeiH8Ikdz",
+	"IUqfvF9wQbegEagu/tIE+ByC18cQuex5mND5zBTpMFMoecuoMoV8OzU+oe8x23PBqY7aTEhhFpDV5iSe",
+	"e8CHVirlHjHUI0w38Z0J2KfNS6V8uPPdmfIRPAmuUK+Q9f3z4UHJH1+YclPIbxW9hgKr+81LalqXTOoU",
+	"VN1pWobskxbBUx60kqRXulyDYtDUYAzU7G9itS9KtxZS1/BkmaayVe2rpb6oxjYL0xWDv+MA3TL/JlaG",
+	"le7OOYi3KqEdmkjzLNubQFNP/vZrtRw9i0McdoC4rRajVvLo37569raQU11h+86i21tV3MQDEUoyhHKg",
+	"N1+hzpfQuRHxyi26IZEVFT/wMHBMnqRF7b5xuftctjP+Zq3AdKin1M6NbaA3iEbh/HncSYRPvI98/U+U",
+	"3LinQS5/fX77Sx6fW4LWjyNs52JBVt4uATl6j4e0kYUUy/K5KYwnPnB9X+Zjxt1KxYfl+9QIeHPyfC6P",
+	"gCNdyba21sufqbS/kKohbQyzWUsED1DvBWNd8b9ksFXjo1da7vD4k/D4yjVMOpRo6lTnuMmq9gd+ewll",
+	"9F5X8gX9mqv5IVbOWSWfq/kd2l8K7eOTedDvnG9TL7pOAmoVZ4jQD556IBxdyVtBMxmUIDOQqYADPYa8",
+	"vpnmvYZuCHL97fIYPt2sGhoSxJyF116IT7EORl9j6YViP+5GG8aSMCltiz/waXtrd0ZgExjoHHi/WhDR",
+	"gEvro7P+CA/hDmNtp9uws7i330TSSQdvb6kP0ZH59vjg03ADj5UaXDFq5/Cp7/sMGqD6F5g2HED9150Q",
+	"QWHIzq0GXpiNVFPsgdzcVSHuhA8GLBcXwKoydkkD+z8rXL2fO4bhvEY7XzIk19GRAQQWYn4Zf9mQ7pXn",
+	"wP0TRc3pm/r1wNonc288eDT+I2nqZbhjZZKKOrVTBVr+qMu8aUyzR1w6YVFuPr+e+SLVQ6FGPC0AyeB4",
+	"eW94nynd+/BfZsHvP3p8PBwO2QkrhDGUMcnnvhy3w+D99amLeNmlQyKoWPtlROW5jgyRxeHMz91r3s33",
+	"+tzNvblIlEa3z2lSyGJkd4sYIek4o/fNL9SufvsqarY/F8bXlPIvWLaf/NqoHjdgKs92Wu8bz5jdVFum",
+	"DeFDbIwmQeFTjYxLFhzY8qJYn9pebUoxjzbfHiWEZwOjCoGvn25c7bGm+pq3KEgXCCUgan0glGYbMNwJ",
+	"1z5JwcaUg8ZkGNS11tvTuHpYrbdEMUZg2AWAy13zdc9CyYTN8SOqg5jN7vKQ9psbV2s+YH1Ah7+Tur7V",
+	"YYXj2wXbI8O6Oq+TNJQr3jVWu7LxlWgXwwdban379PyDH8h0PWL788rYpBUS7E3YrsLV9wfgFxJMtTsg",
+	"U5jq524VtMrutK8V7K6zbsBO8C2v5e7yQP6NJaU9izjUKPVPGkSgUcndU9dWMbTWoKFQy8s9VBUuPh96",
+	"gL7WSsxq/kL5dcjmYnKrrlTYfpSa+HVav/d687W/5uGqbS7m8LbVXR7Nvve84mk07rlHD+hbl0tzktGj",
+	"UGkbCu0nLRsgUEnvplhF8+5e/VhU70HMLVk0T8NLcncKDWH6Rz6VuWHl16N8dienO86YoU9fULQGu779",
+	"hiYzfG381fCnL859AUITXjYU9jZx+NH7cH4HZIPcEAKKD9x6cfVTInyRVA+Pjrcp0aOPXSNHXjtu3tB7",
+	"EcTC21TpBaMbEIs2tJ46jjJ/8ultvn7cfQAZvZr+SUz3BIkwVOGNSwYIgkFryu/izMM/jjkjaG+KG3p0",
+	"dn1HLZ8zHr5XGtRRGQ3GPx5pwxs0t4Img3/hqPYv7I+Qhz6+bnZdtWFXeHxLDLvtp7gLYree374Gr8+G",
+	"irYxx7cRjd5AvVsaj3beu6Pae7efZF0PL9gOIlgXnWz7CRun0A6CbjsL78g52FQf6239NuqSdJDrlpJk",
+	"x3u9nx7bj4IcKEHZa0OV3oDep8mFsb1yRb5eND4v4ifYRqYvW07rOyINrzJcVfxh8824euRvQ8jW673F",
+	"xLy7PEtI+Gpf7XMZhUi6LbetezsLzVBhutFalyiWudqD7r55O/mLX/jKVTT8oPVIZ/16i6vsEjJHXTKK",
+	"X3lG39ztdIz5mnoB35nWgwoy83mQIQY93FYa5otV9cWomFVhX8yqZBCb+hsqTfEsq90XsXh+e7d4iP2q",
+	"FUo2qcN4epDEgo3Yl0J+H1Wfvd37q2Vat5Q7uerv25nTGX2/ikqpRvl+NjwRQ+VsrqZQqtvGp9dJddu9",
+	"y0351sukhkcNbgkNU37KUSs/Zb/R4DvV734fZja8ivRsihO0nuWuTHgbApXASMUCH1ylh5aQE6hq0yrx",
+	"z6Ee4ig4c0vyV/PvzJCgPUQyl3YWM4gcis+9Go4KMdfcAqvKvQHbzWm/DUtlE69vrUZA1czbKsGmjHQN",
+	"bl5azufCQA/BjhJzS5FN5Tm6nHZgW2hxx9Q/wSQMzwV6916y91mx7iRfFwMPKHFHPyOqWL6deM7p8x3l",
+	"dL2yO+vAX00N+F0J4S/ogWAk0tDGq1+Xe/ivnuDrIk5CuTvKHLXS1/cbY77xgUbYb/UDbNzSVcIcZq5I",
+	"N/4iFcPnx0CHJ0UH9L5kuK0Tnmxztw7rZNj6AbdtJtZv9Ttkd8yETvUK7ye0yToM+23YTAFxbzSR1xWC",
+	"t9bCoQbXWcuIJtj34mS/Ru3ngQ/yvV3gOZXLa4UOjb8POC1ftPmMcAnyYH/q86lcHsRcr8XyfRi9QhYA",
+	"tuPZyG/hDcgvCtkrRfN9b0C2kPzrO56S23QRy8rmcg6bNUmYK5TqNaLWvrZeQxaauWKp1l1I3pYk+pmR",
+	"4apevpq0XSV9ezhkm2etwlHtByI2o3eYWh7ivk2dqCLysgjiG8WMJ626ITGXA3fPUrTkj5uKLr1yDZ1y",
+	"+diEKjK4urBUoyrWpnPyJxuhARcXCKhDi4yZYu7V64l/9bq//DcAF/k6vIrNsooGd9d32vDsL6nzgPaQ",
+	"dXT6gIx+2EiQEwOX7sZngP1BiuRPtJ03NGzs4mx4bGKSAc9yIWFiIFUyVtz+3H3oRncLvsZMsJBbEoZr",
+	"XYdDXDMDNgW7ApDswZj0vgePx+MhG7O5gi7u/aOagpZgwYRTQiR5PB5Hcw3q8HF/uT+rPNt4SIHe9PNx",
+	"6KM6Du2MG96C9t6IchGeVqTTK3Yfl7BfwRM8W+RBW25XxPJuxgvPO54Q/hZ5+rf9hPAW3GueEO5pIl/j",
+	"E8LdyytyeRQqGuypOiRDiYOQZoX9mFlQmZXpOjz/33pvtIZFpP7QOfVzBRfMTVBUuxvaUrinBZTvatgF",
+	"WH6TyuulEGHITvDvpUgvnLbgBEtV1v4Vod0rfZ1SVpppMV9Yxld87VoG1+AWdffLIdfV5C801RI3rR73",
+	"pamy2i+AWotBGwpueCjz2QxSa/wZ0FcDtvUWU11PqT5T1AkM5PRouAas3eIGgSyqMt/w6iyfW9XZy1HO",
+	"u/wjnN8NckJ2ZFWugh0YPEpdcLyW2ML0eA4WKnDWUG3tuDfq8GjWW3Ren33FGY7JjCpASWCQG2BlZZnq",
+	"P2vrpr/ZbpeOmk37vSl6djQP+HkEnwbe9O2+NWRcoblOWcsaQJuY8vwL4MnVCCbuW2yWdlr3pI8wzO2+",
+	"LtoqZCoy/PTw/h8Jcv8/koXKRcbXJva0zldoWN4YfA/stSq3Z1G8LpNdeFNUuRUl13aEttwRRld3OvPK",
+	"8uDiu1ynC7Hs2olT4R9W7tf1vYbiwxTkA22Ekl+sBPHhF08GSemKZU6mmsuYwu4CmZ1nc2HVIVfSAIVh",
+	"bgQmpLHAM68yTUQ2cMFOd9cK00OEZZkCeroX3gnj/JSuELfzcHbzsIRllP1HVx8ilbw7WdLCFaYS0k8e",
+	"r3XotiyyHCbW5tvdfT+rFeUGbN37huuv4xZs3H/tF0kbL+DjsXMD3n/0w/3xeNy9anTvb/e/j/r7NusI",
+	"hF16KmlI4Osp8vy6zBXPmIYUxBKybnAMIUB38dgo0AGdNiZWfLV+kNVC8UJsDeG+WaiT4tl1RnD9DLui",
+	"W0I6DoiMiE8RO8nyq+wCpMVZKWZwAfK6YbavBX7Wy6DH9PLMsiqlPbw+e54MkkrnyXGysLY0x6MRFkZD",
+	"SrQ8H2aAxfn67GsJuSqJIDdHOB6hXcDzhTL2+Pvx9+Pkw9sP/z0AIsIyI/ndAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -51,9 +51,13 @@ func MustOpen(host, user, password, dbname string, port int, sslmode string, tp
 		&store.AppSettings{},
 		&store.Env{},
 		&store.AppEnvVars{},
+		&store.EnvVarSet{},
 		&store.Deployment{},
+		&store.DeploymentEvent{},
 		&store.ApiToken{},
 		&store.Build{},
+		&store.CronJob{},
+		&store.Domain{},
 	)
 	if err != nil {
 		panic(err)
@@ -54,6 +54,10 @@ func TestDB(t *testing.T) {
 
 	buildStore := dbstore.NewBuildStore(db)
 
+	cronJobStore := dbstore.NewCronJobStore(db)
+
+	domainStore := dbstore.NewDomainStore(db)
+
 	testSuite := store.NewStoreTestSuite(store.TestStoresConfig{
 		WaitlistStore:   waitlistStore,
 		UserStore:       userStore,
@@ -64,6 +68,8 @@ func TestDB(t *testing.T) {
 		DeploymentStore: deploymentStore,
 		ApiTokenStore:   apiTokenStore,
 		BuildStore:      buildStore,
+		CronJobStore:    cronJobStore,
+		DomainStore:     domainStore,
 	})
 	testSuite(t)
 }
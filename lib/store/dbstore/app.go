@@ -72,12 +72,18 @@ func (s *AppStore) CreateAppSettings(opts store.CreateAppSettingsOptions) (store
 		Common: store.Common{
 			Id: tid.String(),
 		},
-		TeamId:        opts.TeamId,
-		AppId:         opts.AppId,
-		Artifact:      datatypes.NewJSONType(opts.Artifact),
-		Ports:         datatypes.NewJSONType(opts.Ports),
-		ExternalPorts: datatypes.NewJSONType(opts.ExternalPorts),
-		Resources:     datatypes.NewJSONType(opts.Resources),
+		TeamId:         opts.TeamId,
+		AppId:          opts.AppId,
+		Artifact:       datatypes.NewJSONType(opts.Artifact),
+		Ports:          datatypes.NewJSONType(opts.Ports),
+		ExternalPorts:  datatypes.NewJSONType(opts.ExternalPorts),
+		Resources:      datatypes.NewJSONType(opts.Resources),
+		HealthCheck:    datatypes.NewJSONType(opts.HealthCheck),
+		ReleaseCommand: opts.ReleaseCommand,
+		Processes:      datatypes.NewJSONType(opts.Processes),
+		Autoscaling:    datatypes.NewJSONType(opts.Autoscaling),
+		Volumes:        datatypes.NewJSONType(opts.Volumes),
+		Dependencies:   datatypes.NewJSONType(opts.Dependencies),
 	}
 	return appSettings, s.db.Create(&appSettings).Error
 }
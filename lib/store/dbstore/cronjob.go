@@ -0,0 +1,81 @@
+package dbstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/onmetal-dev/metal/lib/validate"
+	"go.jetify.com/typeid"
+	"gorm.io/gorm"
+)
+
+type CronJobStore struct {
+	db *gorm.DB
+}
+
+var _ store.CronJobStore = &CronJobStore{}
+
+func NewCronJobStore(db *gorm.DB) *CronJobStore {
+	return &CronJobStore{db: db}
+}
+
+func (s *CronJobStore) Create(ctx context.Context, opts store.CreateCronJobOptions) (store.CronJob, error) {
+	if err := validate.Struct(opts); err != nil {
+		return store.CronJob{}, err
+	}
+	tid, _ := typeid.WithPrefix("cronjob")
+	cronJob := store.CronJob{
+		Common: store.Common{
+			Id: tid.String(),
+		},
+		TeamId:            opts.TeamId,
+		AppId:             opts.AppId,
+		EnvId:             opts.EnvId,
+		Name:              opts.Name,
+		Schedule:          opts.Schedule,
+		Command:           opts.Command,
+		Timezone:          opts.Timezone,
+		ConcurrencyPolicy: opts.ConcurrencyPolicy,
+	}
+	if err := s.db.WithContext(ctx).Create(&cronJob).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return store.CronJob{}, fmt.Errorf("cron job %s already exists", opts.Name)
+		}
+		return store.CronJob{}, fmt.Errorf("failed to create cron job: %w", err)
+	}
+	return s.GetByName(ctx, opts.AppId, opts.EnvId, opts.Name)
+}
+
+func (s *CronJobStore) GetByName(ctx context.Context, appId, envId, name string) (store.CronJob, error) {
+	var cronJob store.CronJob
+	if err := s.db.WithContext(ctx).Preload("App").Preload("Env").
+		Where("app_id = ? AND env_id = ? AND name = ?", appId, envId, name).
+		First(&cronJob).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return store.CronJob{}, store.ErrCronJobNotFound
+		}
+		return store.CronJob{}, fmt.Errorf("failed to get cron job: %w", err)
+	}
+	return cronJob, nil
+}
+
+func (s *CronJobStore) GetForAppEnv(ctx context.Context, appId, envId string) ([]store.CronJob, error) {
+	var cronJobs []store.CronJob
+	if err := s.db.WithContext(ctx).Preload("App").Preload("Env").
+		Where("app_id = ? AND env_id = ?", appId, envId).
+		Order("name ASC").
+		Find(&cronJobs).Error; err != nil {
+		return nil, fmt.Errorf("failed to get cron jobs: %w", err)
+	}
+	return cronJobs, nil
+}
+
+// Delete hard deletes the cron job so that its name can be reused
+func (s *CronJobStore) Delete(ctx context.Context, id string) error {
+	if err := s.db.WithContext(ctx).Unscoped().Where("id = ?", id).Delete(&store.CronJob{}).Error; err != nil {
+		return fmt.Errorf("failed to delete cron job: %w", err)
+	}
+	return nil
+}
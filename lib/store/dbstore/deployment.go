@@ -6,6 +6,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io"
+	"time"
 
 	"filippo.io/age"
 	"github.com/onmetal-dev/metal/lib/store"
@@ -54,6 +55,45 @@ func (s *DeploymentStore) CreateEnv(opts store.CreateEnvOptions) (store.Env, err
 	return env, s.db.Create(&env).Error
 }
 
+func (s *DeploymentStore) UpsertPreviewEnv(opts store.UpsertPreviewEnvOptions) (store.Env, bool, error) {
+	if err := validate.Struct(opts); err != nil {
+		return store.Env{}, false, err
+	}
+	name, err := store.PreviewEnvName(opts.Branch)
+	if err != nil {
+		return store.Env{}, false, err
+	}
+	var env store.Env
+	var created bool
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where(&store.Env{TeamId: opts.TeamId, Name: name}).First(&env).Error
+		if err == gorm.ErrRecordNotFound {
+			tid, _ := typeid.WithPrefix("env")
+			env = store.Env{
+				Common:                store.Common{Id: tid.String()},
+				TeamId:                opts.TeamId,
+				Name:                  name,
+				DefaultCellId:         opts.DefaultCellId,
+				PreviewBranch:         opts.Branch,
+				PreviewIdleTTLSeconds: opts.IdleTTLSeconds,
+			}
+			created = true
+			return tx.Create(&env).Error
+		} else if err != nil {
+			return err
+		}
+		if env.PreviewBranch != opts.Branch {
+			return fmt.Errorf("%w: %s is not the preview env of branch %s", store.ErrEnvNotPreview, name, opts.Branch)
+		}
+		env.PreviewIdleTTLSeconds = opts.IdleTTLSeconds
+		return tx.Model(&env).Select("PreviewIdleTTLSeconds").Updates(store.Env{PreviewIdleTTLSeconds: opts.IdleTTLSeconds}).Error
+	})
+	if err != nil {
+		return store.Env{}, false, err
+	}
+	return env, created, nil
+}
+
 func (s *DeploymentStore) GetEnv(id string) (store.Env, error) {
 	env := store.Env{Common: store.Common{Id: id}}
 	if err := s.db.First(&env).Error; err != nil {
@@ -70,39 +110,119 @@ func (s *DeploymentStore) GetEnvsForTeam(teamId string) ([]store.Env, error) {
 	return envs, s.db.Where(&store.Env{TeamId: teamId}).Find(&envs).Error
 }
 
+func (s *DeploymentStore) UpdateEnv(id string, opts store.UpdateEnvOptions) error {
+	if err := validate.Struct(opts); err != nil {
+		return err
+	}
+	return s.db.Model(&store.Env{Common: store.Common{Id: id}}).
+		Select("AutoRollback", "ProgressDeadlineSeconds", "DefaultCellId", "FreezeWindows", "Protected").
+		Updates(store.Env{
+			AutoRollback:            opts.AutoRollback,
+			ProgressDeadlineSeconds: opts.ProgressDeadlineSeconds,
+			DefaultCellId:           opts.DefaultCellId,
+			FreezeWindows:           datatypes.NewJSONType(opts.FreezeWindows),
+			Protected:               opts.Protected,
+		}).Error
+}
+
+func (s *DeploymentStore) LockEnv(id string, opts store.LockEnvOptions) error {
+	if err := validate.Struct(opts); err != nil {
+		return err
+	}
+	return s.db.Model(&store.Env{Common: store.Common{Id: id}}).
+		Select("LockedBy", "LockReason", "LockedAt").
+		Updates(store.Env{LockedBy: opts.LockedBy, LockReason: opts.Reason, LockedAt: lo.ToPtr(time.Now())}).Error
+}
+
+func (s *DeploymentStore) UnlockEnv(id string) error {
+	return s.db.Model(&store.Env{Common: store.Common{Id: id}}).
+		Select("LockedBy", "LockReason", "LockedAt").
+		Updates(store.Env{}).Error
+}
+
 func (s *DeploymentStore) DeleteEnv(id string) error {
 	return s.db.Delete(&store.Env{Common: store.Common{Id: id}}).Error
 }
 
 func (s *DeploymentStore) CreateAppEnvVars(opts store.CreateAppEnvVarOptions) (store.AppEnvVars, error) {
-	public, _, err := s.getTeamKeys(opts.TeamId)
+	encryptedEnvVars, err := s.encryptEnvVars(opts.TeamId, opts.EnvVars)
 	if err != nil {
 		return store.AppEnvVars{}, err
 	}
+	tid, _ := typeid.WithPrefix("appenvvars")
+	appEnvVars := store.AppEnvVars{
+		Common:  store.Common{Id: tid.String()},
+		TeamId:  opts.TeamId,
+		EnvId:   opts.EnvId,
+		AppId:   opts.AppId,
+		EnvVars: datatypes.NewJSONType(encryptedEnvVars),
+	}
+	return appEnvVars, s.db.Create(&appEnvVars).Error
+}
+
+func (s *DeploymentStore) CreateEnvVarSet(opts store.CreateEnvVarSetOptions) (store.EnvVarSet, error) {
+	if err := validate.Struct(opts); err != nil {
+		return store.EnvVarSet{}, err
+	}
+	encryptedEnvVars, err := s.encryptEnvVars(opts.TeamId, opts.EnvVars)
+	if err != nil {
+		return store.EnvVarSet{}, err
+	}
+	tid, _ := typeid.WithPrefix("envvarset")
+	envVarSet := store.EnvVarSet{
+		Common:  store.Common{Id: tid.String()},
+		TeamId:  opts.TeamId,
+		EnvId:   opts.EnvId,
+		EnvVars: datatypes.NewJSONType(encryptedEnvVars),
+	}
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&envVarSet).Error; err != nil {
+			return err
+		}
+		return tx.Model(&store.Env{Common: store.Common{Id: opts.EnvId}}).Update("env_var_set_id", envVarSet.Id).Error
+	})
+	if err != nil {
+		return store.EnvVarSet{}, err
+	}
+	envVarSet.EnvVars = datatypes.NewJSONType(opts.EnvVars)
+	return envVarSet, nil
+}
+
+func (s *DeploymentStore) GetEnvVarSet(id string) (store.EnvVarSet, error) {
+	var envVarSet store.EnvVarSet
+	if err := s.db.First(&envVarSet, "id = ?", id).Error; err != nil {
+		return store.EnvVarSet{}, err
+	}
+	envVars, err := s.decryptEnvVars(envVarSet.TeamId, envVarSet.EnvVars.Data())
+	if err != nil {
+		return store.EnvVarSet{}, err
+	}
+	envVarSet.EnvVars = datatypes.NewJSONType(envVars)
+	return envVarSet, nil
+}
+
+// encryptEnvVars encrypts the values of env vars with the team's public key
+func (s *DeploymentStore) encryptEnvVars(teamId string, envVars []store.EnvVar) ([]store.EnvVar, error) {
+	public, _, err := s.getTeamKeys(teamId)
+	if err != nil {
+		return nil, err
+	}
 	recipient, err := age.ParseX25519Recipient(public)
 	if err != nil {
-		return store.AppEnvVars{}, err
+		return nil, err
 	}
-	encryptedEnvVars := make([]store.EnvVar, len(opts.EnvVars))
-	for i, envVar := range opts.EnvVars {
+	encryptedEnvVars := make([]store.EnvVar, len(envVars))
+	for i, envVar := range envVars {
 		encryptedValue, err := ageEncryptValue(envVar.Value, recipient)
 		if err != nil {
-			return store.AppEnvVars{}, err
+			return nil, err
 		}
 		encryptedEnvVars[i] = store.EnvVar{
 			Name:  envVar.Name,
 			Value: encryptedValue,
 		}
 	}
-	tid, _ := typeid.WithPrefix("appenvvars")
-	appEnvVars := store.AppEnvVars{
-		Common:  store.Common{Id: tid.String()},
-		TeamId:  opts.TeamId,
-		EnvId:   opts.EnvId,
-		AppId:   opts.AppId,
-		EnvVars: datatypes.NewJSONType(encryptedEnvVars),
-	}
-	return appEnvVars, s.db.Create(&appEnvVars).Error
+	return encryptedEnvVars, nil
 }
 
 func ageEncryptValue(value string, recipient *age.X25519Recipient) (string, error) {
@@ -139,32 +259,56 @@ func ageDecryptValue(encryptedValue string, identity *age.X25519Identity) (strin
 	return decryptedValue.String(), nil
 }
 
-func (s *DeploymentStore) decryptAppEnvVars(appEnvVars *store.AppEnvVars) error {
-	_, private, err := s.getTeamKeys(appEnvVars.TeamId)
+// decryptEnvVars decrypts the values of env vars with the team's private key
+func (s *DeploymentStore) decryptEnvVars(teamId string, envVars []store.EnvVar) ([]store.EnvVar, error) {
+	_, private, err := s.getTeamKeys(teamId)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	identity, err := age.ParseX25519Identity(private)
 	if err != nil {
-		return fmt.Errorf("failed to parse private key: %v", err)
+		return nil, fmt.Errorf("failed to parse private key: %v", err)
 	}
 
-	decryptedEnvVars := make([]store.EnvVar, len(appEnvVars.EnvVars.Data()))
-	for i, envVar := range appEnvVars.EnvVars.Data() {
+	decryptedEnvVars := make([]store.EnvVar, len(envVars))
+	for i, envVar := range envVars {
 		decryptedValue, err := ageDecryptValue(envVar.Value, identity)
 		if err != nil {
-			return fmt.Errorf("failed to decrypt env var: %v", err)
+			return nil, fmt.Errorf("failed to decrypt env var: %v", err)
 		}
 		decryptedEnvVars[i] = store.EnvVar{
 			Name:  envVar.Name,
 			Value: decryptedValue,
 		}
 	}
+	return decryptedEnvVars, nil
+}
 
+func (s *DeploymentStore) decryptAppEnvVars(appEnvVars *store.AppEnvVars) error {
+	decryptedEnvVars, err := s.decryptEnvVars(appEnvVars.TeamId, appEnvVars.EnvVars.Data())
+	if err != nil {
+		return err
+	}
 	appEnvVars.EnvVars = datatypes.NewJSONType(decryptedEnvVars)
 	return nil
 }
 
+// decryptDeployment decrypts the app's env vars of a deployment and the env vars it shares with the env's other apps, if there are any
+func (s *DeploymentStore) decryptDeployment(deployment *store.Deployment) error {
+	if err := s.decryptAppEnvVars(&deployment.AppEnvVars); err != nil {
+		return err
+	}
+	if deployment.EnvVarSet.Id == "" {
+		return nil
+	}
+	decryptedEnvVars, err := s.decryptEnvVars(deployment.EnvVarSet.TeamId, deployment.EnvVarSet.EnvVars.Data())
+	if err != nil {
+		return err
+	}
+	deployment.EnvVarSet.EnvVars = datatypes.NewJSONType(decryptedEnvVars)
+	return nil
+}
+
 func (s *DeploymentStore) GetAppEnvVars(id string) (store.AppEnvVars, error) {
 	var appEnvVars store.AppEnvVars
 	if err := s.db.First(&appEnvVars, "id = ?", id).Error; err != nil {
@@ -207,11 +351,48 @@ func (s *DeploymentStore) Create(opts store.CreateDeploymentOptions) (store.Depl
 		Replicas:      opts.Replicas,
 		AppSettingsId: opts.AppSettingsId,
 		AppEnvVarsId:  opts.AppEnvVarsId,
+		RollbackOf:    opts.RollbackOf,
+		RequestedBy:   opts.RequestedBy,
 		Cells: lo.Map(opts.CellIds, func(cellId string, _ int) store.Cell {
 			return store.Cell{Common: store.Common{Id: cellId}}
 		}),
 	}
-	if err := s.db.Create(&deployment).Error; err != nil {
+	if len(opts.CanarySteps) > 0 {
+		deployment.CanarySteps = datatypes.NewJSONType(opts.CanarySteps)
+		deployment.CanaryWeight = opts.CanarySteps[0]
+	}
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		env := store.Env{Common: store.Common{Id: opts.EnvId}}
+		if err := tx.First(&env).Error; err != nil {
+			return err
+		}
+		deployment.EnvVarSetId = lo.CoalesceOrEmpty(opts.EnvVarSetId, env.EnvVarSetId)
+		reason := fmt.Sprintf("%s created", deployment.Type)
+		if err := env.CheckUnlocked(time.Now()); err != nil {
+			if !opts.OverrideLock {
+				return err
+			}
+			reason = fmt.Sprintf("%s, overriding the lock (%s)", reason, err)
+		}
+		// automatic rollbacks restore what was running before a failed deployment, so they don't wait for approval
+		if env.Protected && opts.RollbackOf == 0 {
+			deployment.Status = store.DeploymentStatusPendingApproval
+			deployment.StatusReason = "waiting for a team admin to approve it"
+		}
+		if err := tx.Create(&deployment).Error; err != nil {
+			return err
+		}
+		return tx.Create(&store.DeploymentEvent{
+			TeamId:       deployment.TeamId,
+			AppId:        deployment.AppId,
+			EnvId:        deployment.EnvId,
+			DeploymentId: deployment.Id,
+			Status:       deployment.Status,
+			Reason:       reason,
+			Actor:        lo.CoalesceOrEmpty(opts.Actor, store.SystemActor),
+		}).Error
+	})
+	if err != nil {
 		return store.Deployment{}, err
 	}
 	// do this to populate things. Otherwise code needs to assume popualted vs. not depending on which methods are used
@@ -219,15 +400,18 @@ func (s *DeploymentStore) Create(opts store.CreateDeploymentOptions) (store.Depl
 }
 
 func (s *DeploymentStore) preloadDeployment(query *gorm.DB) *gorm.DB {
-	return query.Preload("Env").Preload("App").Preload("AppSettings").Preload("AppEnvVars").Preload("Cells")
+	return query.Preload("Env").Preload("App").Preload("AppSettings").Preload("AppEnvVars").Preload("EnvVarSet").Preload("Cells")
 }
 
 func (s *DeploymentStore) Get(appId string, envId string, id uint) (store.Deployment, error) {
 	deployment := store.Deployment{Id: id, AppId: appId, EnvId: envId}
 	if err := s.preloadDeployment(s.db).First(&deployment).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return store.Deployment{}, store.ErrDeploymentNotFound
+		}
 		return store.Deployment{}, err
 	}
-	if err := s.decryptAppEnvVars(&deployment.AppEnvVars); err != nil {
+	if err := s.decryptDeployment(&deployment); err != nil {
 		return store.Deployment{}, err
 	}
 	return deployment, nil
@@ -243,7 +427,7 @@ func (s *DeploymentStore) GetForTeam(ctx context.Context, teamId string) ([]stor
 		return nil, err
 	}
 	for i := range deployments {
-		if err := s.decryptAppEnvVars(&deployments[i].AppEnvVars); err != nil {
+		if err := s.decryptDeployment(&deployments[i]); err != nil {
 			return nil, err
 		}
 	}
@@ -261,7 +445,7 @@ func (s *DeploymentStore) GetForApp(ctx context.Context, appId string) ([]store.
 		return nil, err
 	}
 	for i := range deployments {
-		if err := s.decryptAppEnvVars(&deployments[i].AppEnvVars); err != nil {
+		if err := s.decryptDeployment(&deployments[i]); err != nil {
 			return nil, err
 		}
 	}
@@ -279,7 +463,7 @@ func (s *DeploymentStore) GetForAppEnv(ctx context.Context, appId string, envId
 		return nil, err
 	}
 	for i := range deployments {
-		if err := s.decryptAppEnvVars(&deployments[i].AppEnvVars); err != nil {
+		if err := s.decryptDeployment(&deployments[i]); err != nil {
 			return nil, err
 		}
 	}
@@ -297,7 +481,7 @@ func (s *DeploymentStore) GetLatestForAppEnv(ctx context.Context, appId string,
 		}
 		return nil, err
 	}
-	if err := s.decryptAppEnvVars(&deployment.AppEnvVars); err != nil {
+	if err := s.decryptDeployment(&deployment); err != nil {
 		return nil, err
 	}
 	return &deployment, nil
@@ -312,7 +496,7 @@ func (s *DeploymentStore) GetForEnv(envId string) ([]store.Deployment, error) {
 		return nil, err
 	}
 	for i := range deployments {
-		if err := s.decryptAppEnvVars(&deployments[i].AppEnvVars); err != nil {
+		if err := s.decryptDeployment(&deployments[i]); err != nil {
 			return nil, err
 		}
 	}
@@ -330,7 +514,7 @@ func (s *DeploymentStore) GetForCell(cellId string) ([]store.Deployment, error)
 		return nil, err
 	}
 	for i := range deployments {
-		if err := s.decryptAppEnvVars(&deployments[i].AppEnvVars); err != nil {
+		if err := s.decryptDeployment(&deployments[i]); err != nil {
 			return nil, err
 		}
 	}
@@ -342,7 +526,131 @@ func (s *DeploymentStore) DeleteDeployment(appId string, envId string, id uint)
 }
 
 func (s *DeploymentStore) UpdateDeploymentStatus(appId string, envId string, id uint, status store.DeploymentStatus, statusReason string) error {
+	return s.RecordDeploymentStatus(store.RecordDeploymentStatusOptions{
+		AppId:        appId,
+		EnvId:        envId,
+		DeploymentId: id,
+		Status:       status,
+		Reason:       statusReason,
+		Actor:        store.SystemActor,
+	})
+}
+
+// RecordDeploymentStatus updates the deployment's status, and its cell statuses if given, and appends an event for the transition.
+// Deployments in progress are checked on repeatedly, so setting the status and reason they already have only records an event if it
+// comes with k8s events. The updates are conditional on the deployment's status not being one of opts.UnlessStatus, so that a status
+// change made in the meantime, e.g. a cancel, isn't overwritten.
+func (s *DeploymentStore) RecordDeploymentStatus(opts store.RecordDeploymentStatusOptions) error {
+	if err := validate.Struct(opts); err != nil {
+		return err
+	}
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		var current store.Deployment
+		if err := tx.Where(&store.Deployment{AppId: opts.AppId, EnvId: opts.EnvId, Id: opts.DeploymentId}).First(&current).Error; err != nil {
+			return err
+		}
+		update := func(columns ...string) *gorm.DB {
+			q := tx.Where(&store.Deployment{AppId: opts.AppId, EnvId: opts.EnvId, Id: opts.DeploymentId})
+			if len(opts.UnlessStatus) > 0 {
+				q = q.Where("status NOT IN ?", opts.UnlessStatus)
+			}
+			return q.Select(columns)
+		}
+		if opts.CellStatuses != nil {
+			result := update("CellStatuses").Updates(store.Deployment{CellStatuses: datatypes.NewJSONType(opts.CellStatuses)})
+			if result.Error != nil {
+				return result.Error
+			} else if result.RowsAffected == 0 {
+				return store.ErrDeploymentStatusChanged
+			}
+		}
+		if current.Status == opts.Status && current.StatusReason == opts.Reason && len(opts.K8sEvents) == 0 {
+			return nil
+		}
+		result := update("Status", "StatusReason").Updates(store.Deployment{Status: opts.Status, StatusReason: opts.Reason})
+		if result.Error != nil {
+			return result.Error
+		} else if result.RowsAffected == 0 {
+			return store.ErrDeploymentStatusChanged
+		}
+		event := store.DeploymentEvent{
+			TeamId:       current.TeamId,
+			AppId:        opts.AppId,
+			EnvId:        opts.EnvId,
+			DeploymentId: opts.DeploymentId,
+			Status:       opts.Status,
+			Reason:       opts.Reason,
+			Actor:        opts.Actor,
+		}
+		if len(opts.K8sEvents) > 0 {
+			event.K8sEvents = datatypes.NewJSONType(opts.K8sEvents)
+		}
+		return tx.Create(&event).Error
+	})
+}
+
+func (s *DeploymentStore) ReviewDeployment(opts store.ReviewDeploymentOptions) error {
+	if err := validate.Struct(opts); err != nil {
+		return err
+	}
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		var current store.Deployment
+		if err := tx.Where(&store.Deployment{AppId: opts.AppId, EnvId: opts.EnvId, Id: opts.DeploymentId}).First(&current).Error; err != nil {
+			return err
+		}
+		if current.Status != store.DeploymentStatusPendingApproval {
+			return fmt.Errorf("deployment %d is %s, only deployments pending approval can be approved or rejected", current.Id, current.Status)
+		}
+		status, reason := store.DeploymentStatusPending, fmt.Sprintf("approved by %s", opts.Reviewer)
+		if !opts.Approved {
+			status, reason = store.DeploymentStatusRejected, fmt.Sprintf("rejected by %s", opts.Reviewer)
+		}
+		if opts.Comment != "" {
+			reason = fmt.Sprintf("%s: %s", reason, opts.Comment)
+		}
+		if err := tx.Where(&store.Deployment{AppId: opts.AppId, EnvId: opts.EnvId, Id: opts.DeploymentId}).
+			Select("Status", "StatusReason", "Review").
+			Updates(store.Deployment{Status: status, StatusReason: reason, Review: datatypes.NewJSONType(&store.DeploymentReview{
+				Approved:   opts.Approved,
+				ReviewerId: opts.ReviewerId,
+				Reviewer:   opts.Reviewer,
+				Comment:    opts.Comment,
+				ReviewedAt: time.Now(),
+			})}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&store.DeploymentEvent{
+			TeamId:       current.TeamId,
+			AppId:        opts.AppId,
+			EnvId:        opts.EnvId,
+			DeploymentId: opts.DeploymentId,
+			Status:       status,
+			Reason:       reason,
+			Actor:        opts.Reviewer,
+		}).Error
+	})
+}
+
+// GetEvents returns the events of a deployment, oldest first
+func (s *DeploymentStore) GetEvents(ctx context.Context, appId string, envId string, id uint) ([]store.DeploymentEvent, error) {
+	var events []store.DeploymentEvent
+	return events, s.db.WithContext(ctx).
+		Where(&store.DeploymentEvent{AppId: appId, EnvId: envId, DeploymentId: id}).
+		Order("created_at ASC, id ASC").
+		Find(&events).Error
+}
+
+// GetEventsForAppEnv returns the events of all deployments of an app in an env, oldest first
+func (s *DeploymentStore) GetEventsForAppEnv(ctx context.Context, appId string, envId string) ([]store.DeploymentEvent, error) {
+	var events []store.DeploymentEvent
+	return events, s.db.WithContext(ctx).
+		Where(&store.DeploymentEvent{AppId: appId, EnvId: envId}).
+		Order("created_at ASC, id ASC").
+		Find(&events).Error
+}
+
+func (s *DeploymentStore) UpdateCanaryWeight(appId string, envId string, id uint, canaryWeight int) error {
 	return s.db.Where(&store.Deployment{AppId: appId, EnvId: envId, Id: id}).
-		Select("Status", "StatusReason").
-		Updates(store.Deployment{Status: status, StatusReason: statusReason}).Error
+		Select("CanaryWeight").
+		Updates(store.Deployment{CanaryWeight: canaryWeight}).Error
 }
@@ -0,0 +1,119 @@
+package dbstore
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/onmetal-dev/metal/lib/store"
+	"github.com/onmetal-dev/metal/lib/validate"
+	"go.jetify.com/typeid"
+	"gorm.io/gorm"
+)
+
+type DomainStore struct {
+	db *gorm.DB
+}
+
+var _ store.DomainStore = &DomainStore{}
+
+func NewDomainStore(db *gorm.DB) *DomainStore {
+	return &DomainStore{db: db}
+}
+
+func generateVerificationToken() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+func (s *DomainStore) Create(ctx context.Context, opts store.CreateDomainOptions) (store.Domain, error) {
+	if err := validate.Struct(opts); err != nil {
+		return store.Domain{}, err
+	}
+	tid, _ := typeid.WithPrefix("domain")
+	domain := store.Domain{
+		Common: store.Common{
+			Id: tid.String(),
+		},
+		TeamId:             opts.TeamId,
+		AppId:              opts.AppId,
+		EnvId:              opts.EnvId,
+		Hostname:           opts.Hostname,
+		VerificationToken:  generateVerificationToken(),
+		VerificationStatus: store.DomainVerificationStatusPending,
+	}
+	if err := s.db.WithContext(ctx).Create(&domain).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return store.Domain{}, fmt.Errorf("domain %s is already in use", opts.Hostname)
+		}
+		return store.Domain{}, fmt.Errorf("failed to create domain: %w", err)
+	}
+	return s.GetByHostname(ctx, opts.Hostname)
+}
+
+func (s *DomainStore) GetByHostname(ctx context.Context, hostname string) (store.Domain, error) {
+	var domain store.Domain
+	if err := s.db.WithContext(ctx).Preload("App").Preload("Env").
+		Where("hostname = ?", hostname).
+		First(&domain).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return store.Domain{}, store.ErrDomainNotFound
+		}
+		return store.Domain{}, fmt.Errorf("failed to get domain: %w", err)
+	}
+	return domain, nil
+}
+
+func (s *DomainStore) GetForAppEnv(ctx context.Context, appId, envId string) ([]store.Domain, error) {
+	var domains []store.Domain
+	if err := s.db.WithContext(ctx).Preload("App").Preload("Env").
+		Where("app_id = ? AND env_id = ?", appId, envId).
+		Order("hostname ASC").
+		Find(&domains).Error; err != nil {
+		return nil, fmt.Errorf("failed to get domains: %w", err)
+	}
+	return domains, nil
+}
+
+func (s *DomainStore) GetForTeam(ctx context.Context, teamId string) ([]store.Domain, error) {
+	var domains []store.Domain
+	if err := s.db.WithContext(ctx).Preload("App").Preload("Env").
+		Where("team_id = ?", teamId).
+		Order("hostname ASC").
+		Find(&domains).Error; err != nil {
+		return nil, fmt.Errorf("failed to get domains: %w", err)
+	}
+	return domains, nil
+}
+
+func (s *DomainStore) UpdateVerification(ctx context.Context, id string, status store.DomainVerificationStatus, statusReason string) error {
+	updates := map[string]interface{}{
+		"verification_status":        status,
+		"verification_status_reason": statusReason,
+	}
+	if status == store.DomainVerificationStatusVerified {
+		updates["verified_at"] = time.Now()
+	}
+	result := s.db.WithContext(ctx).Model(&store.Domain{}).Where("id = ?", id).Updates(updates)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update domain verification: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return store.ErrDomainNotFound
+	}
+	return nil
+}
+
+// Delete hard deletes the domain so that its hostname can be added again
+func (s *DomainStore) Delete(ctx context.Context, id string) error {
+	if err := s.db.WithContext(ctx).Unscoped().Where("id = ?", id).Delete(&store.Domain{}).Error; err != nil {
+		return fmt.Errorf("failed to delete domain: %w", err)
+	}
+	return nil
+}
@@ -160,6 +160,11 @@ func (m *DeploymentStoreMock) CreateEnv(opts store.CreateEnvOptions) (store.Env,
 	return args.Get(0).(store.Env), args.Error(1)
 }
 
+func (m *DeploymentStoreMock) UpsertPreviewEnv(opts store.UpsertPreviewEnvOptions) (store.Env, bool, error) {
+	args := m.Called(opts)
+	return args.Get(0).(store.Env), args.Bool(1), args.Error(2)
+}
+
 func (m *DeploymentStoreMock) GetEnv(id string) (store.Env, error) {
 	args := m.Called(id)
 	return args.Get(0).(store.Env), args.Error(1)
@@ -170,6 +175,21 @@ func (m *DeploymentStoreMock) GetEnvsForTeam(teamId string) ([]store.Env, error)
 	return args.Get(0).([]store.Env), args.Error(1)
 }
 
+func (m *DeploymentStoreMock) UpdateEnv(id string, opts store.UpdateEnvOptions) error {
+	args := m.Called(id, opts)
+	return args.Error(0)
+}
+
+func (m *DeploymentStoreMock) LockEnv(id string, opts store.LockEnvOptions) error {
+	args := m.Called(id, opts)
+	return args.Error(0)
+}
+
+func (m *DeploymentStoreMock) UnlockEnv(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
 func (m *DeploymentStoreMock) DeleteEnv(id string) error {
 	args := m.Called(id)
 	return args.Error(0)
@@ -195,6 +215,16 @@ func (m *DeploymentStoreMock) DeleteAppEnvVars(id string) error {
 	return args.Error(0)
 }
 
+func (m *DeploymentStoreMock) CreateEnvVarSet(opts store.CreateEnvVarSetOptions) (store.EnvVarSet, error) {
+	args := m.Called(opts)
+	return args.Get(0).(store.EnvVarSet), args.Error(1)
+}
+
+func (m *DeploymentStoreMock) GetEnvVarSet(id string) (store.EnvVarSet, error) {
+	args := m.Called(id)
+	return args.Get(0).(store.EnvVarSet), args.Error(1)
+}
+
 func (m *DeploymentStoreMock) Create(opts store.CreateDeploymentOptions) (store.Deployment, error) {
 	args := m.Called(opts)
 	return args.Get(0).(store.Deployment), args.Error(1)
@@ -245,6 +275,31 @@ func (m *DeploymentStoreMock) UpdateDeploymentStatus(appId string, envId string,
 	return args.Error(0)
 }
 
+func (m *DeploymentStoreMock) RecordDeploymentStatus(opts store.RecordDeploymentStatusOptions) error {
+	args := m.Called(opts)
+	return args.Error(0)
+}
+
+func (m *DeploymentStoreMock) ReviewDeployment(opts store.ReviewDeploymentOptions) error {
+	args := m.Called(opts)
+	return args.Error(0)
+}
+
+func (m *DeploymentStoreMock) GetEvents(ctx context.Context, appId string, envId string, id uint) ([]store.DeploymentEvent, error) {
+	args := m.Called(ctx, appId, envId, id)
+	return args.Get(0).([]store.DeploymentEvent), args.Error(1)
+}
+
+func (m *DeploymentStoreMock) GetEventsForAppEnv(ctx context.Context, appId string, envId string) ([]store.DeploymentEvent, error) {
+	args := m.Called(ctx, appId, envId)
+	return args.Get(0).([]store.DeploymentEvent), args.Error(1)
+}
+
+func (m *DeploymentStoreMock) UpdateCanaryWeight(appId string, envId string, id uint, canaryWeight int) error {
+	args := m.Called(appId, envId, id, canaryWeight)
+	return args.Error(0)
+}
+
 type ApiTokenStoreMock struct {
 	mock.Mock
 }
@@ -311,3 +366,96 @@ func (m *BuildStoreMock) UpdateArtifacts(ctx context.Context, id string, artifac
 	args := m.Called(ctx, id, artifacts)
 	return args.Error(0)
 }
+
+type CronJobStoreMock struct {
+	mock.Mock
+}
+
+var _ store.CronJobStore = &CronJobStoreMock{}
+
+func (m *CronJobStoreMock) Create(ctx context.Context, opts store.CreateCronJobOptions) (store.CronJob, error) {
+	args := m.Called(ctx, opts)
+	return args.Get(0).(store.CronJob), args.Error(1)
+}
+
+func (m *CronJobStoreMock) GetByName(ctx context.Context, appId, envId, name string) (store.CronJob, error) {
+	args := m.Called(ctx, appId, envId, name)
+	return args.Get(0).(store.CronJob), args.Error(1)
+}
+
+func (m *CronJobStoreMock) GetForAppEnv(ctx context.Context, appId, envId string) ([]store.CronJob, error) {
+	args := m.Called(ctx, appId, envId)
+	return args.Get(0).([]store.CronJob), args.Error(1)
+}
+
+func (m *CronJobStoreMock) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+type DomainStoreMock struct {
+	mock.Mock
+}
+
+var _ store.DomainStore = &DomainStoreMock{}
+
+func (m *DomainStoreMock) Create(ctx context.Context, opts store.CreateDomainOptions) (store.Domain, error) {
+	args := m.Called(ctx, opts)
+	return args.Get(0).(store.Domain), args.Error(1)
+}
+
+func (m *DomainStoreMock) GetByHostname(ctx context.Context, hostname string) (store.Domain, error) {
+	args := m.Called(ctx, hostname)
+	return args.Get(0).(store.Domain), args.Error(1)
+}
+
+func (m *DomainStoreMock) GetForAppEnv(ctx context.Context, appId, envId string) ([]store.Domain, error) {
+	args := m.Called(ctx, appId, envId)
+	return args.Get(0).([]store.Domain), args.Error(1)
+}
+
+func (m *DomainStoreMock) GetForTeam(ctx context.Context, teamId string) ([]store.Domain, error) {
+	args := m.Called(ctx, teamId)
+	return args.Get(0).([]store.Domain), args.Error(1)
+}
+
+func (m *DomainStoreMock) UpdateVerification(ctx context.Context, id string, status store.DomainVerificationStatus, statusReason string) error {
+	args := m.Called(ctx, id, status, statusReason)
+	return args.Error(0)
+}
+
+func (m *DomainStoreMock) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+type CellStoreMock struct {
+	mock.Mock
+}
+
+var _ store.CellStore = &CellStoreMock{}
+
+func (m *CellStoreMock) Create(c store.Cell) (store.Cell, error) {
+	args := m.Called(c)
+	return args.Get(0).(store.Cell), args.Error(1)
+}
+
+func (m *CellStoreMock) Get(id string) (store.Cell, error) {
+	args := m.Called(id)
+	return args.Get(0).(store.Cell), args.Error(1)
+}
+
+func (m *CellStoreMock) GetForTeam(ctx context.Context, teamId string) ([]store.Cell, error) {
+	args := m.Called(ctx, teamId)
+	return args.Get(0).([]store.Cell), args.Error(1)
+}
+
+func (m *CellStoreMock) UpdateTalosCellData(talosCellData *store.TalosCellData) error {
+	args := m.Called(talosCellData)
+	return args.Error(0)
+}
+
+func (m *CellStoreMock) AddServer(cellId string, server store.Server) error {
+	args := m.Called(cellId, server)
+	return args.Error(0)
+}
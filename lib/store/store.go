@@ -2,9 +2,12 @@ package store
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"gorm.io/datatypes"
@@ -88,6 +91,11 @@ type PaymentMethod struct {
 	ExpirationYear        int    `json:"expiration_year,omitempty"`
 }
 
+// IsAdmin reports whether the user is a member of the team with the admin role
+func (t Team) IsAdmin(userId string) bool {
+	return slices.ContainsFunc(t.Members, func(m TeamMember) bool { return m.UserId == userId && m.Role == TeamRoleAdmin })
+}
+
 type TeamRole string
 
 const (
@@ -307,23 +315,61 @@ type App struct {
 	Name      string    `json:"name"`
 	CreatedAt time.Time `gorm:"index:idx_team_createdat"`
 }
+
+// Port is a container port. Proto is what the container speaks on it: http, grpc (cleartext HTTP/2), or anything else over tcp.
 type Port struct {
 	Name  string `validate:"required,lowercasealphanumhyphen"`
 	Port  int    `validate:"required"`
-	Proto string `validate:"required,oneof=http"`
+	Proto string `validate:"required,oneof=http tcp grpc"`
 }
 
 type Ports []Port
 
+// ExternalPort exposes a container port outside the cell.
+// http and https ports are served on the app's hostname by the cell gateway, and so are grpc ports, over TLS on 443.
+// tcp ports are exposed as is on Port of an address the cell assigns.
 type ExternalPort struct {
 	Name     string `validate:"required,lowercasealphanumhyphen"`
 	PortName string `validate:"required,lowercasealphanumhyphen"` // reference to a Port
-	Proto    string `validate:"required,oneof=http https"`
-	Port     int    `validate:"required"`
+	Proto    string `validate:"required,oneof=http https tcp grpc"`
+	Port     int    `validate:"required,min=1,max=65535"`
 }
 
 type ExternalPorts []ExternalPort
 
+// containerProtoForExternalProto is the protocol the container port behind an external port has to speak
+var containerProtoForExternalProto = map[string]string{
+	"http":  "http",
+	"https": "http",
+	"tcp":   "tcp",
+	"grpc":  "grpc",
+}
+
+// ValidateExternalPorts checks that external port names are unique, and that each external port references exactly one of ports speaking a matching protocol.
+// tcp ports can't use 80 or 443, which belong to the cell gateway.
+func ValidateExternalPorts(externalPorts ExternalPorts, ports Ports) error {
+	names := map[string]bool{}
+	for _, e := range externalPorts {
+		if names[e.Name] {
+			return fmt.Errorf("external port %s is defined more than once", e.Name)
+		}
+		names[e.Name] = true
+
+		i := slices.IndexFunc(ports, func(p Port) bool { return p.Name == e.PortName })
+		if i == -1 {
+			return fmt.Errorf("external port %s references port %s, which none of the processes have", e.Name, e.PortName)
+		} else if slices.ContainsFunc(ports[i+1:], func(p Port) bool { return p.Name == e.PortName }) {
+			return fmt.Errorf("external port %s references port %s, which more than one process has", e.Name, e.PortName)
+		} else if want := containerProtoForExternalProto[e.Proto]; ports[i].Proto != want {
+			return fmt.Errorf("external port %s is %s, so port %s has to be %s, not %s", e.Name, e.Proto, e.PortName, want, ports[i].Proto)
+		}
+		if e.Proto == "tcp" && (e.Port == 80 || e.Port == 443) {
+			return fmt.Errorf("external port %s can't use port %d, which the cell gateway serves http on", e.Name, e.Port)
+		}
+	}
+	return nil
+}
+
 type Resources struct {
 	Limits   ResourceLimits   `json:"limits"`
 	Requests ResourceRequests `json:"requests"`
@@ -339,6 +385,150 @@ type ResourceRequests struct {
 	MemoryMiB int     `json:"memory_mib"`
 }
 
+// HealthCheck is used for both readiness and liveness of an app's containers. It runs Command if one is set.
+// Otherwise it sends a GET request to Path on http ports and opens a connection on tcp ports.
+// Zero values for the timing fields fall back to the k8s defaults.
+type HealthCheck struct {
+	Path     string `json:"path" validate:"omitempty,startswith=/"`
+	PortName string `json:"port_name" validate:"required,lowercasealphanumhyphen"` // reference to a Port. It also picks the process that is checked.
+	// Command is run with /bin/sh -c in the container. It passes if it exits 0.
+	Command             string `json:"command,omitempty"`
+	InitialDelaySeconds int    `json:"initial_delay_seconds" validate:"min=0"`
+	PeriodSeconds       int    `json:"period_seconds" validate:"min=0"`
+	FailureThreshold    int    `json:"failure_threshold" validate:"min=0"`
+}
+
+// ValidateHealthCheck checks that the health check's port is one of ports and that the check suits it:
+// without a command, http ports need a path, tcp ports can't have one, and grpc ports can't be checked.
+func ValidateHealthCheck(healthCheck *HealthCheck, ports Ports) error {
+	i := slices.IndexFunc(ports, func(p Port) bool { return p.Name == healthCheck.PortName })
+	if i == -1 {
+		return fmt.Errorf("health check references port %s, which none of the processes have", healthCheck.PortName)
+	} else if healthCheck.Command != "" {
+		return nil
+	}
+	switch port := ports[i]; port.Proto {
+	case "http":
+		if healthCheck.Path == "" {
+			return fmt.Errorf("health check of http port %s needs a path or a command", port.Name)
+		}
+	case "tcp":
+		if healthCheck.Path != "" {
+			return fmt.Errorf("health check of tcp port %s can't have a path", port.Name)
+		}
+	default:
+		return fmt.Errorf("health check of %s port %s needs a command", port.Proto, port.Name)
+	}
+	return nil
+}
+
+// Autoscaling lets k8s scale a process between MinReplicas and MaxReplicas to keep its average utilization near the targets.
+// Utilization is relative to the resources the process requests. At least one of the targets must be set.
+type Autoscaling struct {
+	MinReplicas                    int `json:"min_replicas" validate:"min=1"`
+	MaxReplicas                    int `json:"max_replicas" validate:"gtefield=MinReplicas"`
+	TargetCPUUtilizationPercent    int `json:"target_cpu_utilization_percent" validate:"required_without=TargetMemoryUtilizationPercent,min=0"`
+	TargetMemoryUtilizationPercent int `json:"target_memory_utilization_percent" validate:"min=0"`
+}
+
+// Volume is persistent storage mounted into one of an app's processes. It is a ReadWriteOnce volume, so the process can only run a single replica.
+// Volumes outlive the settings that define them: removing one stops mounting it, but its data is only deleted along with the app.
+type Volume struct {
+	Name      string `json:"name" validate:"required,lowercasealphanumhyphen"`
+	MountPath string `json:"mount_path" validate:"required,startswith=/"`
+	SizeGiB   int    `json:"size_gib" validate:"min=1"`
+	// Process is the process the volume is mounted into. Empty means DefaultProcessName.
+	Process string `json:"process" validate:"omitempty,lowercasealphanumhyphen"`
+}
+
+type Volumes []Volume
+
+// ForProcess returns the volumes mounted into the named process
+func (vs Volumes) ForProcess(name string) Volumes {
+	var volumes Volumes
+	for _, v := range vs {
+		if v.Process == name || (v.Process == "" && name == DefaultProcessName) {
+			volumes = append(volumes, v)
+		}
+	}
+	return volumes
+}
+
+// ValidateVolumes checks that volume names are unique and that each volume is mounted into one of the processes, at a path no other volume of the process uses.
+// Since volumes are ReadWriteOnce, the processes that mount one can't run more than one replica or autoscale.
+func ValidateVolumes(volumes Volumes, processes Processes) error {
+	names := map[string]bool{}
+	mountPaths := map[string]bool{}
+	for _, v := range volumes {
+		if names[v.Name] {
+			return fmt.Errorf("volume %s is defined more than once", v.Name)
+		}
+		names[v.Name] = true
+		processName := v.Process
+		if processName == "" {
+			processName = DefaultProcessName
+		}
+		if mountPaths[processName+":"+v.MountPath] {
+			return fmt.Errorf("more than one volume is mounted at %s in process %s", v.MountPath, processName)
+		}
+		mountPaths[processName+":"+v.MountPath] = true
+
+		i := slices.IndexFunc(processes, func(p Process) bool { return p.Name == processName })
+		if i == -1 {
+			return fmt.Errorf("volume %s is mounted into process %s, which does not exist", v.Name, processName)
+		} else if processes[i].Autoscaling != nil {
+			return fmt.Errorf("process %s can't autoscale since it mounts volume %s", processName, v.Name)
+		} else if processes[i].Replicas > 1 {
+			return fmt.Errorf("process %s can't run more than one replica since it mounts volume %s", processName, v.Name)
+		}
+	}
+	return nil
+}
+
+// DefaultProcessName is the process that apps without explicit process types run. Its k8s resources are named after the app itself.
+const DefaultProcessName = "web"
+
+// Process is a Procfile-style process type, e.g. web or worker. All of an app's processes run the same image with the same env vars.
+type Process struct {
+	Name string `json:"name" validate:"required,lowercasealphanumhyphen"`
+	// Command is run with /bin/sh -c. Empty runs the image's default entrypoint.
+	Command   string    `json:"command"`
+	Replicas  int       `json:"replicas" validate:"min=0"`
+	Resources Resources `json:"resources"`
+	// Ports are optional. Only processes with ports get a Service, and routes for the external ports that reference them.
+	Ports Ports `json:"ports" validate:"dive"`
+	// Autoscaling is optional. If set, k8s owns the replica count and Replicas is ignored.
+	Autoscaling *Autoscaling `json:"autoscaling,omitempty" validate:"omitempty"`
+}
+
+type Processes []Process
+
+// Scale returns a copy of the processes with the named process scaled to replicas
+func (ps Processes) Scale(name string, replicas int) (Processes, error) {
+	scaled := make(Processes, len(ps))
+	copy(scaled, ps)
+	for i := range scaled {
+		if scaled[i].Name == name {
+			scaled[i].Replicas = replicas
+			return scaled, nil
+		}
+	}
+	return nil, fmt.Errorf("process %s does not exist", name)
+}
+
+// Autoscale returns a copy of the processes with the named process's autoscaling replaced. A nil autoscaling turns it off.
+func (ps Processes) Autoscale(name string, autoscaling *Autoscaling) (Processes, error) {
+	autoscaled := make(Processes, len(ps))
+	copy(autoscaled, ps)
+	for i := range autoscaled {
+		if autoscaled[i].Name == name {
+			autoscaled[i].Autoscaling = autoscaling
+			return autoscaled, nil
+		}
+	}
+	return nil, fmt.Errorf("process %s does not exist", name)
+}
+
 type AppSettings struct {
 	Common
 	TeamId        string                            `json:"team_id"`
@@ -347,6 +537,112 @@ type AppSettings struct {
 	Ports         datatypes.JSONType[Ports]         `gorm:"type:jsonb" json:"ports"`
 	ExternalPorts datatypes.JSONType[ExternalPorts] `gorm:"type:jsonb" json:"external_ports"`
 	Resources     datatypes.JSONType[Resources]     `gorm:"type:jsonb" json:"resources"`
+	HealthCheck   datatypes.JSONType[*HealthCheck]  `gorm:"type:jsonb;default:'null'" json:"health_check"`
+	// ReleaseCommand is run with /bin/sh -c in a one-off container using the new image and env vars before each rollout, e.g. ./migrate up
+	ReleaseCommand string `gorm:"default:''" json:"release_command"`
+	// Processes are the app's process types. If empty, the app runs a single web process using the app-level ports and resources.
+	Processes datatypes.JSONType[Processes] `gorm:"type:jsonb;default:'null'" json:"processes"`
+	// Autoscaling applies to the single web process of apps without process types. Apps with process types set it per process.
+	Autoscaling datatypes.JSONType[*Autoscaling] `gorm:"type:jsonb;default:'null'" json:"autoscaling"`
+	Volumes     datatypes.JSONType[Volumes]      `gorm:"type:jsonb;default:'null'" json:"volumes"`
+	// Dependencies are the names of other apps in the same env. A deployment waits for each of them to be running before it rolls out.
+	Dependencies datatypes.JSONType[[]string] `gorm:"type:jsonb;default:'null'" json:"dependencies"`
+}
+
+// HasVolumes reports whether any of the app's processes mount a volume
+func (s AppSettings) HasVolumes() bool {
+	return len(s.Volumes.Data()) > 0
+}
+
+// HasProcesses reports whether the app defines its own process types
+func (s AppSettings) HasProcesses() bool {
+	return len(s.Processes.Data()) > 0
+}
+
+// AllPorts returns the container ports of every process
+func (s AppSettings) AllPorts() Ports {
+	if !s.HasProcesses() {
+		return s.Ports.Data()
+	}
+	var ports Ports
+	for _, p := range s.Processes.Data() {
+		ports = append(ports, p.Ports...)
+	}
+	return ports
+}
+
+// ValidateDependencies checks that an app's dependencies are other apps of the team, listed once each, and that depending on them doesn't create a cycle.
+// appNames are the names of the team's apps, and dependenciesOf the dependencies the other apps have in the env, by app name.
+func ValidateDependencies(appName string, dependencies []string, appNames []string, dependenciesOf map[string][]string) error {
+	seen := map[string]bool{}
+	for _, dependency := range dependencies {
+		if dependency == appName {
+			return fmt.Errorf("app %s can't depend on itself", appName)
+		} else if seen[dependency] {
+			return fmt.Errorf("dependency %s is listed more than once", dependency)
+		} else if !slices.Contains(appNames, dependency) {
+			return fmt.Errorf("dependency %s is not an app of the team", dependency)
+		}
+		seen[dependency] = true
+	}
+
+	// walk the dependencies of the dependencies, looking for a way back to the app
+	visited := map[string]bool{}
+	var dependsOnApp func(name string) bool
+	dependsOnApp = func(name string) bool {
+		if name == appName {
+			return true
+		} else if visited[name] {
+			return false
+		}
+		visited[name] = true
+		return slices.ContainsFunc(dependenciesOf[name], dependsOnApp)
+	}
+	for _, dependency := range dependencies {
+		if dependsOnApp(dependency) {
+			return fmt.Errorf("app %s can't depend on %s, which depends on it already", appName, dependency)
+		}
+	}
+	return nil
+}
+
+// CreateOptions returns the options to mint a copy of these settings. Callers change what they need before passing them to CreateAppSettings.
+func (s AppSettings) CreateOptions() CreateAppSettingsOptions {
+	return CreateAppSettingsOptions{
+		TeamId:         s.TeamId,
+		AppId:          s.AppId,
+		Artifact:       s.Artifact.Data(),
+		Ports:          s.Ports.Data(),
+		ExternalPorts:  s.ExternalPorts.Data(),
+		Resources:      s.Resources.Data(),
+		HealthCheck:    s.HealthCheck.Data(),
+		ReleaseCommand: s.ReleaseCommand,
+		Processes:      s.Processes.Data(),
+		Autoscaling:    s.Autoscaling.Data(),
+		Volumes:        s.Volumes.Data(),
+		Dependencies:   s.Dependencies.Data(),
+	}
+}
+
+// PromoteOptions returns the options for settings that run the image of source, another env's settings of the app, with its ports
+// and resources. The rest, e.g. the health check, volumes and autoscaling, stays as in s, as do the replicas and autoscaling of
+// processes s has too.
+func (s AppSettings) PromoteOptions(source AppSettings) CreateAppSettingsOptions {
+	opts := s.CreateOptions()
+	opts.Artifact = source.Artifact.Data()
+	opts.Ports = source.Ports.Data()
+	opts.ExternalPorts = source.ExternalPorts.Data()
+	opts.Resources = source.Resources.Data()
+	opts.Processes = nil
+	current := s.Processes.Data()
+	for _, p := range source.Processes.Data() {
+		if i := slices.IndexFunc(current, func(c Process) bool { return c.Name == p.Name }); i >= 0 {
+			p.Replicas = current[i].Replicas
+			p.Autoscaling = current[i].Autoscaling
+		}
+		opts.Processes = append(opts.Processes, p)
+	}
+	return opts
 }
 
 type CreateAppOptions struct {
@@ -356,12 +652,18 @@ type CreateAppOptions struct {
 }
 
 type CreateAppSettingsOptions struct {
-	TeamId        string        `validate:"required"`
-	AppId         string        `validate:"required"`
-	Artifact      Artifact      `validate:"required"`
-	Ports         Ports         `validate:"required"`
-	ExternalPorts ExternalPorts `validate:"required"`
-	Resources     Resources     `validate:"required"`
+	TeamId         string        `validate:"required"`
+	AppId          string        `validate:"required"`
+	Artifact       Artifact      `validate:"required"`
+	Ports          Ports         `validate:"required"`
+	ExternalPorts  ExternalPorts `validate:"required"`
+	Resources      Resources     `validate:"required"`
+	HealthCheck    *HealthCheck  `validate:"omitempty"`
+	ReleaseCommand string
+	Processes      Processes    `validate:"omitempty,dive"`
+	Autoscaling    *Autoscaling `validate:"omitempty"`
+	Volumes        Volumes      `validate:"omitempty,dive"`
+	Dependencies   []string     `validate:"omitempty,dive,lowercasealphanumhyphen"`
 }
 
 var ErrAppNotFound = errors.New("app not found")
@@ -383,6 +685,185 @@ type Env struct {
 	Common
 	TeamId string
 	Name   string
+	// AutoRollback redeploys the settings of the running deployment when a deployment of an app in the env fails
+	AutoRollback bool `gorm:"default:false"`
+	// ProgressDeadlineSeconds is how long a deployment may go without progress before it fails. 0 uses the k8s default of 10 minutes
+	ProgressDeadlineSeconds int `gorm:"default:0"`
+	// DefaultCellId is the cell apps are built on and deployed to the first time they are deployed to the env, for teams with more than one cell
+	DefaultCellId string `gorm:"default:''"`
+	// LockedBy is who locked the env, if it is locked, and LockReason why. Deployments to a locked env are rejected unless an admin overrides the lock
+	LockedBy   string `gorm:"default:''"`
+	LockReason string `gorm:"default:''"`
+	LockedAt   *time.Time
+	// FreezeWindows are weekly windows during which the env is locked, e.g. over the weekend
+	FreezeWindows datatypes.JSONType[[]FreezeWindow]
+	// Protected envs hold new deployments in pending approval until a team admin other than whoever asked for them approves them
+	Protected bool `gorm:"default:false"`
+	// PreviewBranch is the git branch a preview env was created for by metal up --preview. Empty for other envs
+	PreviewBranch string `gorm:"default:''"`
+	// PreviewIdleTTLSeconds is how long a preview env may go without a deployment before it is torn down
+	PreviewIdleTTLSeconds int `gorm:"default:0"`
+	// EnvVarSetId is the env's current set of env vars shared by all of its apps. Empty if none were ever set
+	EnvVarSetId string `gorm:"default:''"`
+}
+
+const (
+	DefaultPreviewIdleTTL = 48 * time.Hour
+	MinPreviewIdleTTL     = 10 * time.Minute
+	MaxPreviewIdleTTL     = 30 * 24 * time.Hour
+)
+
+// ErrEnvNotPreview is returned when a preview env would take the name of an env that isn't the preview env of the same branch
+var ErrEnvNotPreview = errors.New("env is not a preview env")
+
+// IsPreview reports whether the env is a temporary env for a branch that is torn down once it goes unused
+func (e Env) IsPreview() bool {
+	return e.PreviewBranch != ""
+}
+
+// PreviewExpiresAt is when a preview env last deployed to at lastDeployedAt is torn down
+func (e Env) PreviewExpiresAt(lastDeployedAt time.Time) time.Time {
+	return lastDeployedAt.Add(time.Duration(e.PreviewIdleTTLSeconds) * time.Second)
+}
+
+// PreviewEnvName turns a branch into the name of its preview env, e.g. feature/Login-Page becomes feature-login-page-1cd8fc. The name is
+// part of the hostnames of the env's apps, so it is kept short. The hash of the whole branch at the end keeps branches that only differ
+// in punctuation, case or past the truncation apart
+func PreviewEnvName(branch string) (string, error) {
+	var b strings.Builder
+	for _, r := range strings.ToLower(branch) {
+		if ('a' <= r && r <= 'z') || ('0' <= r && r <= '9') {
+			b.WriteRune(r)
+		} else if s := b.String(); s != "" && !strings.HasSuffix(s, "-") {
+			b.WriteRune('-')
+		}
+	}
+	name := strings.Trim(b.String(), "-")
+	if name == "" {
+		return "", fmt.Errorf("branch %q has no letters or digits to name its preview env after", branch)
+	}
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte(branch)))[:previewEnvHashLength]
+	if maxLength := previewEnvNameMaxLength - len(hash) - 1; len(name) > maxLength {
+		name = strings.TrimRight(name[:maxLength], "-")
+	}
+	return name + "-" + hash, nil
+}
+
+const (
+	previewEnvNameMaxLength = 30
+	previewEnvHashLength    = 6
+)
+
+// ErrEnvLocked is returned when deploying to an env that is locked or in one of its freeze windows
+var ErrEnvLocked = errors.New("env is locked")
+
+// ProgressDeadline is how long a deployment to the env may go without progress before it fails
+func (e Env) ProgressDeadline() time.Duration {
+	if e.ProgressDeadlineSeconds > 0 {
+		return time.Duration(e.ProgressDeadlineSeconds) * time.Second
+	}
+	return 10 * time.Minute
+}
+
+// Locked reports whether the env is locked by hand, as opposed to being in a freeze window
+func (e Env) Locked() bool {
+	return e.LockedAt != nil
+}
+
+// CheckUnlocked returns an error wrapping ErrEnvLocked that says who locked the env and why, or which freeze window it is in, if deployments
+// to the env are not allowed at now
+func (e Env) CheckUnlocked(now time.Time) error {
+	if e.Locked() {
+		return fmt.Errorf("%w: %s was locked by %s at %s%s", ErrEnvLocked, e.Name, e.LockedBy, e.LockedAt.UTC().Format(time.DateTime+" MST"), reasonSuffix(e.LockReason))
+	}
+	for _, w := range e.FreezeWindows.Data() {
+		if w.Contains(now) {
+			return fmt.Errorf("%w: %s is in the freeze window %s%s", ErrEnvLocked, e.Name, w, reasonSuffix(w.Reason))
+		}
+	}
+	return nil
+}
+
+func reasonSuffix(reason string) string {
+	if reason == "" {
+		return ""
+	}
+	return ": " + reason
+}
+
+// FreezeWindow recurs weekly from Start until End, which are a day of the week and a time of day in Timezone, e.g. "Fri 16:00" and "Mon 09:00".
+// Windows that end at an earlier time in the week than they start wrap around the end of the week.
+type FreezeWindow struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	Timezone string `json:"timezone"` // IANA time zone, e.g. Europe/Berlin. Defaults to UTC
+	Reason   string `json:"reason"`
+}
+
+func (w FreezeWindow) String() string {
+	timezone := w.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	return fmt.Sprintf("%s to %s %s", w.Start, w.End, timezone)
+}
+
+// Validate checks that the window's start, end and time zone parse
+func (w FreezeWindow) Validate() error {
+	if _, err := parseWeekMinute(w.Start); err != nil {
+		return fmt.Errorf("invalid start: %w", err)
+	}
+	if _, err := parseWeekMinute(w.End); err != nil {
+		return fmt.Errorf("invalid end: %w", err)
+	}
+	if _, err := time.LoadLocation(w.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone: %w", err)
+	}
+	return nil
+}
+
+// Contains reports whether t falls in the window. Invalid windows contain nothing
+func (w FreezeWindow) Contains(t time.Time) bool {
+	start, err := parseWeekMinute(w.Start)
+	if err != nil {
+		return false
+	}
+	end, err := parseWeekMinute(w.End)
+	if err != nil {
+		return false
+	}
+	loc, err := time.LoadLocation(w.Timezone)
+	if err != nil {
+		return false
+	}
+	t = t.In(loc)
+	m := int(t.Weekday())*24*60 + t.Hour()*60 + t.Minute()
+	if start <= end {
+		return start <= m && m < end
+	}
+	return m >= start || m < end
+}
+
+// parseWeekMinute parses a day of the week and a time of day, e.g. "Fri 16:00", into minutes since the start of Sunday
+func parseWeekMinute(s string) (int, error) {
+	day, clock, ok := strings.Cut(strings.TrimSpace(s), " ")
+	if !ok {
+		return 0, fmt.Errorf("%q is not a day and time like \"Fri 16:00\"", s)
+	}
+	weekday := time.Weekday(-1)
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(day, d.String()) || strings.EqualFold(day, d.String()[:3]) {
+			weekday = d
+		}
+	}
+	if weekday < 0 {
+		return 0, fmt.Errorf("%q is not a day of the week", day)
+	}
+	tod, err := time.Parse("15:04", strings.TrimSpace(clock))
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a time like 16:00", clock)
+	}
+	return int(weekday)*24*60 + tod.Hour()*60 + tod.Minute(), nil
 }
 
 type EnvVar struct {
@@ -398,6 +879,15 @@ type AppEnvVars struct {
 	EnvVars datatypes.JSONType[[]EnvVar]
 }
 
+// EnvVarSet is a snapshot of the env vars shared by all apps in an env, e.g. SENTRY_DSN. Like AppEnvVars it never changes:
+// changing the shared env vars creates a new set that deployments created from then on pick up
+type EnvVarSet struct {
+	Common
+	TeamId  string
+	EnvId   string `gorm:"index"`
+	EnvVars datatypes.JSONType[[]EnvVar]
+}
+
 type DeploymentType string
 
 const (
@@ -411,10 +901,19 @@ type DeploymentStatus string
 
 const (
 	DeploymentStatusPending   DeploymentStatus = "pending"
+	DeploymentStatusReleasing DeploymentStatus = "releasing" // running the app's release command before rolling out
 	DeploymentStatusDeploying DeploymentStatus = "deploying"
 	DeploymentStatusRunning   DeploymentStatus = "running"
 	DeploymentStatusFailed    DeploymentStatus = "failed"
 	DeploymentStatusStopped   DeploymentStatus = "stopped"
+	DeploymentStatusCanary    DeploymentStatus = "canary"    // the canary serves a share of traffic next to the previous deployment until it is promoted or aborted
+	DeploymentStatusPromoting DeploymentStatus = "promoting" // sending more traffic to the canary, or rolling it out fully
+	DeploymentStatusAborting  DeploymentStatus = "aborting"  // tearing down the canary and sending all traffic back to the previous deployment
+	DeploymentStatusCanceling DeploymentStatus = "canceling" // rolling the cell back to how it was before the deployment started
+	DeploymentStatusCanceled  DeploymentStatus = "canceled"
+	// DeploymentStatusPendingApproval deployments in protected envs wait for a team admin other than whoever asked for them to approve them
+	DeploymentStatusPendingApproval DeploymentStatus = "pending-approval"
+	DeploymentStatusRejected        DeploymentStatus = "rejected"
 )
 
 // Deployment has a monotonic id that is incremented for each deployment of an app/env combination
@@ -433,10 +932,77 @@ type Deployment struct {
 	AppSettings   AppSettings `gorm:"foreignKey:AppSettingsId"`
 	AppEnvVarsId  string
 	AppEnvVars    AppEnvVars `gorm:"foreignKey:AppEnvVarsId"`
-	Cells         []Cell     `gorm:"many2many:deployment_cells;"`
-	CreatedAt     time.Time  `gorm:"index:idx_app_createdat;index:idx_team_createdat"`
-	UpdatedAt     time.Time
-	DeletedAt     gorm.DeletedAt `gorm:"index"`
+	// EnvVarSetId is the set of env vars shared by the env's apps that the deployment was created with. Empty if the env had none
+	EnvVarSetId string    `gorm:"default:''"`
+	EnvVarSet   EnvVarSet `gorm:"foreignKey:EnvVarSetId;constraint:-"`
+	Cells       []Cell    `gorm:"many2many:deployment_cells;"`
+	// CanarySteps are the percentages of traffic a canary goes through before it is rolled out fully. Empty for regular deployments
+	CanarySteps datatypes.JSONType[[]int] `gorm:"type:jsonb;default:'null'"`
+	// CanaryWeight is the percentage of traffic the canary currently receives. It is 100 once the canary has been promoted fully
+	CanaryWeight int `gorm:"default:0"`
+	// RollbackOf is the id of the failed deployment this deployment automatically rolls back. 0 for deployments someone asked for
+	RollbackOf uint `gorm:"default:0"`
+	// CellStatuses are how far the deployment got on each of its cells. Status is rolled up from them
+	CellStatuses datatypes.JSONType[[]CellStatus] `gorm:"type:jsonb;default:'null'"`
+	// RequestedBy is the id of the user who asked for the deployment, or who created the API token that did. Empty for deployments metal made itself
+	RequestedBy string `gorm:"default:''"`
+	// Review is the approval or rejection of a deployment that waited for approval in a protected env
+	Review    datatypes.JSONType[*DeploymentReview] `gorm:"type:jsonb;default:'null'"`
+	CreatedAt time.Time                             `gorm:"index:idx_app_createdat;index:idx_team_createdat"`
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+// CellStatus is the status of a deployment on one of its cells
+type CellStatus struct {
+	CellId       string           `json:"cell_id"`
+	Status       DeploymentStatus `json:"status"`
+	StatusReason string           `json:"status_reason"`
+}
+
+// DeploymentReview records who approved or rejected a deployment, and why
+type DeploymentReview struct {
+	Approved   bool      `json:"approved"`
+	ReviewerId string    `json:"reviewer_id"`
+	Reviewer   string    `json:"reviewer"` // the reviewer's email
+	Comment    string    `json:"comment"`
+	ReviewedAt time.Time `json:"reviewed_at"`
+}
+
+// CheckReviewer returns an error if the user can't approve or reject the deployment: it must be waiting for approval, and only team admins
+// other than whoever asked for it can review it
+func (d Deployment) CheckReviewer(team Team, userId string) error {
+	if d.Status != DeploymentStatusPendingApproval {
+		return fmt.Errorf("deployment %d is %s, only deployments pending approval can be approved or rejected", d.Id, d.Status)
+	}
+	if i := slices.IndexFunc(team.Members, func(m TeamMember) bool { return m.UserId == userId }); i < 0 || team.Members[i].Role != TeamRoleAdmin {
+		return errors.New("only team admins can approve or reject deployments")
+	}
+	if userId == d.RequestedBy {
+		return fmt.Errorf("deployment %d must be approved or rejected by an admin other than the one who asked for it", d.Id)
+	}
+	return nil
+}
+
+// EnvVars returns the env vars the deployment's containers get: the env vars shared by the env's apps, overridden by the app's own
+func (d Deployment) EnvVars() []EnvVar {
+	appEnvVars := d.AppEnvVars.EnvVars.Data()
+	var envVars []EnvVar
+	for _, shared := range d.EnvVarSet.EnvVars.Data() {
+		if !slices.ContainsFunc(appEnvVars, func(e EnvVar) bool { return e.Name == shared.Name }) {
+			envVars = append(envVars, shared)
+		}
+	}
+	return append(envVars, appEnvVars...)
+}
+
+// CellStatus returns the status of the deployment on a cell. Cells that haven't been advanced yet have the deployment's status
+func (d Deployment) CellStatus(cellId string) CellStatus {
+	statuses := d.CellStatuses.Data()
+	if i := slices.IndexFunc(statuses, func(s CellStatus) bool { return s.CellId == cellId }); i >= 0 {
+		return statuses[i]
+	}
+	return CellStatus{CellId: cellId, Status: d.Status, StatusReason: d.StatusReason}
 }
 
 func (d *Deployment) BeforeCreate(tx *gorm.DB) error {
@@ -453,11 +1019,128 @@ func (d *Deployment) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// Processes returns the processes the deployment runs. Apps without explicit process types run a single web process
+// built from the app-level ports and resources, scaled to the deployment's replica count.
+func (d Deployment) Processes() Processes {
+	if d.AppSettings.HasProcesses() {
+		return d.AppSettings.Processes.Data()
+	}
+	return Processes{{
+		Name:        DefaultProcessName,
+		Replicas:    d.Replicas,
+		Resources:   d.AppSettings.Resources.Data(),
+		Ports:       d.AppSettings.Ports.Data(),
+		Autoscaling: d.AppSettings.Autoscaling.Data(),
+	}}
+}
+
+// IsCanary reports whether the deployment is released as a canary, i.e. next to the previous deployment with a share of its traffic
+func (d Deployment) IsCanary() bool {
+	return len(d.CanarySteps.Data()) > 0
+}
+
+// InCanaryPhase reports whether the deployment is a canary that hasn't been promoted fully yet
+func (d Deployment) InCanaryPhase() bool {
+	return d.IsCanary() && d.CanaryWeight < 100
+}
+
+// NextCanaryWeight returns the traffic percentage of the canary's next step, or 100 if it is on its last step
+func (d Deployment) NextCanaryWeight() int {
+	for _, step := range d.CanarySteps.Data() {
+		if step > d.CanaryWeight {
+			return step
+		}
+	}
+	return 100
+}
+
+// CanCancel reports whether the deployment is still on its way to running, so that it can be canceled
+func (d Deployment) CanCancel() bool {
+	return d.Status == DeploymentStatusPending || d.Status == DeploymentStatusReleasing || d.Status == DeploymentStatusDeploying
+}
+
+// CanRollbackTo reports whether the deployment is a valid target for a rollback, i.e. it ran successfully at some point.
+func (d Deployment) CanRollbackTo() bool {
+	return d.Status == DeploymentStatusRunning || d.Status == DeploymentStatusStopped
+}
+
+// SystemActor is the actor of deployment events that metal causes itself, e.g. a rollout finishing or a pod crashing
+const SystemActor = "metal"
+
+// K8sEvent is a k8s event on one of the objects of a deployment, kept with the deployment event it explains
+type K8sEvent struct {
+	Type     string    `json:"type"`   // Normal or Warning
+	Reason   string    `json:"reason"` // e.g. BackOff or FailedScheduling
+	Object   string    `json:"object"` // kind/name of the object the event is about, e.g. Pod/myapp-web-5d9f-x2x7q
+	Message  string    `json:"message"`
+	Count    int       `json:"count"`
+	LastSeen time.Time `json:"last_seen"`
+}
+
+// DeploymentEvent records a transition of a deployment's status. Events are only ever appended, so that together they are
+// the deployment's timeline, while the deployment itself only has its current status.
+type DeploymentEvent struct {
+	Id           uint   `gorm:"primarykey"`
+	TeamId       string `gorm:"index"`
+	AppId        string `gorm:"index:idx_deployment_event_deployment"`
+	EnvId        string `gorm:"index:idx_deployment_event_deployment"`
+	DeploymentId uint   `gorm:"index:idx_deployment_event_deployment"`
+	Status       DeploymentStatus
+	Reason       string
+	// Actor is who caused the transition: SystemActor, the email of a user, or the name of an API token
+	Actor     string
+	K8sEvents datatypes.JSONType[[]K8sEvent] `gorm:"type:jsonb;default:'null'"`
+	CreatedAt time.Time
+}
+
+// RecordDeploymentStatusOptions set a deployment's status and record the transition
+type RecordDeploymentStatusOptions struct {
+	AppId        string `validate:"required"`
+	EnvId        string `validate:"required"`
+	DeploymentId uint   `validate:"required"`
+	Status       DeploymentStatus
+	Reason       string
+	Actor        string `validate:"required"`
+	K8sEvents    []K8sEvent
+	// CellStatuses replace the deployment's cell statuses if set
+	CellStatuses []CellStatus
+	// UnlessStatus leaves the deployment alone if it has moved on to one of these statuses, e.g. because a user canceled it
+	// while metal was working on it. ErrDeploymentStatusChanged is returned in that case
+	UnlessStatus []DeploymentStatus
+}
+
+// ErrDeploymentStatusChanged is returned when recording a status of a deployment that has moved on to one of the statuses it mustn't overwrite
+var ErrDeploymentStatusChanged = errors.New("deployment status changed")
+
 type CreateEnvOptions struct {
 	TeamId string `validate:"required"`
 	Name   string `validate:"required,lowercasealphanumhyphen"`
 }
 
+// UpsertPreviewEnvOptions create the preview env of a branch, or change the idle TTL of the one that exists
+type UpsertPreviewEnvOptions struct {
+	TeamId         string `validate:"required"`
+	Branch         string `validate:"required"`
+	IdleTTLSeconds int    `validate:"min=600,max=2592000"`
+	// DefaultCellId is the default cell of a preview env that is created, usually that of the env it is a preview of
+	DefaultCellId string
+}
+
+// UpdateEnvOptions are the settings of an env that can be changed after it is created
+type UpdateEnvOptions struct {
+	AutoRollback            bool
+	ProgressDeadlineSeconds int `validate:"omitempty,min=30,max=3600"`
+	DefaultCellId           string
+	FreezeWindows           []FreezeWindow
+	Protected               bool
+}
+
+// LockEnvOptions say who is locking an env and why
+type LockEnvOptions struct {
+	LockedBy string `validate:"required"`
+	Reason   string
+}
+
 type CreateAppEnvVarOptions struct {
 	TeamId  string   `validate:"required"`
 	EnvId   string   `validate:"required"`
@@ -465,6 +1148,12 @@ type CreateAppEnvVarOptions struct {
 	EnvVars []EnvVar `validate:"required"`
 }
 
+type CreateEnvVarSetOptions struct {
+	TeamId  string `validate:"required"`
+	EnvId   string `validate:"required"`
+	EnvVars []EnvVar
+}
+
 type CreateDeploymentOptions struct {
 	TeamId        string         `validate:"required"`
 	EnvId         string         `validate:"required"`
@@ -474,18 +1163,50 @@ type CreateDeploymentOptions struct {
 	AppEnvVarsId  string         `validate:"required"`
 	CellIds       []string       `validate:"required"`
 	Replicas      int            `validate:"required"`
+	// CanarySteps makes the deployment a canary that receives these percentages of traffic in turn, e.g. [10, 50]
+	CanarySteps []int `validate:"omitempty,dive,min=1,max=99"`
+	// RollbackOf links an automatic rollback to the failed deployment it was created for
+	RollbackOf uint
+	// Actor is who asked for the deployment, recorded as the actor of its first event. Defaults to SystemActor
+	Actor string
+	// OverrideLock creates the deployment even if the env is locked or in a freeze window. Only admins may override locks
+	OverrideLock bool
+	// RequestedBy is the id of the user who asked for the deployment, who may not approve it in a protected env
+	RequestedBy string
+	// EnvVarSetId is the set of shared env vars the deployment gets. Defaults to the env's current set. Rollbacks pass the one of the deployment they roll back to
+	EnvVarSetId string
+}
+
+// ReviewDeploymentOptions approve or reject a deployment that is pending approval
+type ReviewDeploymentOptions struct {
+	AppId        string `validate:"required"`
+	EnvId        string `validate:"required"`
+	DeploymentId uint   `validate:"required"`
+	Approved     bool
+	ReviewerId   string `validate:"required"`
+	Reviewer     string `validate:"required"`
+	Comment      string
 }
 
 var ErrEnvNotFound = errors.New("env not found")
 
+var ErrDeploymentNotFound = errors.New("deployment not found")
+
 // DeploymentStore allows for
-// - creating, retrieving (by teamId), and deleting environments
+// - creating, retrieving (by teamId), updating, and deleting environments
 // - creating, retrieving (by teamId, appId, envId), and deleting AppEnvVars
+// - creating and retrieving the EnvVarSets shared by an env's apps
 // - creating, retrieving (by teamId or by Id or by appId, or by envId, or by cellId), and deleting Deployments
+// - recording the status transitions of Deployments and retrieving them as events
 type DeploymentStore interface {
 	CreateEnv(opts CreateEnvOptions) (Env, error)
+	// UpsertPreviewEnv returns the preview env of a branch, creating it if it doesn't exist yet
+	UpsertPreviewEnv(opts UpsertPreviewEnvOptions) (env Env, created bool, err error)
 	GetEnv(id string) (Env, error)
 	GetEnvsForTeam(teamId string) ([]Env, error)
+	UpdateEnv(id string, opts UpdateEnvOptions) error
+	LockEnv(id string, opts LockEnvOptions) error
+	UnlockEnv(id string) error
 	DeleteEnv(id string) error
 
 	CreateAppEnvVars(opts CreateAppEnvVarOptions) (AppEnvVars, error)
@@ -493,6 +1214,10 @@ type DeploymentStore interface {
 	GetAppEnvVarsForAppEnv(appId string, envId string) ([]AppEnvVars, error)
 	DeleteAppEnvVars(id string) error
 
+	// CreateEnvVarSet stores a new set of env vars shared by an env's apps and makes it the env's current set
+	CreateEnvVarSet(opts CreateEnvVarSetOptions) (EnvVarSet, error)
+	GetEnvVarSet(id string) (EnvVarSet, error)
+
 	Create(opts CreateDeploymentOptions) (Deployment, error)
 	Get(appId string, envId string, id uint) (Deployment, error)
 	GetForTeam(ctx context.Context, teamId string) ([]Deployment, error)
@@ -502,7 +1227,104 @@ type DeploymentStore interface {
 	GetForEnv(envId string) ([]Deployment, error)
 	GetForCell(cellId string) ([]Deployment, error)
 	DeleteDeployment(appId string, envId string, id uint) error
+	// UpdateDeploymentStatus records a status transition that metal caused itself. Use RecordDeploymentStatus for ones a user asked for
 	UpdateDeploymentStatus(appId string, envId string, id uint, status DeploymentStatus, statusReason string) error
+	RecordDeploymentStatus(opts RecordDeploymentStatusOptions) error
+	// ReviewDeployment records the review of a deployment pending approval, moving it on to pending if it is approved or rejected otherwise
+	ReviewDeployment(opts ReviewDeploymentOptions) error
+	GetEvents(ctx context.Context, appId string, envId string, id uint) ([]DeploymentEvent, error)
+	GetEventsForAppEnv(ctx context.Context, appId string, envId string) ([]DeploymentEvent, error)
+	UpdateCanaryWeight(appId string, envId string, id uint, canaryWeight int) error
+}
+
+type CronJobConcurrencyPolicy string
+
+const (
+	CronJobConcurrencyPolicyAllow   CronJobConcurrencyPolicy = "allow"   // runs may overlap
+	CronJobConcurrencyPolicyForbid  CronJobConcurrencyPolicy = "forbid"  // skip a run if the previous one is still going
+	CronJobConcurrencyPolicyReplace CronJobConcurrencyPolicy = "replace" // stop the previous run and start a new one
+)
+
+// CronJob runs a command on a schedule in an env, using the image and env vars of the app's running deployment in that env
+type CronJob struct {
+	Common
+	TeamId            string `gorm:"index"`
+	AppId             string `gorm:"uniqueIndex:idx_cronjob_app_env_name"`
+	EnvId             string `gorm:"uniqueIndex:idx_cronjob_app_env_name"`
+	App               App    `gorm:"foreignKey:AppId"`
+	Env               Env    `gorm:"foreignKey:EnvId"`
+	Name              string `gorm:"uniqueIndex:idx_cronjob_app_env_name"`
+	Schedule          string
+	Command           string // run with /bin/sh -c
+	Timezone          string // IANA time zone the schedule is in, e.g. America/New_York
+	ConcurrencyPolicy CronJobConcurrencyPolicy
+}
+
+type CreateCronJobOptions struct {
+	TeamId            string                   `validate:"required"`
+	AppId             string                   `validate:"required"`
+	EnvId             string                   `validate:"required"`
+	Name              string                   `validate:"required,lowercasealphanumhyphen,max=40"`
+	Schedule          string                   `validate:"required,cronschedule"`
+	Command           string                   `validate:"required"`
+	Timezone          string                   `validate:"required,tzlocation"`
+	ConcurrencyPolicy CronJobConcurrencyPolicy `validate:"required,oneof=allow forbid replace"`
+}
+
+var ErrCronJobNotFound = errors.New("cron job not found")
+
+type CronJobStore interface {
+	Create(ctx context.Context, opts CreateCronJobOptions) (CronJob, error)
+	GetByName(ctx context.Context, appId, envId, name string) (CronJob, error)
+	GetForAppEnv(ctx context.Context, appId, envId string) ([]CronJob, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type DomainVerificationStatus string
+
+const (
+	DomainVerificationStatusPending  DomainVerificationStatus = "pending"
+	DomainVerificationStatusVerified DomainVerificationStatus = "verified"
+	DomainVerificationStatusFailed   DomainVerificationStatus = "failed"
+)
+
+// Domain is a custom hostname that routes to an app in an env, in addition to the hostname we give it on each cell.
+// It only routes, and gets a certificate, once whoever added it has proven they control its DNS with a TXT record containing VerificationToken or a CNAME to the app's hostname.
+type Domain struct {
+	Common
+	TeamId                   string `gorm:"index"`
+	AppId                    string `gorm:"index:idx_domain_app_env"`
+	EnvId                    string `gorm:"index:idx_domain_app_env"`
+	App                      App    `gorm:"foreignKey:AppId"`
+	Env                      Env    `gorm:"foreignKey:EnvId"`
+	Hostname                 string `gorm:"uniqueIndex"`
+	VerificationToken        string
+	VerificationStatus       DomainVerificationStatus
+	VerificationStatusReason string
+	VerifiedAt               *time.Time
+}
+
+func (d Domain) Verified() bool {
+	return d.VerificationStatus == DomainVerificationStatusVerified
+}
+
+type CreateDomainOptions struct {
+	TeamId   string `validate:"required"`
+	AppId    string `validate:"required"`
+	EnvId    string `validate:"required"`
+	Hostname string `validate:"required,fqdn,lowercase,max=240"`
+}
+
+var ErrDomainNotFound = errors.New("domain not found")
+
+// DomainStore keeps track of custom domains. Hostnames are unique across all teams, since a hostname can only route to one app.
+type DomainStore interface {
+	Create(ctx context.Context, opts CreateDomainOptions) (Domain, error)
+	GetByHostname(ctx context.Context, hostname string) (Domain, error)
+	GetForAppEnv(ctx context.Context, appId, envId string) ([]Domain, error)
+	GetForTeam(ctx context.Context, teamId string) ([]Domain, error)
+	UpdateVerification(ctx context.Context, id string, status DomainVerificationStatus, statusReason string) error
+	Delete(ctx context.Context, id string) error
 }
 
 // ApiTokenScope represents the access level of an API token
@@ -553,12 +1375,53 @@ type ImageArtifact struct {
 	Digest string `json:"digest"`
 }
 
-// Name returns the full name of the image: <registry>/<repository>:<tag>
+// Name returns the full name of the image: [<registry>/]<repository>[:<tag>][@<digest>]. Images with a digest are pinned to it, even if they have a tag
 func (i *ImageArtifact) Name() string {
-	if i.Registry == "" {
-		return fmt.Sprintf("%s:%s", i.Repository, i.Tag)
+	name := i.Repository
+	if i.Registry != "" {
+		name = i.Registry + "/" + name
+	}
+	if i.Tag != "" {
+		name += ":" + i.Tag
+	}
+	if i.Digest != "" {
+		name += "@" + i.Digest
+	}
+	return name
+}
+
+// ParseImageArtifact parses an image reference like registry.example.com:5000/team/app:v1.2, ghcr.io/team/app@sha256:... or busybox.
+// The first path component is only taken as the registry if it looks like a host, the way docker does it.
+func ParseImageArtifact(name string) (*ImageArtifact, error) {
+	image := &ImageArtifact{}
+	rest := name
+	if i := strings.Index(rest, "@"); i >= 0 {
+		image.Digest = rest[i+1:]
+		rest = rest[:i]
+		if image.Digest == "" {
+			return nil, fmt.Errorf("invalid image %q: empty digest", name)
+		}
+	}
+	// a tag follows the last colon, unless that colon is part of a registry's host:port
+	if i := strings.LastIndex(rest, ":"); i > strings.LastIndex(rest, "/") {
+		image.Tag = rest[i+1:]
+		rest = rest[:i]
+		if image.Tag == "" {
+			return nil, fmt.Errorf("invalid image %q: empty tag", name)
+		}
+	}
+	if first, path, found := strings.Cut(rest, "/"); found && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		image.Registry = first
+		rest = path
+	}
+	image.Repository = rest
+	if image.Tag == "" && image.Digest == "" {
+		image.Tag = "latest"
+	}
+	if image.Repository == "" || strings.ContainsAny(image.Repository, " @:") || strings.HasPrefix(image.Repository, "/") || strings.HasSuffix(image.Repository, "/") {
+		return nil, fmt.Errorf("invalid image %q", name)
 	}
-	return fmt.Sprintf("%s/%s:%s", i.Registry, i.Repository, i.Tag)
+	return image, nil
 }
 
 type Artifact struct {
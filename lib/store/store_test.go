@@ -0,0 +1,357 @@
+package store
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+func TestParseImageArtifact(t *testing.T) {
+	const digest = "sha256:1ff6c18fbef2045af6b9c16bf034cc421a29027b800e4f9b68ae9b1cb3e9ae07"
+
+	testCases := []struct {
+		name     string
+		image    string
+		expected ImageArtifact
+		errMsg   string
+	}{
+		{"bare image", "busybox", ImageArtifact{Repository: "busybox", Tag: "latest"}, ""},
+		{"docker hub namespace", "stefanprodan/podinfo:6.7.0", ImageArtifact{Repository: "stefanprodan/podinfo", Tag: "6.7.0"}, ""},
+		{"registry", "ghcr.io/team/app:v1.2", ImageArtifact{Registry: "ghcr.io", Repository: "team/app", Tag: "v1.2"}, ""},
+		{"registry with host:port", "registry.example.com:5000/team/app:v1.2", ImageArtifact{Registry: "registry.example.com:5000", Repository: "team/app", Tag: "v1.2"}, ""},
+		{"registry with host:port without a tag", "registry.example.com:5000/team/app", ImageArtifact{Registry: "registry.example.com:5000", Repository: "team/app", Tag: "latest"}, ""},
+		{"localhost", "localhost/app:dev", ImageArtifact{Registry: "localhost", Repository: "app", Tag: "dev"}, ""},
+		{"digest only", "ghcr.io/team/app@" + digest, ImageArtifact{Registry: "ghcr.io", Repository: "team/app", Digest: digest}, ""},
+		{"tag and digest", "ghcr.io/team/app:v1@" + digest, ImageArtifact{Registry: "ghcr.io", Repository: "team/app", Tag: "v1", Digest: digest}, ""},
+		{"empty", "", ImageArtifact{}, `invalid image ""`},
+		{"empty tag", "busybox:", ImageArtifact{}, "empty tag"},
+		{"empty digest", "busybox@", ImageArtifact{}, "empty digest"},
+		{"registry without a repository", "ghcr.io/", ImageArtifact{}, `invalid image "ghcr.io/"`},
+		{"leading slash", "/app", ImageArtifact{}, `invalid image "/app"`},
+		{"space", "my app", ImageArtifact{}, `invalid image "my app"`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			image, err := ParseImageArtifact(tc.image)
+			if tc.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, *image)
+		})
+	}
+}
+
+func TestImageArtifactName(t *testing.T) {
+	const digest = "sha256:1ff6c18fbef2045af6b9c16bf034cc421a29027b800e4f9b68ae9b1cb3e9ae07"
+
+	testCases := []struct {
+		name     string
+		image    ImageArtifact
+		expected string
+	}{
+		{"bare image", ImageArtifact{Repository: "busybox", Tag: "latest"}, "busybox:latest"},
+		{"registry with host:port", ImageArtifact{Registry: "registry.example.com:5000", Repository: "team/app", Tag: "v1.2"}, "registry.example.com:5000/team/app:v1.2"},
+		{"localhost", ImageArtifact{Registry: "localhost", Repository: "app", Tag: "dev"}, "localhost/app:dev"},
+		{"digest only", ImageArtifact{Registry: "ghcr.io", Repository: "team/app", Digest: digest}, "ghcr.io/team/app@" + digest},
+		{"tag and digest", ImageArtifact{Registry: "ghcr.io", Repository: "team/app", Tag: "v1", Digest: digest}, "ghcr.io/team/app:v1@" + digest},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.image.Name())
+			// names parse back into the same image
+			parsed, err := ParseImageArtifact(tc.expected)
+			require.NoError(t, err)
+			assert.Equal(t, tc.image, *parsed)
+		})
+	}
+}
+
+func TestNextCanaryWeight(t *testing.T) {
+	steps := datatypes.NewJSONType([]int{10, 50})
+	testCases := []struct {
+		weight   int
+		expected int
+	}{
+		{0, 10},
+		{10, 50},
+		{50, 100},
+		{100, 100},
+	}
+	for _, tc := range testCases {
+		d := Deployment{CanarySteps: steps, CanaryWeight: tc.weight}
+		assert.Equal(t, tc.expected, d.NextCanaryWeight(), "weight %d", tc.weight)
+	}
+}
+
+func TestFreezeWindowContains(t *testing.T) {
+	// 2026-10-16 is a Friday
+	at := func(day int, hour int, minute int) time.Time {
+		return time.Date(2026, 10, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	testCases := []struct {
+		name     string
+		window   FreezeWindow
+		t        time.Time
+		expected bool
+	}{
+		{"within a window on one day", FreezeWindow{Start: "Fri 09:00", End: "Fri 17:00"}, at(16, 12, 0), true},
+		{"before a window on one day", FreezeWindow{Start: "Fri 09:00", End: "Fri 17:00"}, at(16, 8, 59), false},
+		{"exactly at the start", FreezeWindow{Start: "Fri 09:00", End: "Fri 17:00"}, at(16, 9, 0), true},
+		{"exactly at the end", FreezeWindow{Start: "Fri 09:00", End: "Fri 17:00"}, at(16, 17, 0), false},
+		{"just before the end", FreezeWindow{Start: "Fri 09:00", End: "Fri 17:00"}, at(16, 16, 59), true},
+		{"same time on another day", FreezeWindow{Start: "Fri 09:00", End: "Fri 17:00"}, at(15, 12, 0), false},
+		{"across midnight before it", FreezeWindow{Start: "Fri 22:00", End: "Sat 06:00"}, at(16, 23, 30), true},
+		{"across midnight after it", FreezeWindow{Start: "Fri 22:00", End: "Sat 06:00"}, at(17, 5, 59), true},
+		{"across midnight after the end", FreezeWindow{Start: "Fri 22:00", End: "Sat 06:00"}, at(17, 6, 0), false},
+		{"across the end of the week on friday", FreezeWindow{Start: "Fri 16:00", End: "Mon 09:00"}, at(16, 16, 0), true},
+		{"across the end of the week on sunday", FreezeWindow{Start: "Fri 16:00", End: "Mon 09:00"}, at(18, 23, 59), true},
+		{"across the end of the week on monday", FreezeWindow{Start: "Fri 16:00", End: "Mon 09:00"}, at(19, 8, 59), true},
+		{"across the end of the week at the end", FreezeWindow{Start: "Fri 16:00", End: "Mon 09:00"}, at(19, 9, 0), false},
+		{"across the end of the week midweek", FreezeWindow{Start: "Fri 16:00", End: "Mon 09:00"}, at(14, 12, 0), false},
+		{"sunday to monday", FreezeWindow{Start: "Sun 20:00", End: "Mon 02:00"}, at(19, 1, 0), true},
+		{"sunday to monday before the start", FreezeWindow{Start: "Sun 20:00", End: "Mon 02:00"}, at(18, 19, 59), false},
+		// Europe/Berlin is UTC+2 in October, so 16:00 there is 14:00 UTC
+		{"time zone at the start", FreezeWindow{Start: "Fri 16:00", End: "Fri 18:00", Timezone: "Europe/Berlin"}, at(16, 14, 0), true},
+		{"time zone before the start", FreezeWindow{Start: "Fri 16:00", End: "Fri 18:00", Timezone: "Europe/Berlin"}, at(16, 13, 59), false},
+		{"time zone crossing midnight in UTC", FreezeWindow{Start: "Sat 00:00", End: "Sat 01:00", Timezone: "Europe/Berlin"}, at(16, 22, 30), true},
+		{"full day names", FreezeWindow{Start: "friday 09:00", End: "Friday 17:00"}, at(16, 12, 0), true},
+		{"invalid start", FreezeWindow{Start: "someday 09:00", End: "Fri 17:00"}, at(16, 12, 0), false},
+		{"invalid time zone", FreezeWindow{Start: "Fri 09:00", End: "Fri 17:00", Timezone: "Mars/Olympus"}, at(16, 12, 0), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.window.Contains(tc.t))
+		})
+	}
+}
+
+func TestPreviewEnvName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		branch   string
+		expected string
+		errMsg   string
+	}{
+		{"simple branch", "main", "main-0d6e40", ""},
+		{"slashes and case", "feature/Login-Page", "feature-login-page-1cd8fc", ""},
+		{"runs of punctuation", "x/-y", "x-y-a6c12b", ""},
+		{"long branch is truncated before the hash", strings.Repeat("a", 40), strings.Repeat("a", 23) + "-e33cdf", ""},
+		{"no letters or digits", "///", "", "has no letters or digits"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			name, err := PreviewEnvName(tc.branch)
+			if tc.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, name)
+			assert.LessOrEqual(t, len(name), 30)
+		})
+	}
+
+	t.Run("branches that read the same get different envs", func(t *testing.T) {
+		for _, branches := range [][]string{
+			{"feature/login-page", "feature-login-page", "Feature/Login-Page"},
+			{strings.Repeat("a", 40), strings.Repeat("a", 40) + "b"},
+		} {
+			names := map[string]string{}
+			for _, branch := range branches {
+				name, err := PreviewEnvName(branch)
+				require.NoError(t, err)
+				assert.NotContains(t, names, name, "%s and %s", branch, names[name])
+				names[name] = branch
+			}
+		}
+	})
+
+	t.Run("truncation doesn't leave a double hyphen", func(t *testing.T) {
+		name, err := PreviewEnvName(strings.Repeat("a", 22) + "/bc")
+		require.NoError(t, err)
+		assert.NotContains(t, name, "--")
+	})
+}
+
+func TestValidateDependencies(t *testing.T) {
+	appNames := []string{"web", "api", "db", "cache"}
+	testCases := []struct {
+		name           string
+		app            string
+		dependencies   []string
+		dependenciesOf map[string][]string
+		errMsg         string
+	}{
+		{"no dependencies", "web", nil, nil, ""},
+		{"chain", "web", []string{"api"}, map[string][]string{"api": {"db"}, "db": {"cache"}}, ""},
+		{"diamond", "web", []string{"api", "cache"}, map[string][]string{"api": {"db", "cache"}, "db": {"cache"}}, ""},
+		{"self", "web", []string{"web"}, nil, "app web can't depend on itself"},
+		{"listed twice", "web", []string{"db", "db"}, nil, "dependency db is listed more than once"},
+		{"unknown app", "web", []string{"queue"}, nil, "dependency queue is not an app of the team"},
+		{"direct cycle", "web", []string{"api"}, map[string][]string{"api": {"web"}}, "app web can't depend on api, which depends on it already"},
+		{"indirect cycle", "web", []string{"api"}, map[string][]string{"api": {"db"}, "db": {"cache"}, "cache": {"web"}}, "app web can't depend on api, which depends on it already"},
+		{"cycle among the other apps", "web", []string{"api"}, map[string][]string{"api": {"db"}, "db": {"api"}}, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateDependencies(tc.app, tc.dependencies, appNames, tc.dependenciesOf)
+			if tc.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tc.errMsg, err.Error())
+		})
+	}
+}
+
+func TestValidateExternalPorts(t *testing.T) {
+	// the ports of a web and a worker process
+	ports := Ports{{Name: "http", Port: 8080, Proto: "http"}, {Name: "grpc", Port: 9090, Proto: "grpc"}, {Name: "postgres", Port: 5432, Proto: "tcp"}}
+	testCases := []struct {
+		name          string
+		externalPorts ExternalPorts
+		ports         Ports
+		errMsg        string
+	}{
+		{"none", nil, ports, ""},
+		{"one of each protocol", ExternalPorts{
+			{Name: "web", PortName: "http", Proto: "http", Port: 80},
+			{Name: "web-tls", PortName: "http", Proto: "https", Port: 443},
+			{Name: "api", PortName: "grpc", Proto: "grpc", Port: 443},
+			{Name: "db", PortName: "postgres", Proto: "tcp", Port: 5432},
+		}, ports, ""},
+		{"duplicate name", ExternalPorts{{Name: "web", PortName: "http", Proto: "http", Port: 80}, {Name: "web", PortName: "http", Proto: "https", Port: 443}}, ports, "external port web is defined more than once"},
+		{"unknown port", ExternalPorts{{Name: "web", PortName: "admin", Proto: "http", Port: 80}}, ports, "references port admin, which none of the processes have"},
+		{"port in more than one process", ExternalPorts{{Name: "web", PortName: "http", Proto: "http", Port: 80}}, append(Ports{{Name: "http", Port: 3000, Proto: "http"}}, ports...), "references port http, which more than one process has"},
+		{"http to a tcp port", ExternalPorts{{Name: "db", PortName: "postgres", Proto: "http", Port: 80}}, ports, "external port db is http, so port postgres has to be http, not tcp"},
+		{"https to a grpc port", ExternalPorts{{Name: "api", PortName: "grpc", Proto: "https", Port: 443}}, ports, "external port api is https, so port grpc has to be http, not grpc"},
+		{"grpc to an http port", ExternalPorts{{Name: "api", PortName: "http", Proto: "grpc", Port: 443}}, ports, "external port api is grpc, so port http has to be grpc, not http"},
+		{"tcp to an http port", ExternalPorts{{Name: "web", PortName: "http", Proto: "tcp", Port: 8080}}, ports, "external port web is tcp, so port http has to be tcp, not http"},
+		{"tcp on the gateway's http port", ExternalPorts{{Name: "db", PortName: "postgres", Proto: "tcp", Port: 80}}, ports, "can't use port 80"},
+		{"tcp on the gateway's https port", ExternalPorts{{Name: "db", PortName: "postgres", Proto: "tcp", Port: 443}}, ports, "can't use port 443"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateExternalPorts(tc.externalPorts, tc.ports)
+			if tc.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.errMsg)
+		})
+	}
+}
+
+func TestValidateVolumes(t *testing.T) {
+	processes := Processes{{Name: "web", Replicas: 1}, {Name: "indexer", Replicas: 1}, {Name: "worker", Replicas: 3}, {Name: "api", Autoscaling: &Autoscaling{MinReplicas: 1, MaxReplicas: 5}}}
+	testCases := []struct {
+		name    string
+		volumes Volumes
+		errMsg  string
+	}{
+		{"none", nil, ""},
+		{"mounted into the default process", Volumes{{Name: "data", MountPath: "/data", SizeGiB: 5}}, ""},
+		{"same path in different processes", Volumes{{Name: "data", MountPath: "/data", SizeGiB: 5}, {Name: "index", MountPath: "/data", SizeGiB: 1, Process: "indexer"}}, ""},
+		{"duplicate name", Volumes{{Name: "data", MountPath: "/data", SizeGiB: 5}, {Name: "data", MountPath: "/other", SizeGiB: 5}}, "volume data is defined more than once"},
+		{"duplicate mount path", Volumes{{Name: "data", MountPath: "/data", SizeGiB: 5}, {Name: "cache", MountPath: "/data", SizeGiB: 1, Process: "web"}}, "more than one volume is mounted at /data in process web"},
+		{"unknown process", Volumes{{Name: "data", MountPath: "/data", SizeGiB: 5, Process: "cron"}}, "volume data is mounted into process cron, which does not exist"},
+		{"autoscaled process", Volumes{{Name: "data", MountPath: "/data", SizeGiB: 5, Process: "api"}}, "process api can't autoscale since it mounts volume data"},
+		{"more than one replica", Volumes{{Name: "data", MountPath: "/data", SizeGiB: 5, Process: "worker"}}, "process worker can't run more than one replica since it mounts volume data"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateVolumes(tc.volumes, processes)
+			if tc.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.errMsg)
+		})
+	}
+}
+
+func TestVolumesForProcess(t *testing.T) {
+	volumes := Volumes{{Name: "data", MountPath: "/data"}, {Name: "cache", MountPath: "/cache", Process: "web"}, {Name: "spool", MountPath: "/spool", Process: "worker"}}
+	assert.Equal(t, []string{"data", "cache"}, volumeNames(volumes.ForProcess("web")))
+	assert.Equal(t, []string{"spool"}, volumeNames(volumes.ForProcess("worker")))
+	assert.Empty(t, volumes.ForProcess("cron"))
+}
+
+func volumeNames(volumes Volumes) []string {
+	var names []string
+	for _, v := range volumes {
+		names = append(names, v.Name)
+	}
+	return names
+}
+
+func TestValidateHealthCheck(t *testing.T) {
+	ports := Ports{{Name: "http", Port: 8080, Proto: "http"}, {Name: "grpc", Port: 9090, Proto: "grpc"}, {Name: "postgres", Port: 5432, Proto: "tcp"}}
+	testCases := []struct {
+		name        string
+		healthCheck HealthCheck
+		errMsg      string
+	}{
+		{"http", HealthCheck{Path: "/healthz", PortName: "http"}, ""},
+		{"tcp", HealthCheck{PortName: "postgres"}, ""},
+		{"exec on an http port", HealthCheck{PortName: "http", Command: "curl -f localhost:8080"}, ""},
+		{"exec on a grpc port", HealthCheck{PortName: "grpc", Command: "grpc_health_probe -addr=:9090"}, ""},
+		{"unknown port", HealthCheck{Path: "/healthz", PortName: "admin"}, "health check references port admin, which none of the processes have"},
+		{"http without a path", HealthCheck{PortName: "http"}, "health check of http port http needs a path or a command"},
+		{"tcp with a path", HealthCheck{Path: "/healthz", PortName: "postgres"}, "health check of tcp port postgres can't have a path"},
+		{"grpc without a command", HealthCheck{PortName: "grpc"}, "health check of grpc port grpc needs a command"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateHealthCheck(&tc.healthCheck, ports)
+			if tc.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.errMsg)
+		})
+	}
+}
+
+func TestDeploymentEnvVars(t *testing.T) {
+	testCases := []struct {
+		name     string
+		shared   []EnvVar
+		app      []EnvVar
+		expected []EnvVar
+	}{
+		{"none", nil, nil, nil},
+		{"shared only", []EnvVar{{Name: "SENTRY_DSN", Value: "shared"}}, nil, []EnvVar{{Name: "SENTRY_DSN", Value: "shared"}}},
+		{"app only", nil, []EnvVar{{Name: "DEBUG", Value: "1"}}, []EnvVar{{Name: "DEBUG", Value: "1"}}},
+		{"both", []EnvVar{{Name: "SENTRY_DSN", Value: "shared"}}, []EnvVar{{Name: "DEBUG", Value: "1"}}, []EnvVar{{Name: "SENTRY_DSN", Value: "shared"}, {Name: "DEBUG", Value: "1"}}},
+		{
+			"app overrides shared",
+			[]EnvVar{{Name: "SENTRY_DSN", Value: "shared"}, {Name: "LOG_LEVEL", Value: "info"}},
+			[]EnvVar{{Name: "SENTRY_DSN", Value: "app"}, {Name: "DEBUG", Value: "1"}},
+			[]EnvVar{{Name: "LOG_LEVEL", Value: "info"}, {Name: "SENTRY_DSN", Value: "app"}, {Name: "DEBUG", Value: "1"}},
+		},
+		{"app overrides shared with an empty value", []EnvVar{{Name: "SENTRY_DSN", Value: "shared"}}, []EnvVar{{Name: "SENTRY_DSN", Value: ""}}, []EnvVar{{Name: "SENTRY_DSN", Value: ""}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Deployment{
+				EnvVarSet:  EnvVarSet{EnvVars: datatypes.NewJSONType(tc.shared)},
+				AppEnvVars: AppEnvVars{EnvVars: datatypes.NewJSONType(tc.app)},
+			}
+			assert.Equal(t, tc.expected, d.EnvVars())
+		})
+	}
+}
@@ -20,6 +20,8 @@ type TestStoresConfig struct {
 	DeploymentStore DeploymentStore
 	ApiTokenStore   ApiTokenStore
 	BuildStore      BuildStore
+	CronJobStore    CronJobStore
+	DomainStore     DomainStore
 }
 
 func createUser(t *testing.T, stores TestStoresConfig, email, password string) User {
@@ -235,11 +237,19 @@ func NewStoreTestSuite(stores TestStoresConfig) func(t *testing.T) {
 				},
 			}
 			createAppSettingsOpts := CreateAppSettingsOptions{
-				TeamId:        team.Id,
-				AppId:         app.Id,
-				Ports:         ports,
-				ExternalPorts: externalPorts,
-				Resources:     resources,
+				TeamId:         team.Id,
+				AppId:          app.Id,
+				Ports:          ports,
+				ExternalPorts:  externalPorts,
+				Resources:      resources,
+				HealthCheck:    &HealthCheck{Path: "/healthz", PortName: "http", InitialDelaySeconds: 20},
+				ReleaseCommand: "./migrate up",
+				Processes: Processes{
+					{Name: "web", Replicas: 2, Resources: resources, Ports: ports, Autoscaling: &Autoscaling{MinReplicas: 2, MaxReplicas: 10, TargetCPUUtilizationPercent: 70}},
+					{Name: "worker", Command: "./worker", Replicas: 1, Resources: resources},
+				},
+				Volumes:      Volumes{{Name: "data", MountPath: "/data", SizeGiB: 10, Process: "worker"}},
+				Dependencies: []string{"postgres"},
 			}
 			appSettings, err := stores.AppStore.CreateAppSettings(createAppSettingsOpts)
 			require.NoError(err, "Failed to create app settings")
@@ -255,6 +265,22 @@ func NewStoreTestSuite(stores TestStoresConfig) func(t *testing.T) {
 			require.Equal(len(externalPorts), len(fetchedAppSettings.ExternalPorts.Data()), "Expected fetched app settings external ports to match")
 			require.Equal(resources.Limits.CpuCores, fetchedAppSettings.Resources.Data().Limits.CpuCores, "Expected fetched app settings CPU limit to match")
 			require.Equal(resources.Limits.MemoryMiB, fetchedAppSettings.Resources.Data().Limits.MemoryMiB, "Expected fetched app settings memory limit to match")
+			require.NotNil(fetchedAppSettings.HealthCheck.Data(), "Expected fetched app settings health check to be present")
+			require.Equal("/healthz", fetchedAppSettings.HealthCheck.Data().Path, "Expected fetched app settings health check path to match")
+			require.Equal(20, fetchedAppSettings.HealthCheck.Data().InitialDelaySeconds, "Expected fetched app settings health check initial delay to match")
+			require.Equal("./migrate up", fetchedAppSettings.ReleaseCommand, "Expected fetched app settings release command to match")
+			require.Len(fetchedAppSettings.Processes.Data(), 2, "Expected fetched app settings processes to match")
+			require.Equal("./worker", fetchedAppSettings.Processes.Data()[1].Command, "Expected fetched app settings worker command to match")
+			require.Empty(fetchedAppSettings.Processes.Data()[1].Ports, "Expected fetched app settings worker to have no ports")
+			require.NotNil(fetchedAppSettings.Processes.Data()[0].Autoscaling, "Expected fetched app settings web autoscaling to be present")
+			require.Equal(10, fetchedAppSettings.Processes.Data()[0].Autoscaling.MaxReplicas, "Expected fetched app settings web max replicas to match")
+			require.Nil(fetchedAppSettings.Processes.Data()[1].Autoscaling, "Expected fetched app settings worker to not autoscale")
+			require.Nil(fetchedAppSettings.Autoscaling.Data(), "Expected fetched app settings app-level autoscaling to be absent")
+			require.True(fetchedAppSettings.HasVolumes(), "Expected fetched app settings volumes to be present")
+			require.Equal(10, fetchedAppSettings.Volumes.Data()[0].SizeGiB, "Expected fetched app settings volume size to match")
+			require.Len(fetchedAppSettings.Volumes.Data().ForProcess("worker"), 1, "Expected the volume to be mounted into the worker")
+			require.Empty(fetchedAppSettings.Volumes.Data().ForProcess("web"), "Expected no volume to be mounted into web")
+			require.Equal([]string{"postgres"}, fetchedAppSettings.Dependencies.Data(), "Expected fetched app settings dependencies to match")
 		})
 
 		t.Run("Deployment Operations", func(t *testing.T) {
@@ -344,6 +370,28 @@ func NewStoreTestSuite(stores TestStoresConfig) func(t *testing.T) {
 				require.Error(err, "Expected error when getting deleted app env vars")
 			})
 
+			// Test EnvVarSet operations
+			t.Run("EnvVarSet Operations", func(t *testing.T) {
+				env, _ := stores.DeploymentStore.CreateEnv(CreateEnvOptions{TeamId: team.Id, Name: "shared-env"})
+
+				envVarSet, err := stores.DeploymentStore.CreateEnvVarSet(CreateEnvVarSetOptions{
+					TeamId:  team.Id,
+					EnvId:   env.Id,
+					EnvVars: []EnvVar{{Name: "SENTRY_DSN", Value: "https://sentry.example.com/1"}},
+				})
+				require.NoError(err, "Failed to create env var set")
+				require.NotEmpty(envVarSet.Id, "Expected env var set id to be present")
+
+				// the new set becomes the env's current one
+				fetchedEnv, err := stores.DeploymentStore.GetEnv(env.Id)
+				require.NoError(err, "Failed to get env")
+				require.Equal(envVarSet.Id, fetchedEnv.EnvVarSetId, "Expected the env to point at its new env var set")
+
+				fetchedEnvVarSet, err := stores.DeploymentStore.GetEnvVarSet(envVarSet.Id)
+				require.NoError(err, "Failed to get env var set")
+				require.Equal([]EnvVar{{Name: "SENTRY_DSN", Value: "https://sentry.example.com/1"}}, fetchedEnvVarSet.EnvVars.Data(), "Expected fetched env vars to be decrypted")
+			})
+
 			// Test Deployment operations
 			t.Run("Deployment Operations", func(t *testing.T) {
 				ctx := context.Background()
@@ -387,10 +435,121 @@ func NewStoreTestSuite(stores TestStoresConfig) func(t *testing.T) {
 				require.NoError(err, "Failed to get deployment")
 				require.Equal(deployment.Id, fetchedDeployment.Id, "Expected fetched deployment id to match")
 
+				// Status transitions are recorded as events, starting with the creation of the deployment
+				require.NoError(stores.DeploymentStore.UpdateDeploymentStatus(app.Id, env.Id, deployment.Id, DeploymentStatusDeploying, ""), "Failed to update deployment status")
+				require.NoError(stores.DeploymentStore.UpdateDeploymentStatus(app.Id, env.Id, deployment.Id, DeploymentStatusDeploying, ""), "Failed to update deployment status")
+				require.NoError(stores.DeploymentStore.RecordDeploymentStatus(RecordDeploymentStatusOptions{
+					AppId:        app.Id,
+					EnvId:        env.Id,
+					DeploymentId: deployment.Id,
+					Status:       DeploymentStatusCanceling,
+					Reason:       "rolling back to the previous deployment",
+					Actor:        "someone@example.com",
+				}), "Failed to record deployment status")
+				events, err := stores.DeploymentStore.GetEvents(ctx, app.Id, env.Id, deployment.Id)
+				require.NoError(err, "Failed to get deployment events")
+				require.Equal(3, len(events), "Expected an event for the creation and each change of status")
+				require.Equal(DeploymentStatusPending, events[0].Status, "Expected the first event to be the creation")
+				require.Equal(SystemActor, events[1].Actor, "Expected status updates to be made by metal")
+				require.Equal("someone@example.com", events[2].Actor, "Expected recorded status to keep its actor")
+
+				// Cell statuses are kept even when the rolled up status doesn't change
+				require.Equal(DeploymentStatusPending, fetchedDeployment.CellStatus(cell.Id).Status, "Expected a cell that wasn't advanced yet to have the deployment's status")
+				require.NoError(stores.DeploymentStore.RecordDeploymentStatus(RecordDeploymentStatusOptions{
+					AppId:        app.Id,
+					EnvId:        env.Id,
+					DeploymentId: deployment.Id,
+					Status:       DeploymentStatusCanceling,
+					Reason:       "rolling back to the previous deployment",
+					Actor:        SystemActor,
+					CellStatuses: []CellStatus{{CellId: cell.Id, Status: DeploymentStatusCanceled}},
+				}), "Failed to record deployment status")
+				fetchedDeployment, err = stores.DeploymentStore.Get(app.Id, env.Id, deployment.Id)
+				require.NoError(err, "Failed to get deployment")
+				require.Equal(DeploymentStatusCanceled, fetchedDeployment.CellStatus(cell.Id).Status, "Expected cell status to be updated")
+				events, err = stores.DeploymentStore.GetEvents(ctx, app.Id, env.Id, deployment.Id)
+				require.NoError(err, "Failed to get deployment events")
+				require.Equal(3, len(events), "Expected no event when only cell statuses change")
+
+				// Status updates made while the deployment was being canceled don't overwrite the cancel
+				err = stores.DeploymentStore.RecordDeploymentStatus(RecordDeploymentStatusOptions{
+					AppId:        app.Id,
+					EnvId:        env.Id,
+					DeploymentId: deployment.Id,
+					Status:       DeploymentStatusRunning,
+					Actor:        SystemActor,
+					CellStatuses: []CellStatus{{CellId: cell.Id, Status: DeploymentStatusRunning}},
+					UnlessStatus: []DeploymentStatus{DeploymentStatusCanceling, DeploymentStatusCanceled},
+				})
+				require.ErrorIs(err, ErrDeploymentStatusChanged, "Expected the cancel to win")
+				fetchedDeployment, err = stores.DeploymentStore.Get(app.Id, env.Id, deployment.Id)
+				require.NoError(err, "Failed to get deployment")
+				require.Equal(DeploymentStatusCanceling, fetchedDeployment.Status, "Expected the deployment to still be canceling")
+				require.Equal(DeploymentStatusCanceled, fetchedDeployment.CellStatus(cell.Id).Status, "Expected cell status to be left alone")
+
 				// Create another deployment for the same app/env
 				deployment2, err := stores.DeploymentStore.Create(createDeploymentOpts)
 				require.NoError(err, "Failed to create second deployment")
 				require.Equal(uint(2), deployment2.Id, "Expected second deployment id to be 2")
+				require.False(deployment2.IsCanary(), "Expected a regular deployment not to be a canary")
+
+				// Canary deployments start out on their first step
+				canaryOpts := createDeploymentOpts
+				canaryOpts.CanarySteps = []int{10, 50}
+				canary, err := stores.DeploymentStore.Create(canaryOpts)
+				require.NoError(err, "Failed to create canary deployment")
+				require.True(canary.InCanaryPhase(), "Expected canary deployment to be in its canary phase")
+				require.Equal(10, canary.CanaryWeight, "Expected canary to start on its first step")
+				require.Equal(50, canary.NextCanaryWeight(), "Expected next canary step to be 50")
+
+				err = stores.DeploymentStore.UpdateCanaryWeight(app.Id, env.Id, canary.Id, canary.NextCanaryWeight())
+				require.NoError(err, "Failed to update canary weight")
+				fetchedCanary, err := stores.DeploymentStore.Get(app.Id, env.Id, canary.Id)
+				require.NoError(err, "Failed to get canary deployment")
+				require.Equal(50, fetchedCanary.CanaryWeight, "Expected canary weight to be updated")
+				require.Equal(100, fetchedCanary.NextCanaryWeight(), "Expected a canary on its last step to be promoted fully next")
+				require.NoError(stores.DeploymentStore.DeleteDeployment(app.Id, env.Id, canary.Id), "Failed to delete canary deployment")
+
+				// Deployments to a locked env are rejected unless the lock is overridden
+				require.NoError(stores.DeploymentStore.LockEnv(env.Id, LockEnvOptions{LockedBy: "someone@example.com", Reason: "incident"}), "Failed to lock env")
+				lockedEnv, err := stores.DeploymentStore.GetEnv(env.Id)
+				require.NoError(err, "Failed to get env")
+				require.True(lockedEnv.Locked(), "Expected env to be locked")
+				require.Equal("someone@example.com", lockedEnv.LockedBy, "Expected lock to record who took it")
+				require.Equal("incident", lockedEnv.LockReason, "Expected lock to record why")
+				_, err = stores.DeploymentStore.Create(createDeploymentOpts)
+				require.ErrorIs(err, ErrEnvLocked, "Expected deployments to a locked env to be rejected")
+				overrideOpts := createDeploymentOpts
+				overrideOpts.OverrideLock = true
+				overridden, err := stores.DeploymentStore.Create(overrideOpts)
+				require.NoError(err, "Failed to create deployment overriding the lock")
+				require.NoError(stores.DeploymentStore.DeleteDeployment(app.Id, env.Id, overridden.Id), "Failed to delete deployment")
+				require.NoError(stores.DeploymentStore.UnlockEnv(env.Id), "Failed to unlock env")
+				unlockedEnv, err := stores.DeploymentStore.GetEnv(env.Id)
+				require.NoError(err, "Failed to get env")
+				require.False(unlockedEnv.Locked(), "Expected env to be unlocked")
+
+				// Deployments to a protected env wait for an admin to approve them
+				require.NoError(stores.DeploymentStore.UpdateEnv(env.Id, UpdateEnvOptions{Protected: true}), "Failed to protect env")
+				protectedOpts := createDeploymentOpts
+				protectedOpts.RequestedBy = "user_requester"
+				awaiting, err := stores.DeploymentStore.Create(protectedOpts)
+				require.NoError(err, "Failed to create deployment to a protected env")
+				require.Equal(DeploymentStatusPendingApproval, awaiting.Status, "Expected deployment to a protected env to wait for approval")
+				require.NoError(stores.DeploymentStore.ReviewDeployment(ReviewDeploymentOptions{
+					AppId: app.Id, EnvId: env.Id, DeploymentId: awaiting.Id, Approved: false, ReviewerId: "user_admin", Reviewer: "admin@example.com", Comment: "not now",
+				}), "Failed to reject deployment")
+				rejected, err := stores.DeploymentStore.Get(app.Id, env.Id, awaiting.Id)
+				require.NoError(err, "Failed to get deployment")
+				require.Equal(DeploymentStatusRejected, rejected.Status, "Expected deployment to be rejected")
+				require.Equal("rejected by admin@example.com: not now", rejected.StatusReason, "Expected the rejection to be the status reason")
+				require.NotNil(rejected.Review.Data(), "Expected the review to be recorded")
+				require.Equal("not now", rejected.Review.Data().Comment, "Expected the review to record the comment")
+				require.Error(stores.DeploymentStore.ReviewDeployment(ReviewDeploymentOptions{
+					AppId: app.Id, EnvId: env.Id, DeploymentId: awaiting.Id, Approved: true, ReviewerId: "user_admin", Reviewer: "admin@example.com",
+				}), "Expected a rejected deployment not to be approvable")
+				require.NoError(stores.DeploymentStore.DeleteDeployment(app.Id, env.Id, awaiting.Id), "Failed to delete deployment")
+				require.NoError(stores.DeploymentStore.UpdateEnv(env.Id, UpdateEnvOptions{}), "Failed to unprotect env")
 
 				// Get Deployments for Team
 				teamDeployments, err := stores.DeploymentStore.GetForTeam(ctx, team.Id)
@@ -421,7 +580,7 @@ func NewStoreTestSuite(stores TestStoresConfig) func(t *testing.T) {
 
 				// Verify deployment is deleted
 				_, err = stores.DeploymentStore.Get(app.Id, env.Id, deployment.Id)
-				require.Error(err, "Expected error when getting deleted deployment")
+				require.ErrorIs(err, ErrDeploymentNotFound, "Expected error when getting deleted deployment")
 
 				// Test GetLatestForAppEnv
 				latestDeployment, err := stores.DeploymentStore.GetLatestForAppEnv(ctx, app.Id, env.Id)
@@ -599,5 +758,116 @@ func NewStoreTestSuite(stores TestStoresConfig) func(t *testing.T) {
 			require.Equal(artifact.Image.Repository, buildWithArtifact.Artifacts.Data()[0].Image.Repository, "Expected build artifact image name to match")
 			require.Equal(artifact.Image.Tag, buildWithArtifact.Artifacts.Data()[0].Image.Tag, "Expected build artifact image tag to match")
 		})
+
+		t.Run("CronJob Operations", func(t *testing.T) {
+			require := require.New(t)
+			ctx := context.Background()
+
+			// Create a team, app, and env for the cron job tests
+			team := createTeam(t, stores, "CronJob Test Team", "A team for testing cron jobs")
+			user := createUser(t, stores, "cronjobtest@example.com", "password123")
+			addUserToTeam(t, ctx, stores, user.Id, team.Id)
+			app, err := stores.AppStore.Create(CreateAppOptions{Name: "cron-app", TeamId: team.Id, UserId: user.Id})
+			require.NoError(err, "Failed to create app")
+			env, err := stores.DeploymentStore.CreateEnv(CreateEnvOptions{TeamId: team.Id, Name: "cron-env"})
+			require.NoError(err, "Failed to create env")
+
+			createOpts := CreateCronJobOptions{
+				TeamId:            team.Id,
+				AppId:             app.Id,
+				EnvId:             env.Id,
+				Name:              "nightly-report",
+				Schedule:          "0 3 * * *",
+				Command:           "./report",
+				Timezone:          "America/New_York",
+				ConcurrencyPolicy: CronJobConcurrencyPolicyForbid,
+			}
+			cronJob, err := stores.CronJobStore.Create(ctx, createOpts)
+			require.NoError(err, "Failed to create cron job")
+			require.NotEmpty(cronJob.Id, "Expected cron job id to be present")
+			require.Equal(app.Name, cronJob.App.Name, "Expected cron job app to be loaded")
+			require.Equal(env.Name, cronJob.Env.Name, "Expected cron job env to be loaded")
+
+			// names are unique per app and env
+			_, err = stores.CronJobStore.Create(ctx, createOpts)
+			require.Error(err, "Expected error when creating a duplicate cron job")
+
+			invalidOpts := createOpts
+			invalidOpts.Name = "bad-schedule"
+			invalidOpts.Schedule = "every day"
+			_, err = stores.CronJobStore.Create(ctx, invalidOpts)
+			require.Error(err, "Expected error when creating a cron job with an invalid schedule")
+
+			fetched, err := stores.CronJobStore.GetByName(ctx, app.Id, env.Id, "nightly-report")
+			require.NoError(err, "Failed to get cron job")
+			require.Equal(cronJob.Id, fetched.Id, "Expected fetched cron job id to match")
+			require.Equal("America/New_York", fetched.Timezone, "Expected fetched cron job timezone to match")
+			require.Equal(CronJobConcurrencyPolicyForbid, fetched.ConcurrencyPolicy, "Expected fetched cron job concurrency policy to match")
+
+			cronJobs, err := stores.CronJobStore.GetForAppEnv(ctx, app.Id, env.Id)
+			require.NoError(err, "Failed to get cron jobs for app env")
+			require.Len(cronJobs, 1, "Expected one cron job for the app env")
+
+			require.NoError(stores.CronJobStore.Delete(ctx, cronJob.Id), "Failed to delete cron job")
+			_, err = stores.CronJobStore.GetByName(ctx, app.Id, env.Id, "nightly-report")
+			require.ErrorIs(err, ErrCronJobNotFound, "Expected cron job to be deleted")
+
+			// deleted names can be reused
+			_, err = stores.CronJobStore.Create(ctx, createOpts)
+			require.NoError(err, "Failed to recreate cron job")
+		})
+
+		t.Run("Domain Operations", func(t *testing.T) {
+			require := require.New(t)
+			ctx := context.Background()
+
+			team := createTeam(t, stores, "Domain Test Team", "A team for testing domains")
+			user := createUser(t, stores, "domaintest@example.com", "password123")
+			addUserToTeam(t, ctx, stores, user.Id, team.Id)
+			app, err := stores.AppStore.Create(CreateAppOptions{Name: "domain-app", TeamId: team.Id, UserId: user.Id})
+			require.NoError(err, "Failed to create app")
+			env, err := stores.DeploymentStore.CreateEnv(CreateEnvOptions{TeamId: team.Id, Name: "domain-env"})
+			require.NoError(err, "Failed to create env")
+
+			createOpts := CreateDomainOptions{
+				TeamId:   team.Id,
+				AppId:    app.Id,
+				EnvId:    env.Id,
+				Hostname: "www.example.com",
+			}
+			domain, err := stores.DomainStore.Create(ctx, createOpts)
+			require.NoError(err, "Failed to create domain")
+			require.NotEmpty(domain.Id, "Expected domain id to be present")
+			require.NotEmpty(domain.VerificationToken, "Expected a verification token")
+			require.Equal(DomainVerificationStatusPending, domain.VerificationStatus, "Expected new domain to be pending verification")
+			require.Equal(app.Name, domain.App.Name, "Expected domain app to be loaded")
+			require.Equal(env.Name, domain.Env.Name, "Expected domain env to be loaded")
+
+			// hostnames are unique
+			_, err = stores.DomainStore.Create(ctx, createOpts)
+			require.Error(err, "Expected error when creating a duplicate domain")
+
+			invalidOpts := createOpts
+			invalidOpts.Hostname = "not a hostname"
+			_, err = stores.DomainStore.Create(ctx, invalidOpts)
+			require.Error(err, "Expected error when creating a domain with an invalid hostname")
+
+			require.NoError(stores.DomainStore.UpdateVerification(ctx, domain.Id, DomainVerificationStatusVerified, ""), "Failed to verify domain")
+			fetched, err := stores.DomainStore.GetByHostname(ctx, "www.example.com")
+			require.NoError(err, "Failed to get domain")
+			require.True(fetched.Verified(), "Expected domain to be verified")
+			require.NotNil(fetched.VerifiedAt, "Expected domain verified at to be set")
+
+			domains, err := stores.DomainStore.GetForAppEnv(ctx, app.Id, env.Id)
+			require.NoError(err, "Failed to get domains for app env")
+			require.Len(domains, 1, "Expected one domain for the app env")
+			domains, err = stores.DomainStore.GetForTeam(ctx, team.Id)
+			require.NoError(err, "Failed to get domains for team")
+			require.Len(domains, 1, "Expected one domain for the team")
+
+			require.NoError(stores.DomainStore.Delete(ctx, domain.Id), "Failed to delete domain")
+			_, err = stores.DomainStore.GetByHostname(ctx, "www.example.com")
+			require.ErrorIs(err, ErrDomainNotFound, "Expected domain to be deleted")
+		})
 	}
 }
@@ -19,6 +19,7 @@ func init() {
 	validate.RegisterValidation("dotenvformat", isDotenvFormat)
 	validate.RegisterValidation("duration", isDuration)
 	validate.RegisterValidation("tzlocation", isTZLocation)
+	validate.RegisterValidation("cronschedule", isCronSchedule)
 }
 
 var lowerCaseAlphaNumHyphenRegex = regexp.MustCompile(`^[a-z0-9-]+$`)
@@ -46,6 +47,30 @@ func isTZLocation(fl validator.FieldLevel) bool {
 	return err == nil
 }
 
+var cronFieldRegex = regexp.MustCompile(`^[0-9A-Za-z*?,/-]+$`)
+
+var cronMacros = []string{"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly"}
+
+// isCronSchedule does a shallow check of the five-field cron syntax that k8s CronJobs accept. k8s does the full parse.
+func isCronSchedule(fl validator.FieldLevel) bool {
+	schedule := strings.TrimSpace(fl.Field().String())
+	for _, macro := range cronMacros {
+		if schedule == macro {
+			return true
+		}
+	}
+	fields := strings.Fields(schedule)
+	if len(fields) != 5 {
+		return false
+	}
+	for _, field := range fields {
+		if !cronFieldRegex.MatchString(field) {
+			return false
+		}
+	}
+	return true
+}
+
 func Validator() *validator.Validate {
 	return validate
 }
@@ -147,3 +147,41 @@ func TestTZLocation(t *testing.T) {
 		})
 	}
 }
+
+func TestCronSchedule(t *testing.T) {
+	type TestStruct struct {
+		Field string `validate:"cronschedule"`
+	}
+
+	v := Validator()
+
+	testCases := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"every minute", "* * * * *", true},
+		{"steps and ranges", "*/15 9-17 * * MON-FRI", true},
+		{"lists", "0 0,12 1 */2 *", true},
+		{"macro", "@daily", true},
+		{"too few fields", "* * * *", false},
+		{"too many fields", "0 * * * * *", false},
+		{"invalid characters", "0 0 * * $", false},
+		{"unknown macro", "@sometimes", false},
+		{"empty string", "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := TestStruct{Field: tc.input}
+			err := v.Struct(ts)
+
+			if tc.valid && err != nil {
+				t.Errorf("Test case '%s': Expected valid input '%s', but got error: %v", tc.name, tc.input, err)
+			}
+			if !tc.valid && err == nil {
+				t.Errorf("Test case '%s': Expected invalid input '%s', but got no error", tc.name, tc.input)
+			}
+		})
+	}
+}